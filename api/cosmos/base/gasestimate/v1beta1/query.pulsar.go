@@ -0,0 +1,2536 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package gasestimatev1beta1
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/gasprofile/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_EstimateGasRequest_2_list)(nil)
+
+type _EstimateGasRequest_2_list struct {
+	list *[]uint32
+}
+
+func (x *_EstimateGasRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EstimateGasRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_EstimateGasRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EstimateGasRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EstimateGasRequest_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EstimateGasRequest at list field Percentiles as it is not of Message kind"))
+}
+
+func (x *_EstimateGasRequest_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EstimateGasRequest_2_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_EstimateGasRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EstimateGasRequest             protoreflect.MessageDescriptor
+	fd_EstimateGasRequest_tx_bytes    protoreflect.FieldDescriptor
+	fd_EstimateGasRequest_percentiles protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_gasestimate_v1beta1_query_proto_init()
+	md_EstimateGasRequest = File_cosmos_base_gasestimate_v1beta1_query_proto.Messages().ByName("EstimateGasRequest")
+	fd_EstimateGasRequest_tx_bytes = md_EstimateGasRequest.Fields().ByName("tx_bytes")
+	fd_EstimateGasRequest_percentiles = md_EstimateGasRequest.Fields().ByName("percentiles")
+}
+
+var _ protoreflect.Message = (*fastReflection_EstimateGasRequest)(nil)
+
+type fastReflection_EstimateGasRequest EstimateGasRequest
+
+func (x *EstimateGasRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EstimateGasRequest)(x)
+}
+
+func (x *EstimateGasRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EstimateGasRequest_messageType fastReflection_EstimateGasRequest_messageType
+var _ protoreflect.MessageType = fastReflection_EstimateGasRequest_messageType{}
+
+type fastReflection_EstimateGasRequest_messageType struct{}
+
+func (x fastReflection_EstimateGasRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EstimateGasRequest)(nil)
+}
+func (x fastReflection_EstimateGasRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasRequest)
+}
+func (x fastReflection_EstimateGasRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EstimateGasRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EstimateGasRequest) Type() protoreflect.MessageType {
+	return _fastReflection_EstimateGasRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EstimateGasRequest) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EstimateGasRequest) Interface() protoreflect.ProtoMessage {
+	return (*EstimateGasRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EstimateGasRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_EstimateGasRequest_tx_bytes, value) {
+			return
+		}
+	}
+	if len(x.Percentiles) != 0 {
+		value := protoreflect.ValueOfList(&_EstimateGasRequest_2_list{list: &x.Percentiles})
+		if !f(fd_EstimateGasRequest_percentiles, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EstimateGasRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.tx_bytes":
+		return len(x.TxBytes) != 0
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.percentiles":
+		return len(x.Percentiles) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.tx_bytes":
+		x.TxBytes = nil
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.percentiles":
+		x.Percentiles = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EstimateGasRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.tx_bytes":
+		value := x.TxBytes
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.percentiles":
+		if len(x.Percentiles) == 0 {
+			return protoreflect.ValueOfList(&_EstimateGasRequest_2_list{})
+		}
+		listValue := &_EstimateGasRequest_2_list{list: &x.Percentiles}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.tx_bytes":
+		x.TxBytes = value.Bytes()
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.percentiles":
+		lv := value.List()
+		clv := lv.(*_EstimateGasRequest_2_list)
+		x.Percentiles = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.percentiles":
+		if x.Percentiles == nil {
+			x.Percentiles = []uint32{}
+		}
+		value := &_EstimateGasRequest_2_list{list: &x.Percentiles}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message cosmos.base.gasestimate.v1beta1.EstimateGasRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EstimateGasRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.tx_bytes":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasRequest.percentiles":
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_EstimateGasRequest_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EstimateGasRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.gasestimate.v1beta1.EstimateGasRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EstimateGasRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EstimateGasRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EstimateGasRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EstimateGasRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TxBytes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Percentiles) > 0 {
+			l = 0
+			for _, e := range x.Percentiles {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EstimateGasRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Percentiles) > 0 {
+			var pksize2 int
+			for _, num := range x.Percentiles {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.Percentiles {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.TxBytes) > 0 {
+			i -= len(x.TxBytes)
+			copy(dAtA[i:], x.TxBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EstimateGasRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Percentiles = append(x.Percentiles, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.Percentiles) == 0 {
+						x.Percentiles = make([]uint32, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint32
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Percentiles = append(x.Percentiles, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EstimateGasResponse_2_list)(nil)
+
+type _EstimateGasResponse_2_list struct {
+	list *[]*v1beta1.MsgGasProfile
+}
+
+func (x *_EstimateGasResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EstimateGasResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EstimateGasResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.MsgGasProfile)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EstimateGasResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.MsgGasProfile)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EstimateGasResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.MsgGasProfile)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EstimateGasResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EstimateGasResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.MsgGasProfile)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EstimateGasResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EstimateGasResponse_6_list)(nil)
+
+type _EstimateGasResponse_6_list struct {
+	list *[]*FeeSuggestion
+}
+
+func (x *_EstimateGasResponse_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EstimateGasResponse_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EstimateGasResponse_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSuggestion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EstimateGasResponse_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSuggestion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EstimateGasResponse_6_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSuggestion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EstimateGasResponse_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EstimateGasResponse_6_list) NewElement() protoreflect.Value {
+	v := new(FeeSuggestion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EstimateGasResponse_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EstimateGasResponse            protoreflect.MessageDescriptor
+	fd_EstimateGasResponse_gas_used   protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_msgs       protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_gas_limit  protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_gas_margin protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_samples    protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_fees       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_gasestimate_v1beta1_query_proto_init()
+	md_EstimateGasResponse = File_cosmos_base_gasestimate_v1beta1_query_proto.Messages().ByName("EstimateGasResponse")
+	fd_EstimateGasResponse_gas_used = md_EstimateGasResponse.Fields().ByName("gas_used")
+	fd_EstimateGasResponse_msgs = md_EstimateGasResponse.Fields().ByName("msgs")
+	fd_EstimateGasResponse_gas_limit = md_EstimateGasResponse.Fields().ByName("gas_limit")
+	fd_EstimateGasResponse_gas_margin = md_EstimateGasResponse.Fields().ByName("gas_margin")
+	fd_EstimateGasResponse_samples = md_EstimateGasResponse.Fields().ByName("samples")
+	fd_EstimateGasResponse_fees = md_EstimateGasResponse.Fields().ByName("fees")
+}
+
+var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
+
+type fastReflection_EstimateGasResponse EstimateGasResponse
+
+func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(x)
+}
+
+func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
+var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
+
+type fastReflection_EstimateGasResponse_messageType struct{}
+
+func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(nil)
+}
+func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
+}
+func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
+	return _fastReflection_EstimateGasResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
+	return (*EstimateGasResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_EstimateGasResponse_gas_used, value) {
+			return
+		}
+	}
+	if len(x.Msgs) != 0 {
+		value := protoreflect.ValueOfList(&_EstimateGasResponse_2_list{list: &x.Msgs})
+		if !f(fd_EstimateGasResponse_msgs, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_EstimateGasResponse_gas_limit, value) {
+			return
+		}
+	}
+	if x.GasMargin != "" {
+		value := protoreflect.ValueOfString(x.GasMargin)
+		if !f(fd_EstimateGasResponse_gas_margin, value) {
+			return
+		}
+	}
+	if x.Samples != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Samples)
+		if !f(fd_EstimateGasResponse_samples, value) {
+			return
+		}
+	}
+	if len(x.Fees) != 0 {
+		value := protoreflect.ValueOfList(&_EstimateGasResponse_6_list{list: &x.Fees})
+		if !f(fd_EstimateGasResponse_fees, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_used":
+		return x.GasUsed != uint64(0)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.msgs":
+		return len(x.Msgs) != 0
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_margin":
+		return x.GasMargin != ""
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.samples":
+		return x.Samples != uint64(0)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.fees":
+		return len(x.Fees) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_used":
+		x.GasUsed = uint64(0)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.msgs":
+		x.Msgs = nil
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_limit":
+		x.GasLimit = uint64(0)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_margin":
+		x.GasMargin = ""
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.samples":
+		x.Samples = uint64(0)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.fees":
+		x.Fees = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.msgs":
+		if len(x.Msgs) == 0 {
+			return protoreflect.ValueOfList(&_EstimateGasResponse_2_list{})
+		}
+		listValue := &_EstimateGasResponse_2_list{list: &x.Msgs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_margin":
+		value := x.GasMargin
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.samples":
+		value := x.Samples
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.fees":
+		if len(x.Fees) == 0 {
+			return protoreflect.ValueOfList(&_EstimateGasResponse_6_list{})
+		}
+		listValue := &_EstimateGasResponse_6_list{list: &x.Fees}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_used":
+		x.GasUsed = value.Uint()
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.msgs":
+		lv := value.List()
+		clv := lv.(*_EstimateGasResponse_2_list)
+		x.Msgs = *clv.list
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_limit":
+		x.GasLimit = value.Uint()
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_margin":
+		x.GasMargin = value.Interface().(string)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.samples":
+		x.Samples = value.Uint()
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.fees":
+		lv := value.List()
+		clv := lv.(*_EstimateGasResponse_6_list)
+		x.Fees = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.msgs":
+		if x.Msgs == nil {
+			x.Msgs = []*v1beta1.MsgGasProfile{}
+		}
+		value := &_EstimateGasResponse_2_list{list: &x.Msgs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.fees":
+		if x.Fees == nil {
+			x.Fees = []*FeeSuggestion{}
+		}
+		value := &_EstimateGasResponse_6_list{list: &x.Fees}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_used":
+		panic(fmt.Errorf("field gas_used of message cosmos.base.gasestimate.v1beta1.EstimateGasResponse is not mutable"))
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message cosmos.base.gasestimate.v1beta1.EstimateGasResponse is not mutable"))
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_margin":
+		panic(fmt.Errorf("field gas_margin of message cosmos.base.gasestimate.v1beta1.EstimateGasResponse is not mutable"))
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.samples":
+		panic(fmt.Errorf("field samples of message cosmos.base.gasestimate.v1beta1.EstimateGasResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.msgs":
+		list := []*v1beta1.MsgGasProfile{}
+		return protoreflect.ValueOfList(&_EstimateGasResponse_2_list{list: &list})
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.gas_margin":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.samples":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.gasestimate.v1beta1.EstimateGasResponse.fees":
+		list := []*FeeSuggestion{}
+		return protoreflect.ValueOfList(&_EstimateGasResponse_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.gasestimate.v1beta1.EstimateGasResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EstimateGasResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EstimateGasResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
+		if len(x.Msgs) > 0 {
+			for _, e := range x.Msgs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		l = len(x.GasMargin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Samples != 0 {
+			n += 1 + runtime.Sov(uint64(x.Samples))
+		}
+		if len(x.Fees) > 0 {
+			for _, e := range x.Fees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EstimateGasResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fees) > 0 {
+			for iNdEx := len(x.Fees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Samples != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Samples))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.GasMargin) > 0 {
+			i -= len(x.GasMargin)
+			copy(dAtA[i:], x.GasMargin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasMargin)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Msgs) > 0 {
+			for iNdEx := len(x.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Msgs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EstimateGasResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Msgs = append(x.Msgs, &v1beta1.MsgGasProfile{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msgs[len(x.Msgs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasMargin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasMargin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+				}
+				x.Samples = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Samples |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fees = append(x.Fees, &FeeSuggestion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees[len(x.Fees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeeSuggestion_2_list)(nil)
+
+type _FeeSuggestion_2_list struct {
+	list *[]*v1beta11.DecCoin
+}
+
+func (x *_FeeSuggestion_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeSuggestion_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeSuggestion_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeSuggestion_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeSuggestion_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSuggestion_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeSuggestion_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSuggestion_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeeSuggestion_3_list)(nil)
+
+type _FeeSuggestion_3_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_FeeSuggestion_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeeSuggestion_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeeSuggestion_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeeSuggestion_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeeSuggestion_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSuggestion_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeeSuggestion_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeeSuggestion_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeeSuggestion            protoreflect.MessageDescriptor
+	fd_FeeSuggestion_percentile protoreflect.FieldDescriptor
+	fd_FeeSuggestion_gas_prices protoreflect.FieldDescriptor
+	fd_FeeSuggestion_fees       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_gasestimate_v1beta1_query_proto_init()
+	md_FeeSuggestion = File_cosmos_base_gasestimate_v1beta1_query_proto.Messages().ByName("FeeSuggestion")
+	fd_FeeSuggestion_percentile = md_FeeSuggestion.Fields().ByName("percentile")
+	fd_FeeSuggestion_gas_prices = md_FeeSuggestion.Fields().ByName("gas_prices")
+	fd_FeeSuggestion_fees = md_FeeSuggestion.Fields().ByName("fees")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeSuggestion)(nil)
+
+type fastReflection_FeeSuggestion FeeSuggestion
+
+func (x *FeeSuggestion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSuggestion)(x)
+}
+
+func (x *FeeSuggestion) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeSuggestion_messageType fastReflection_FeeSuggestion_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSuggestion_messageType{}
+
+type fastReflection_FeeSuggestion_messageType struct{}
+
+func (x fastReflection_FeeSuggestion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSuggestion)(nil)
+}
+func (x fastReflection_FeeSuggestion_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSuggestion)
+}
+func (x fastReflection_FeeSuggestion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSuggestion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeSuggestion) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSuggestion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeSuggestion) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSuggestion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeSuggestion) New() protoreflect.Message {
+	return new(fastReflection_FeeSuggestion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeSuggestion) Interface() protoreflect.ProtoMessage {
+	return (*FeeSuggestion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeSuggestion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Percentile != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Percentile)
+		if !f(fd_FeeSuggestion_percentile, value) {
+			return
+		}
+	}
+	if len(x.GasPrices) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSuggestion_2_list{list: &x.GasPrices})
+		if !f(fd_FeeSuggestion_gas_prices, value) {
+			return
+		}
+	}
+	if len(x.Fees) != 0 {
+		value := protoreflect.ValueOfList(&_FeeSuggestion_3_list{list: &x.Fees})
+		if !f(fd_FeeSuggestion_fees, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeSuggestion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.percentile":
+		return x.Percentile != uint32(0)
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.gas_prices":
+		return len(x.GasPrices) != 0
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.fees":
+		return len(x.Fees) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.FeeSuggestion"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.FeeSuggestion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSuggestion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.percentile":
+		x.Percentile = uint32(0)
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.gas_prices":
+		x.GasPrices = nil
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.fees":
+		x.Fees = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.FeeSuggestion"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.FeeSuggestion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeSuggestion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.percentile":
+		value := x.Percentile
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.gas_prices":
+		if len(x.GasPrices) == 0 {
+			return protoreflect.ValueOfList(&_FeeSuggestion_2_list{})
+		}
+		listValue := &_FeeSuggestion_2_list{list: &x.GasPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.fees":
+		if len(x.Fees) == 0 {
+			return protoreflect.ValueOfList(&_FeeSuggestion_3_list{})
+		}
+		listValue := &_FeeSuggestion_3_list{list: &x.Fees}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.FeeSuggestion"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.FeeSuggestion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSuggestion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.percentile":
+		x.Percentile = uint32(value.Uint())
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.gas_prices":
+		lv := value.List()
+		clv := lv.(*_FeeSuggestion_2_list)
+		x.GasPrices = *clv.list
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.fees":
+		lv := value.List()
+		clv := lv.(*_FeeSuggestion_3_list)
+		x.Fees = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.FeeSuggestion"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.FeeSuggestion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSuggestion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.gas_prices":
+		if x.GasPrices == nil {
+			x.GasPrices = []*v1beta11.DecCoin{}
+		}
+		value := &_FeeSuggestion_2_list{list: &x.GasPrices}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.fees":
+		if x.Fees == nil {
+			x.Fees = []*v1beta11.Coin{}
+		}
+		value := &_FeeSuggestion_3_list{list: &x.Fees}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.percentile":
+		panic(fmt.Errorf("field percentile of message cosmos.base.gasestimate.v1beta1.FeeSuggestion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.FeeSuggestion"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.FeeSuggestion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeSuggestion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.percentile":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.gas_prices":
+		list := []*v1beta11.DecCoin{}
+		return protoreflect.ValueOfList(&_FeeSuggestion_2_list{list: &list})
+	case "cosmos.base.gasestimate.v1beta1.FeeSuggestion.fees":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_FeeSuggestion_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.gasestimate.v1beta1.FeeSuggestion"))
+		}
+		panic(fmt.Errorf("message cosmos.base.gasestimate.v1beta1.FeeSuggestion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeSuggestion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.gasestimate.v1beta1.FeeSuggestion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeSuggestion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSuggestion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeSuggestion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeSuggestion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeSuggestion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Percentile != 0 {
+			n += 1 + runtime.Sov(uint64(x.Percentile))
+		}
+		if len(x.GasPrices) > 0 {
+			for _, e := range x.GasPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Fees) > 0 {
+			for _, e := range x.Fees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSuggestion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fees) > 0 {
+			for iNdEx := len(x.Fees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.GasPrices) > 0 {
+			for iNdEx := len(x.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GasPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Percentile != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Percentile))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSuggestion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSuggestion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSuggestion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+				}
+				x.Percentile = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Percentile |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasPrices = append(x.GasPrices, &v1beta11.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasPrices[len(x.GasPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fees = append(x.Fees, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees[len(x.Fees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/base/gasestimate/v1beta1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EstimateGasRequest defines the request structure for the EstimateGas gRPC query.
+type EstimateGasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx_bytes is the raw transaction to estimate. As for a simulation, its
+	// signatures do not need to be valid.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// percentiles are the percentiles, between 1 and 100, of the gas prices of
+	// the recently included transactions to suggest fees for. Defaults to 25, 50
+	// and 75.
+	Percentiles []uint32 `protobuf:"varint,2,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (x *EstimateGasRequest) Reset() {
+	*x = EstimateGasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateGasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateGasRequest) ProtoMessage() {}
+
+// Deprecated: Use EstimateGasRequest.ProtoReflect.Descriptor instead.
+func (*EstimateGasRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EstimateGasRequest) GetTxBytes() []byte {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+func (x *EstimateGasRequest) GetPercentiles() []uint32 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+// EstimateGasResponse defines the response structure for the EstimateGas gRPC query.
+type EstimateGasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gas_used is the gas consumed by the simulated transaction.
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// msgs are the gas profiles of the messages of the transaction, per message
+	// type. The gas consumed outside of the messages, e.g. by the signature
+	// verification, is not part of them.
+	Msgs []*v1beta1.MsgGasProfile `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// gas_limit is the suggested gas limit of the transaction: the gas used
+	// increased by the gas margin.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_margin is the margin applied to the gas used, from the variance of the
+	// gas used by the recently included transactions with the same message
+	// types.
+	GasMargin string `protobuf:"bytes,4,opt,name=gas_margin,json=gasMargin,proto3" json:"gas_margin,omitempty"`
+	// samples is the number of recently included transactions the gas margin
+	// is computed from. A default margin is used without enough samples.
+	Samples uint64 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	// fees are the suggested fees of the transaction for each percentile. It is
+	// empty if no transaction paying fees was recently included.
+	Fees []*FeeSuggestion `protobuf:"bytes,6,rep,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (x *EstimateGasResponse) Reset() {
+	*x = EstimateGasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateGasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateGasResponse) ProtoMessage() {}
+
+// Deprecated: Use EstimateGasResponse.ProtoReflect.Descriptor instead.
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EstimateGasResponse) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *EstimateGasResponse) GetMsgs() []*v1beta1.MsgGasProfile {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *EstimateGasResponse) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *EstimateGasResponse) GetGasMargin() string {
+	if x != nil {
+		return x.GasMargin
+	}
+	return ""
+}
+
+func (x *EstimateGasResponse) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *EstimateGasResponse) GetFees() []*FeeSuggestion {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+// FeeSuggestion is the fee suggested for a transaction from a percentile of the
+// gas prices of the recently included transactions.
+type FeeSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percentile uint32 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// gas_prices are the percentile of the gas prices, per fee denom.
+	GasPrices []*v1beta11.DecCoin `protobuf:"bytes,2,rep,name=gas_prices,json=gasPrices,proto3" json:"gas_prices,omitempty"`
+	// fees are the gas prices multiplied by the suggested gas limit, rounded up.
+	Fees []*v1beta11.Coin `protobuf:"bytes,3,rep,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (x *FeeSuggestion) Reset() {
+	*x = FeeSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSuggestion) ProtoMessage() {}
+
+// Deprecated: Use FeeSuggestion.ProtoReflect.Descriptor instead.
+func (*FeeSuggestion) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeeSuggestion) GetPercentile() uint32 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *FeeSuggestion) GetGasPrices() []*v1beta11.DecCoin {
+	if x != nil {
+		return x.GasPrices
+	}
+	return nil
+}
+
+func (x *FeeSuggestion) GetFees() []*v1beta11.Coin {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+var File_cosmos_base_gasestimate_v1beta1_query_proto protoreflect.FileDescriptor
+
+var file_cosmos_base_gasestimate_v1beta1_query_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x67, 0x61,
+	0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x61, 0x73, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x67, 0x61, 0x73, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x12, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xc0, 0x02, 0x0a,
+	0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x41, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x61, 0x73, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x47, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x6d, 0x73,
+	0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x50, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x67, 0x61, 0x73, 0x4d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x04, 0x66,
+	0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22,
+	0x82, 0x02, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x12, 0x70, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x04,
+	0x66, 0x65, 0x65, 0x73, 0x32, 0xba, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0xae, 0x01, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73,
+	0x12, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x67,
+	0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x67, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x67, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x42, 0x95, 0x02, 0x0a, 0x23, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x67, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x67, 0x61, 0x73, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43,
+	0x42, 0x47, 0xaa, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x2e, 0x47, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61,
+	0x73, 0x65, 0x5c, 0x47, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x2b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x42, 0x61, 0x73, 0x65, 0x5c, 0x47, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42,
+	0x61, 0x73, 0x65, 0x3a, 0x3a, 0x47, 0x61, 0x73, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescOnce sync.Once
+	file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescData = file_cosmos_base_gasestimate_v1beta1_query_proto_rawDesc
+)
+
+func file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescGZIP() []byte {
+	file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescOnce.Do(func() {
+		file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescData)
+	})
+	return file_cosmos_base_gasestimate_v1beta1_query_proto_rawDescData
+}
+
+var file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_base_gasestimate_v1beta1_query_proto_goTypes = []interface{}{
+	(*EstimateGasRequest)(nil),    // 0: cosmos.base.gasestimate.v1beta1.EstimateGasRequest
+	(*EstimateGasResponse)(nil),   // 1: cosmos.base.gasestimate.v1beta1.EstimateGasResponse
+	(*FeeSuggestion)(nil),         // 2: cosmos.base.gasestimate.v1beta1.FeeSuggestion
+	(*v1beta1.MsgGasProfile)(nil), // 3: cosmos.base.gasprofile.v1beta1.MsgGasProfile
+	(*v1beta11.DecCoin)(nil),      // 4: cosmos.base.v1beta1.DecCoin
+	(*v1beta11.Coin)(nil),         // 5: cosmos.base.v1beta1.Coin
+}
+var file_cosmos_base_gasestimate_v1beta1_query_proto_depIdxs = []int32{
+	3, // 0: cosmos.base.gasestimate.v1beta1.EstimateGasResponse.msgs:type_name -> cosmos.base.gasprofile.v1beta1.MsgGasProfile
+	2, // 1: cosmos.base.gasestimate.v1beta1.EstimateGasResponse.fees:type_name -> cosmos.base.gasestimate.v1beta1.FeeSuggestion
+	4, // 2: cosmos.base.gasestimate.v1beta1.FeeSuggestion.gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
+	5, // 3: cosmos.base.gasestimate.v1beta1.FeeSuggestion.fees:type_name -> cosmos.base.v1beta1.Coin
+	0, // 4: cosmos.base.gasestimate.v1beta1.Service.EstimateGas:input_type -> cosmos.base.gasestimate.v1beta1.EstimateGasRequest
+	1, // 5: cosmos.base.gasestimate.v1beta1.Service.EstimateGas:output_type -> cosmos.base.gasestimate.v1beta1.EstimateGasResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_base_gasestimate_v1beta1_query_proto_init() }
+func file_cosmos_base_gasestimate_v1beta1_query_proto_init() {
+	if File_cosmos_base_gasestimate_v1beta1_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSuggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_base_gasestimate_v1beta1_query_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cosmos_base_gasestimate_v1beta1_query_proto_goTypes,
+		DependencyIndexes: file_cosmos_base_gasestimate_v1beta1_query_proto_depIdxs,
+		MessageInfos:      file_cosmos_base_gasestimate_v1beta1_query_proto_msgTypes,
+	}.Build()
+	File_cosmos_base_gasestimate_v1beta1_query_proto = out.File
+	file_cosmos_base_gasestimate_v1beta1_query_proto_rawDesc = nil
+	file_cosmos_base_gasestimate_v1beta1_query_proto_goTypes = nil
+	file_cosmos_base_gasestimate_v1beta1_query_proto_depIdxs = nil
+}
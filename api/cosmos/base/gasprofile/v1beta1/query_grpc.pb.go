@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/base/gasprofile/v1beta1/query.proto
+
+package gasprofilev1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Service_GasProfile_FullMethodName  = "/cosmos.base.gasprofile.v1beta1.Service/GasProfile"
+	Service_ReplayBlock_FullMethodName = "/cosmos.base.gasprofile.v1beta1.Service/ReplayBlock"
+)
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Service defines the gRPC querier service for the gas profile of a node.
+type ServiceClient interface {
+	// GasProfile queries the gas profile aggregated over the blocks finalized by
+	// the node since the profiler was enabled.
+	GasProfile(ctx context.Context, in *GasProfileRequest, opts ...grpc.CallOption) (*GasProfileResponse, error)
+	// ReplayBlock re-executes the transactions of a block on the state of the
+	// previous height and returns their gas profile. State changes are discarded.
+	// It is disabled unless enabled in the node configuration.
+	ReplayBlock(ctx context.Context, in *ReplayBlockRequest, opts ...grpc.CallOption) (*ReplayBlockResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) GasProfile(ctx context.Context, in *GasProfileRequest, opts ...grpc.CallOption) (*GasProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GasProfileResponse)
+	err := c.cc.Invoke(ctx, Service_GasProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ReplayBlock(ctx context.Context, in *ReplayBlockRequest, opts ...grpc.CallOption) (*ReplayBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayBlockResponse)
+	err := c.cc.Invoke(ctx, Service_ReplayBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility.
+//
+// Service defines the gRPC querier service for the gas profile of a node.
+type ServiceServer interface {
+	// GasProfile queries the gas profile aggregated over the blocks finalized by
+	// the node since the profiler was enabled.
+	GasProfile(context.Context, *GasProfileRequest) (*GasProfileResponse, error)
+	// ReplayBlock re-executes the transactions of a block on the state of the
+	// previous height and returns their gas profile. State changes are discarded.
+	// It is disabled unless enabled in the node configuration.
+	ReplayBlock(context.Context, *ReplayBlockRequest) (*ReplayBlockResponse, error)
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceServer struct{}
+
+func (UnimplementedServiceServer) GasProfile(context.Context, *GasProfileRequest) (*GasProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasProfile not implemented")
+}
+func (UnimplementedServiceServer) ReplayBlock(context.Context, *ReplayBlockRequest) (*ReplayBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayBlock not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+func (UnimplementedServiceServer) testEmbeddedByValue()                 {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	// If the following call pancis, it indicates UnimplementedServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_GasProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GasProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GasProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GasProfile(ctx, req.(*GasProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ReplayBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ReplayBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ReplayBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ReplayBlock(ctx, req.(*ReplayBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.gasprofile.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GasProfile",
+			Handler:    _Service_GasProfile_Handler,
+		},
+		{
+			MethodName: "ReplayBlock",
+			Handler:    _Service_ReplayBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/gasprofile/v1beta1/query.proto",
+}
@@ -0,0 +1,5989 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package frostv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_KeyShare_6_list)(nil)
+
+type _KeyShare_6_list struct {
+	list *[]*VerifyingShare
+}
+
+func (x *_KeyShare_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_KeyShare_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_KeyShare_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerifyingShare)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_KeyShare_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerifyingShare)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_KeyShare_6_list) AppendMutable() protoreflect.Value {
+	v := new(VerifyingShare)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_KeyShare_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_KeyShare_6_list) NewElement() protoreflect.Value {
+	v := new(VerifyingShare)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_KeyShare_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_KeyShare                  protoreflect.MessageDescriptor
+	fd_KeyShare_ciphersuite      protoreflect.FieldDescriptor
+	fd_KeyShare_identifier       protoreflect.FieldDescriptor
+	fd_KeyShare_min_signers      protoreflect.FieldDescriptor
+	fd_KeyShare_signing_share    protoreflect.FieldDescriptor
+	fd_KeyShare_group_public_key protoreflect.FieldDescriptor
+	fd_KeyShare_verifying_shares protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_KeyShare = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("KeyShare")
+	fd_KeyShare_ciphersuite = md_KeyShare.Fields().ByName("ciphersuite")
+	fd_KeyShare_identifier = md_KeyShare.Fields().ByName("identifier")
+	fd_KeyShare_min_signers = md_KeyShare.Fields().ByName("min_signers")
+	fd_KeyShare_signing_share = md_KeyShare.Fields().ByName("signing_share")
+	fd_KeyShare_group_public_key = md_KeyShare.Fields().ByName("group_public_key")
+	fd_KeyShare_verifying_shares = md_KeyShare.Fields().ByName("verifying_shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_KeyShare)(nil)
+
+type fastReflection_KeyShare KeyShare
+
+func (x *KeyShare) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KeyShare)(x)
+}
+
+func (x *KeyShare) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KeyShare_messageType fastReflection_KeyShare_messageType
+var _ protoreflect.MessageType = fastReflection_KeyShare_messageType{}
+
+type fastReflection_KeyShare_messageType struct{}
+
+func (x fastReflection_KeyShare_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KeyShare)(nil)
+}
+func (x fastReflection_KeyShare_messageType) New() protoreflect.Message {
+	return new(fastReflection_KeyShare)
+}
+func (x fastReflection_KeyShare_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyShare
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KeyShare) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyShare
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KeyShare) Type() protoreflect.MessageType {
+	return _fastReflection_KeyShare_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KeyShare) New() protoreflect.Message {
+	return new(fastReflection_KeyShare)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KeyShare) Interface() protoreflect.ProtoMessage {
+	return (*KeyShare)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KeyShare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Ciphersuite != "" {
+		value := protoreflect.ValueOfString(x.Ciphersuite)
+		if !f(fd_KeyShare_ciphersuite, value) {
+			return
+		}
+	}
+	if x.Identifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Identifier)
+		if !f(fd_KeyShare_identifier, value) {
+			return
+		}
+	}
+	if x.MinSigners != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinSigners)
+		if !f(fd_KeyShare_min_signers, value) {
+			return
+		}
+	}
+	if len(x.SigningShare) != 0 {
+		value := protoreflect.ValueOfBytes(x.SigningShare)
+		if !f(fd_KeyShare_signing_share, value) {
+			return
+		}
+	}
+	if len(x.GroupPublicKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.GroupPublicKey)
+		if !f(fd_KeyShare_group_public_key, value) {
+			return
+		}
+	}
+	if len(x.VerifyingShares) != 0 {
+		value := protoreflect.ValueOfList(&_KeyShare_6_list{list: &x.VerifyingShares})
+		if !f(fd_KeyShare_verifying_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KeyShare) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.KeyShare.ciphersuite":
+		return x.Ciphersuite != ""
+	case "cosmos.crypto.frost.v1.KeyShare.identifier":
+		return x.Identifier != uint32(0)
+	case "cosmos.crypto.frost.v1.KeyShare.min_signers":
+		return x.MinSigners != uint32(0)
+	case "cosmos.crypto.frost.v1.KeyShare.signing_share":
+		return len(x.SigningShare) != 0
+	case "cosmos.crypto.frost.v1.KeyShare.group_public_key":
+		return len(x.GroupPublicKey) != 0
+	case "cosmos.crypto.frost.v1.KeyShare.verifying_shares":
+		return len(x.VerifyingShares) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.KeyShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.KeyShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyShare) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.KeyShare.ciphersuite":
+		x.Ciphersuite = ""
+	case "cosmos.crypto.frost.v1.KeyShare.identifier":
+		x.Identifier = uint32(0)
+	case "cosmos.crypto.frost.v1.KeyShare.min_signers":
+		x.MinSigners = uint32(0)
+	case "cosmos.crypto.frost.v1.KeyShare.signing_share":
+		x.SigningShare = nil
+	case "cosmos.crypto.frost.v1.KeyShare.group_public_key":
+		x.GroupPublicKey = nil
+	case "cosmos.crypto.frost.v1.KeyShare.verifying_shares":
+		x.VerifyingShares = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.KeyShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.KeyShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_KeyShare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.KeyShare.ciphersuite":
+		value := x.Ciphersuite
+		return protoreflect.ValueOfString(value)
+	case "cosmos.crypto.frost.v1.KeyShare.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.KeyShare.min_signers":
+		value := x.MinSigners
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.KeyShare.signing_share":
+		value := x.SigningShare
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crypto.frost.v1.KeyShare.group_public_key":
+		value := x.GroupPublicKey
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crypto.frost.v1.KeyShare.verifying_shares":
+		if len(x.VerifyingShares) == 0 {
+			return protoreflect.ValueOfList(&_KeyShare_6_list{})
+		}
+		listValue := &_KeyShare_6_list{list: &x.VerifyingShares}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.KeyShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.KeyShare does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyShare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.KeyShare.ciphersuite":
+		x.Ciphersuite = value.Interface().(string)
+	case "cosmos.crypto.frost.v1.KeyShare.identifier":
+		x.Identifier = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.KeyShare.min_signers":
+		x.MinSigners = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.KeyShare.signing_share":
+		x.SigningShare = value.Bytes()
+	case "cosmos.crypto.frost.v1.KeyShare.group_public_key":
+		x.GroupPublicKey = value.Bytes()
+	case "cosmos.crypto.frost.v1.KeyShare.verifying_shares":
+		lv := value.List()
+		clv := lv.(*_KeyShare_6_list)
+		x.VerifyingShares = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.KeyShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.KeyShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyShare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.KeyShare.verifying_shares":
+		if x.VerifyingShares == nil {
+			x.VerifyingShares = []*VerifyingShare{}
+		}
+		value := &_KeyShare_6_list{list: &x.VerifyingShares}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.crypto.frost.v1.KeyShare.ciphersuite":
+		panic(fmt.Errorf("field ciphersuite of message cosmos.crypto.frost.v1.KeyShare is not mutable"))
+	case "cosmos.crypto.frost.v1.KeyShare.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.crypto.frost.v1.KeyShare is not mutable"))
+	case "cosmos.crypto.frost.v1.KeyShare.min_signers":
+		panic(fmt.Errorf("field min_signers of message cosmos.crypto.frost.v1.KeyShare is not mutable"))
+	case "cosmos.crypto.frost.v1.KeyShare.signing_share":
+		panic(fmt.Errorf("field signing_share of message cosmos.crypto.frost.v1.KeyShare is not mutable"))
+	case "cosmos.crypto.frost.v1.KeyShare.group_public_key":
+		panic(fmt.Errorf("field group_public_key of message cosmos.crypto.frost.v1.KeyShare is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.KeyShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.KeyShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_KeyShare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.KeyShare.ciphersuite":
+		return protoreflect.ValueOfString("")
+	case "cosmos.crypto.frost.v1.KeyShare.identifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.KeyShare.min_signers":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.KeyShare.signing_share":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crypto.frost.v1.KeyShare.group_public_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crypto.frost.v1.KeyShare.verifying_shares":
+		list := []*VerifyingShare{}
+		return protoreflect.ValueOfList(&_KeyShare_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.KeyShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.KeyShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_KeyShare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.KeyShare", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_KeyShare) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyShare) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_KeyShare) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_KeyShare) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*KeyShare)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Ciphersuite)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Identifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Identifier))
+		}
+		if x.MinSigners != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinSigners))
+		}
+		l = len(x.SigningShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GroupPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.VerifyingShares) > 0 {
+			for _, e := range x.VerifyingShares {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*KeyShare)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VerifyingShares) > 0 {
+			for iNdEx := len(x.VerifyingShares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VerifyingShares[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.GroupPublicKey) > 0 {
+			i -= len(x.GroupPublicKey)
+			copy(dAtA[i:], x.GroupPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPublicKey)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SigningShare) > 0 {
+			i -= len(x.SigningShare)
+			copy(dAtA[i:], x.SigningShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningShare)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.MinSigners != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinSigners))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Identifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Identifier))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Ciphersuite) > 0 {
+			i -= len(x.Ciphersuite)
+			copy(dAtA[i:], x.Ciphersuite)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ciphersuite)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*KeyShare)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyShare: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ciphersuite", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ciphersuite = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				x.Identifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Identifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSigners", wireType)
+				}
+				x.MinSigners = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinSigners |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningShare", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningShare = append(x.SigningShare[:0], dAtA[iNdEx:postIndex]...)
+				if x.SigningShare == nil {
+					x.SigningShare = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPublicKey = append(x.GroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.GroupPublicKey == nil {
+					x.GroupPublicKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifyingShares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerifyingShares = append(x.VerifyingShares, &VerifyingShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerifyingShares[len(x.VerifyingShares)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VerifyingShare                 protoreflect.MessageDescriptor
+	fd_VerifyingShare_identifier      protoreflect.FieldDescriptor
+	fd_VerifyingShare_verifying_share protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_VerifyingShare = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("VerifyingShare")
+	fd_VerifyingShare_identifier = md_VerifyingShare.Fields().ByName("identifier")
+	fd_VerifyingShare_verifying_share = md_VerifyingShare.Fields().ByName("verifying_share")
+}
+
+var _ protoreflect.Message = (*fastReflection_VerifyingShare)(nil)
+
+type fastReflection_VerifyingShare VerifyingShare
+
+func (x *VerifyingShare) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VerifyingShare)(x)
+}
+
+func (x *VerifyingShare) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VerifyingShare_messageType fastReflection_VerifyingShare_messageType
+var _ protoreflect.MessageType = fastReflection_VerifyingShare_messageType{}
+
+type fastReflection_VerifyingShare_messageType struct{}
+
+func (x fastReflection_VerifyingShare_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VerifyingShare)(nil)
+}
+func (x fastReflection_VerifyingShare_messageType) New() protoreflect.Message {
+	return new(fastReflection_VerifyingShare)
+}
+func (x fastReflection_VerifyingShare_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerifyingShare
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VerifyingShare) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerifyingShare
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VerifyingShare) Type() protoreflect.MessageType {
+	return _fastReflection_VerifyingShare_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VerifyingShare) New() protoreflect.Message {
+	return new(fastReflection_VerifyingShare)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VerifyingShare) Interface() protoreflect.ProtoMessage {
+	return (*VerifyingShare)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VerifyingShare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Identifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Identifier)
+		if !f(fd_VerifyingShare_identifier, value) {
+			return
+		}
+	}
+	if len(x.VerifyingShare) != 0 {
+		value := protoreflect.ValueOfBytes(x.VerifyingShare)
+		if !f(fd_VerifyingShare_verifying_share, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VerifyingShare) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.VerifyingShare.identifier":
+		return x.Identifier != uint32(0)
+	case "cosmos.crypto.frost.v1.VerifyingShare.verifying_share":
+		return len(x.VerifyingShare) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.VerifyingShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.VerifyingShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerifyingShare) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.VerifyingShare.identifier":
+		x.Identifier = uint32(0)
+	case "cosmos.crypto.frost.v1.VerifyingShare.verifying_share":
+		x.VerifyingShare = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.VerifyingShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.VerifyingShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VerifyingShare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.VerifyingShare.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.VerifyingShare.verifying_share":
+		value := x.VerifyingShare
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.VerifyingShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.VerifyingShare does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerifyingShare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.VerifyingShare.identifier":
+		x.Identifier = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.VerifyingShare.verifying_share":
+		x.VerifyingShare = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.VerifyingShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.VerifyingShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerifyingShare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.VerifyingShare.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.crypto.frost.v1.VerifyingShare is not mutable"))
+	case "cosmos.crypto.frost.v1.VerifyingShare.verifying_share":
+		panic(fmt.Errorf("field verifying_share of message cosmos.crypto.frost.v1.VerifyingShare is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.VerifyingShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.VerifyingShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VerifyingShare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.VerifyingShare.identifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.VerifyingShare.verifying_share":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.VerifyingShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.VerifyingShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VerifyingShare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.VerifyingShare", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VerifyingShare) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerifyingShare) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VerifyingShare) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VerifyingShare) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VerifyingShare)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Identifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Identifier))
+		}
+		l = len(x.VerifyingShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VerifyingShare)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VerifyingShare) > 0 {
+			i -= len(x.VerifyingShare)
+			copy(dAtA[i:], x.VerifyingShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerifyingShare)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Identifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Identifier))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VerifyingShare)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerifyingShare: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerifyingShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				x.Identifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Identifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifyingShare", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerifyingShare = append(x.VerifyingShare[:0], dAtA[iNdEx:postIndex]...)
+				if x.VerifyingShare == nil {
+					x.VerifyingShare = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DKGSecretPackage_5_list)(nil)
+
+type _DKGSecretPackage_5_list struct {
+	list *[][]byte
+}
+
+func (x *_DKGSecretPackage_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGSecretPackage_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_DKGSecretPackage_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGSecretPackage_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGSecretPackage_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGSecretPackage at list field Coefficients as it is not of Message kind"))
+}
+
+func (x *_DKGSecretPackage_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGSecretPackage_5_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_DKGSecretPackage_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DKGSecretPackage              protoreflect.MessageDescriptor
+	fd_DKGSecretPackage_ciphersuite  protoreflect.FieldDescriptor
+	fd_DKGSecretPackage_identifier   protoreflect.FieldDescriptor
+	fd_DKGSecretPackage_min_signers  protoreflect.FieldDescriptor
+	fd_DKGSecretPackage_max_signers  protoreflect.FieldDescriptor
+	fd_DKGSecretPackage_coefficients protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_DKGSecretPackage = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("DKGSecretPackage")
+	fd_DKGSecretPackage_ciphersuite = md_DKGSecretPackage.Fields().ByName("ciphersuite")
+	fd_DKGSecretPackage_identifier = md_DKGSecretPackage.Fields().ByName("identifier")
+	fd_DKGSecretPackage_min_signers = md_DKGSecretPackage.Fields().ByName("min_signers")
+	fd_DKGSecretPackage_max_signers = md_DKGSecretPackage.Fields().ByName("max_signers")
+	fd_DKGSecretPackage_coefficients = md_DKGSecretPackage.Fields().ByName("coefficients")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGSecretPackage)(nil)
+
+type fastReflection_DKGSecretPackage DKGSecretPackage
+
+func (x *DKGSecretPackage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGSecretPackage)(x)
+}
+
+func (x *DKGSecretPackage) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGSecretPackage_messageType fastReflection_DKGSecretPackage_messageType
+var _ protoreflect.MessageType = fastReflection_DKGSecretPackage_messageType{}
+
+type fastReflection_DKGSecretPackage_messageType struct{}
+
+func (x fastReflection_DKGSecretPackage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGSecretPackage)(nil)
+}
+func (x fastReflection_DKGSecretPackage_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGSecretPackage)
+}
+func (x fastReflection_DKGSecretPackage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGSecretPackage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGSecretPackage) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGSecretPackage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGSecretPackage) Type() protoreflect.MessageType {
+	return _fastReflection_DKGSecretPackage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGSecretPackage) New() protoreflect.Message {
+	return new(fastReflection_DKGSecretPackage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGSecretPackage) Interface() protoreflect.ProtoMessage {
+	return (*DKGSecretPackage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGSecretPackage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Ciphersuite != "" {
+		value := protoreflect.ValueOfString(x.Ciphersuite)
+		if !f(fd_DKGSecretPackage_ciphersuite, value) {
+			return
+		}
+	}
+	if x.Identifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Identifier)
+		if !f(fd_DKGSecretPackage_identifier, value) {
+			return
+		}
+	}
+	if x.MinSigners != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinSigners)
+		if !f(fd_DKGSecretPackage_min_signers, value) {
+			return
+		}
+	}
+	if x.MaxSigners != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxSigners)
+		if !f(fd_DKGSecretPackage_max_signers, value) {
+			return
+		}
+	}
+	if len(x.Coefficients) != 0 {
+		value := protoreflect.ValueOfList(&_DKGSecretPackage_5_list{list: &x.Coefficients})
+		if !f(fd_DKGSecretPackage_coefficients, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGSecretPackage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.ciphersuite":
+		return x.Ciphersuite != ""
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.identifier":
+		return x.Identifier != uint32(0)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.min_signers":
+		return x.MinSigners != uint32(0)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.max_signers":
+		return x.MaxSigners != uint32(0)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.coefficients":
+		return len(x.Coefficients) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGSecretPackage"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGSecretPackage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGSecretPackage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.ciphersuite":
+		x.Ciphersuite = ""
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.identifier":
+		x.Identifier = uint32(0)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.min_signers":
+		x.MinSigners = uint32(0)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.max_signers":
+		x.MaxSigners = uint32(0)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.coefficients":
+		x.Coefficients = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGSecretPackage"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGSecretPackage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGSecretPackage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.ciphersuite":
+		value := x.Ciphersuite
+		return protoreflect.ValueOfString(value)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.min_signers":
+		value := x.MinSigners
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.max_signers":
+		value := x.MaxSigners
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.coefficients":
+		if len(x.Coefficients) == 0 {
+			return protoreflect.ValueOfList(&_DKGSecretPackage_5_list{})
+		}
+		listValue := &_DKGSecretPackage_5_list{list: &x.Coefficients}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGSecretPackage"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGSecretPackage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGSecretPackage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.ciphersuite":
+		x.Ciphersuite = value.Interface().(string)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.identifier":
+		x.Identifier = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.min_signers":
+		x.MinSigners = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.max_signers":
+		x.MaxSigners = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.coefficients":
+		lv := value.List()
+		clv := lv.(*_DKGSecretPackage_5_list)
+		x.Coefficients = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGSecretPackage"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGSecretPackage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGSecretPackage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.coefficients":
+		if x.Coefficients == nil {
+			x.Coefficients = [][]byte{}
+		}
+		value := &_DKGSecretPackage_5_list{list: &x.Coefficients}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.ciphersuite":
+		panic(fmt.Errorf("field ciphersuite of message cosmos.crypto.frost.v1.DKGSecretPackage is not mutable"))
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.crypto.frost.v1.DKGSecretPackage is not mutable"))
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.min_signers":
+		panic(fmt.Errorf("field min_signers of message cosmos.crypto.frost.v1.DKGSecretPackage is not mutable"))
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.max_signers":
+		panic(fmt.Errorf("field max_signers of message cosmos.crypto.frost.v1.DKGSecretPackage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGSecretPackage"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGSecretPackage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGSecretPackage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.ciphersuite":
+		return protoreflect.ValueOfString("")
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.identifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.min_signers":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.max_signers":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.DKGSecretPackage.coefficients":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_DKGSecretPackage_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGSecretPackage"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGSecretPackage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGSecretPackage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.DKGSecretPackage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGSecretPackage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGSecretPackage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGSecretPackage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGSecretPackage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGSecretPackage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Ciphersuite)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Identifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Identifier))
+		}
+		if x.MinSigners != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinSigners))
+		}
+		if x.MaxSigners != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSigners))
+		}
+		if len(x.Coefficients) > 0 {
+			for _, b := range x.Coefficients {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGSecretPackage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Coefficients) > 0 {
+			for iNdEx := len(x.Coefficients) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Coefficients[iNdEx])
+				copy(dAtA[i:], x.Coefficients[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Coefficients[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.MaxSigners != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSigners))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MinSigners != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinSigners))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Identifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Identifier))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Ciphersuite) > 0 {
+			i -= len(x.Ciphersuite)
+			copy(dAtA[i:], x.Ciphersuite)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ciphersuite)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGSecretPackage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGSecretPackage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGSecretPackage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ciphersuite", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ciphersuite = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				x.Identifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Identifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSigners", wireType)
+				}
+				x.MinSigners = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinSigners |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+				}
+				x.MaxSigners = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSigners |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coefficients", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Coefficients = append(x.Coefficients, make([]byte, postIndex-iNdEx))
+				copy(x.Coefficients[len(x.Coefficients)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DKGRound1Package_3_list)(nil)
+
+type _DKGRound1Package_3_list struct {
+	list *[][]byte
+}
+
+func (x *_DKGRound1Package_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DKGRound1Package_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_DKGRound1Package_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DKGRound1Package_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DKGRound1Package_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DKGRound1Package at list field Commitment as it is not of Message kind"))
+}
+
+func (x *_DKGRound1Package_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DKGRound1Package_3_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_DKGRound1Package_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DKGRound1Package                    protoreflect.MessageDescriptor
+	fd_DKGRound1Package_ciphersuite        protoreflect.FieldDescriptor
+	fd_DKGRound1Package_identifier         protoreflect.FieldDescriptor
+	fd_DKGRound1Package_commitment         protoreflect.FieldDescriptor
+	fd_DKGRound1Package_proof_of_knowledge protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_DKGRound1Package = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("DKGRound1Package")
+	fd_DKGRound1Package_ciphersuite = md_DKGRound1Package.Fields().ByName("ciphersuite")
+	fd_DKGRound1Package_identifier = md_DKGRound1Package.Fields().ByName("identifier")
+	fd_DKGRound1Package_commitment = md_DKGRound1Package.Fields().ByName("commitment")
+	fd_DKGRound1Package_proof_of_knowledge = md_DKGRound1Package.Fields().ByName("proof_of_knowledge")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGRound1Package)(nil)
+
+type fastReflection_DKGRound1Package DKGRound1Package
+
+func (x *DKGRound1Package) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGRound1Package)(x)
+}
+
+func (x *DKGRound1Package) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGRound1Package_messageType fastReflection_DKGRound1Package_messageType
+var _ protoreflect.MessageType = fastReflection_DKGRound1Package_messageType{}
+
+type fastReflection_DKGRound1Package_messageType struct{}
+
+func (x fastReflection_DKGRound1Package_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGRound1Package)(nil)
+}
+func (x fastReflection_DKGRound1Package_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGRound1Package)
+}
+func (x fastReflection_DKGRound1Package_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGRound1Package
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGRound1Package) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGRound1Package
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGRound1Package) Type() protoreflect.MessageType {
+	return _fastReflection_DKGRound1Package_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGRound1Package) New() protoreflect.Message {
+	return new(fastReflection_DKGRound1Package)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGRound1Package) Interface() protoreflect.ProtoMessage {
+	return (*DKGRound1Package)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGRound1Package) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Ciphersuite != "" {
+		value := protoreflect.ValueOfString(x.Ciphersuite)
+		if !f(fd_DKGRound1Package_ciphersuite, value) {
+			return
+		}
+	}
+	if x.Identifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Identifier)
+		if !f(fd_DKGRound1Package_identifier, value) {
+			return
+		}
+	}
+	if len(x.Commitment) != 0 {
+		value := protoreflect.ValueOfList(&_DKGRound1Package_3_list{list: &x.Commitment})
+		if !f(fd_DKGRound1Package_commitment, value) {
+			return
+		}
+	}
+	if len(x.ProofOfKnowledge) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProofOfKnowledge)
+		if !f(fd_DKGRound1Package_proof_of_knowledge, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGRound1Package) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound1Package.ciphersuite":
+		return x.Ciphersuite != ""
+	case "cosmos.crypto.frost.v1.DKGRound1Package.identifier":
+		return x.Identifier != uint32(0)
+	case "cosmos.crypto.frost.v1.DKGRound1Package.commitment":
+		return len(x.Commitment) != 0
+	case "cosmos.crypto.frost.v1.DKGRound1Package.proof_of_knowledge":
+		return len(x.ProofOfKnowledge) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound1Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound1Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRound1Package) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound1Package.ciphersuite":
+		x.Ciphersuite = ""
+	case "cosmos.crypto.frost.v1.DKGRound1Package.identifier":
+		x.Identifier = uint32(0)
+	case "cosmos.crypto.frost.v1.DKGRound1Package.commitment":
+		x.Commitment = nil
+	case "cosmos.crypto.frost.v1.DKGRound1Package.proof_of_knowledge":
+		x.ProofOfKnowledge = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound1Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound1Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGRound1Package) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound1Package.ciphersuite":
+		value := x.Ciphersuite
+		return protoreflect.ValueOfString(value)
+	case "cosmos.crypto.frost.v1.DKGRound1Package.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.DKGRound1Package.commitment":
+		if len(x.Commitment) == 0 {
+			return protoreflect.ValueOfList(&_DKGRound1Package_3_list{})
+		}
+		listValue := &_DKGRound1Package_3_list{list: &x.Commitment}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.crypto.frost.v1.DKGRound1Package.proof_of_knowledge":
+		value := x.ProofOfKnowledge
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound1Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound1Package does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRound1Package) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound1Package.ciphersuite":
+		x.Ciphersuite = value.Interface().(string)
+	case "cosmos.crypto.frost.v1.DKGRound1Package.identifier":
+		x.Identifier = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.DKGRound1Package.commitment":
+		lv := value.List()
+		clv := lv.(*_DKGRound1Package_3_list)
+		x.Commitment = *clv.list
+	case "cosmos.crypto.frost.v1.DKGRound1Package.proof_of_knowledge":
+		x.ProofOfKnowledge = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound1Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound1Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRound1Package) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound1Package.commitment":
+		if x.Commitment == nil {
+			x.Commitment = [][]byte{}
+		}
+		value := &_DKGRound1Package_3_list{list: &x.Commitment}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.crypto.frost.v1.DKGRound1Package.ciphersuite":
+		panic(fmt.Errorf("field ciphersuite of message cosmos.crypto.frost.v1.DKGRound1Package is not mutable"))
+	case "cosmos.crypto.frost.v1.DKGRound1Package.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.crypto.frost.v1.DKGRound1Package is not mutable"))
+	case "cosmos.crypto.frost.v1.DKGRound1Package.proof_of_knowledge":
+		panic(fmt.Errorf("field proof_of_knowledge of message cosmos.crypto.frost.v1.DKGRound1Package is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound1Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound1Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGRound1Package) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound1Package.ciphersuite":
+		return protoreflect.ValueOfString("")
+	case "cosmos.crypto.frost.v1.DKGRound1Package.identifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.DKGRound1Package.commitment":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_DKGRound1Package_3_list{list: &list})
+	case "cosmos.crypto.frost.v1.DKGRound1Package.proof_of_knowledge":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound1Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound1Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGRound1Package) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.DKGRound1Package", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGRound1Package) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRound1Package) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGRound1Package) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGRound1Package) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGRound1Package)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Ciphersuite)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Identifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Identifier))
+		}
+		if len(x.Commitment) > 0 {
+			for _, b := range x.Commitment {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ProofOfKnowledge)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGRound1Package)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProofOfKnowledge) > 0 {
+			i -= len(x.ProofOfKnowledge)
+			copy(dAtA[i:], x.ProofOfKnowledge)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProofOfKnowledge)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Commitment) > 0 {
+			for iNdEx := len(x.Commitment) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Commitment[iNdEx])
+				copy(dAtA[i:], x.Commitment[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Identifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Identifier))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Ciphersuite) > 0 {
+			i -= len(x.Ciphersuite)
+			copy(dAtA[i:], x.Ciphersuite)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ciphersuite)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGRound1Package)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRound1Package: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRound1Package: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ciphersuite", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ciphersuite = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				x.Identifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Identifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = append(x.Commitment, make([]byte, postIndex-iNdEx))
+				copy(x.Commitment[len(x.Commitment)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofOfKnowledge", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProofOfKnowledge = append(x.ProofOfKnowledge[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProofOfKnowledge == nil {
+					x.ProofOfKnowledge = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DKGRound2Package               protoreflect.MessageDescriptor
+	fd_DKGRound2Package_sender        protoreflect.FieldDescriptor
+	fd_DKGRound2Package_recipient     protoreflect.FieldDescriptor
+	fd_DKGRound2Package_signing_share protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_DKGRound2Package = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("DKGRound2Package")
+	fd_DKGRound2Package_sender = md_DKGRound2Package.Fields().ByName("sender")
+	fd_DKGRound2Package_recipient = md_DKGRound2Package.Fields().ByName("recipient")
+	fd_DKGRound2Package_signing_share = md_DKGRound2Package.Fields().ByName("signing_share")
+}
+
+var _ protoreflect.Message = (*fastReflection_DKGRound2Package)(nil)
+
+type fastReflection_DKGRound2Package DKGRound2Package
+
+func (x *DKGRound2Package) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DKGRound2Package)(x)
+}
+
+func (x *DKGRound2Package) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DKGRound2Package_messageType fastReflection_DKGRound2Package_messageType
+var _ protoreflect.MessageType = fastReflection_DKGRound2Package_messageType{}
+
+type fastReflection_DKGRound2Package_messageType struct{}
+
+func (x fastReflection_DKGRound2Package_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DKGRound2Package)(nil)
+}
+func (x fastReflection_DKGRound2Package_messageType) New() protoreflect.Message {
+	return new(fastReflection_DKGRound2Package)
+}
+func (x fastReflection_DKGRound2Package_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGRound2Package
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DKGRound2Package) Descriptor() protoreflect.MessageDescriptor {
+	return md_DKGRound2Package
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DKGRound2Package) Type() protoreflect.MessageType {
+	return _fastReflection_DKGRound2Package_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DKGRound2Package) New() protoreflect.Message {
+	return new(fastReflection_DKGRound2Package)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DKGRound2Package) Interface() protoreflect.ProtoMessage {
+	return (*DKGRound2Package)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DKGRound2Package) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Sender)
+		if !f(fd_DKGRound2Package_sender, value) {
+			return
+		}
+	}
+	if x.Recipient != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Recipient)
+		if !f(fd_DKGRound2Package_recipient, value) {
+			return
+		}
+	}
+	if len(x.SigningShare) != 0 {
+		value := protoreflect.ValueOfBytes(x.SigningShare)
+		if !f(fd_DKGRound2Package_signing_share, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DKGRound2Package) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound2Package.sender":
+		return x.Sender != uint32(0)
+	case "cosmos.crypto.frost.v1.DKGRound2Package.recipient":
+		return x.Recipient != uint32(0)
+	case "cosmos.crypto.frost.v1.DKGRound2Package.signing_share":
+		return len(x.SigningShare) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound2Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound2Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRound2Package) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound2Package.sender":
+		x.Sender = uint32(0)
+	case "cosmos.crypto.frost.v1.DKGRound2Package.recipient":
+		x.Recipient = uint32(0)
+	case "cosmos.crypto.frost.v1.DKGRound2Package.signing_share":
+		x.SigningShare = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound2Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound2Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DKGRound2Package) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound2Package.sender":
+		value := x.Sender
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.DKGRound2Package.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.DKGRound2Package.signing_share":
+		value := x.SigningShare
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound2Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound2Package does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRound2Package) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound2Package.sender":
+		x.Sender = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.DKGRound2Package.recipient":
+		x.Recipient = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.DKGRound2Package.signing_share":
+		x.SigningShare = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound2Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound2Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRound2Package) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound2Package.sender":
+		panic(fmt.Errorf("field sender of message cosmos.crypto.frost.v1.DKGRound2Package is not mutable"))
+	case "cosmos.crypto.frost.v1.DKGRound2Package.recipient":
+		panic(fmt.Errorf("field recipient of message cosmos.crypto.frost.v1.DKGRound2Package is not mutable"))
+	case "cosmos.crypto.frost.v1.DKGRound2Package.signing_share":
+		panic(fmt.Errorf("field signing_share of message cosmos.crypto.frost.v1.DKGRound2Package is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound2Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound2Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DKGRound2Package) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.DKGRound2Package.sender":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.DKGRound2Package.recipient":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.DKGRound2Package.signing_share":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.DKGRound2Package"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.DKGRound2Package does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DKGRound2Package) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.DKGRound2Package", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DKGRound2Package) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DKGRound2Package) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DKGRound2Package) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DKGRound2Package) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DKGRound2Package)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sender != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sender))
+		}
+		if x.Recipient != 0 {
+			n += 1 + runtime.Sov(uint64(x.Recipient))
+		}
+		l = len(x.SigningShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DKGRound2Package)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SigningShare) > 0 {
+			i -= len(x.SigningShare)
+			copy(dAtA[i:], x.SigningShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningShare)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Recipient != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Recipient))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Sender != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sender))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DKGRound2Package)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRound2Package: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DKGRound2Package: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				x.Sender = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sender |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				x.Recipient = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Recipient |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningShare", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningShare = append(x.SigningShare[:0], dAtA[iNdEx:postIndex]...)
+				if x.SigningShare == nil {
+					x.SigningShare = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SigningNonces            protoreflect.MessageDescriptor
+	fd_SigningNonces_identifier protoreflect.FieldDescriptor
+	fd_SigningNonces_hiding     protoreflect.FieldDescriptor
+	fd_SigningNonces_binding    protoreflect.FieldDescriptor
+	fd_SigningNonces_commitment protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_SigningNonces = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("SigningNonces")
+	fd_SigningNonces_identifier = md_SigningNonces.Fields().ByName("identifier")
+	fd_SigningNonces_hiding = md_SigningNonces.Fields().ByName("hiding")
+	fd_SigningNonces_binding = md_SigningNonces.Fields().ByName("binding")
+	fd_SigningNonces_commitment = md_SigningNonces.Fields().ByName("commitment")
+}
+
+var _ protoreflect.Message = (*fastReflection_SigningNonces)(nil)
+
+type fastReflection_SigningNonces SigningNonces
+
+func (x *SigningNonces) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningNonces)(x)
+}
+
+func (x *SigningNonces) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SigningNonces_messageType fastReflection_SigningNonces_messageType
+var _ protoreflect.MessageType = fastReflection_SigningNonces_messageType{}
+
+type fastReflection_SigningNonces_messageType struct{}
+
+func (x fastReflection_SigningNonces_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningNonces)(nil)
+}
+func (x fastReflection_SigningNonces_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningNonces)
+}
+func (x fastReflection_SigningNonces_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningNonces
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SigningNonces) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningNonces
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SigningNonces) Type() protoreflect.MessageType {
+	return _fastReflection_SigningNonces_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SigningNonces) New() protoreflect.Message {
+	return new(fastReflection_SigningNonces)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SigningNonces) Interface() protoreflect.ProtoMessage {
+	return (*SigningNonces)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SigningNonces) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Identifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Identifier)
+		if !f(fd_SigningNonces_identifier, value) {
+			return
+		}
+	}
+	if len(x.Hiding) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hiding)
+		if !f(fd_SigningNonces_hiding, value) {
+			return
+		}
+	}
+	if len(x.Binding) != 0 {
+		value := protoreflect.ValueOfBytes(x.Binding)
+		if !f(fd_SigningNonces_binding, value) {
+			return
+		}
+	}
+	if x.Commitment != nil {
+		value := protoreflect.ValueOfMessage(x.Commitment.ProtoReflect())
+		if !f(fd_SigningNonces_commitment, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SigningNonces) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningNonces.identifier":
+		return x.Identifier != uint32(0)
+	case "cosmos.crypto.frost.v1.SigningNonces.hiding":
+		return len(x.Hiding) != 0
+	case "cosmos.crypto.frost.v1.SigningNonces.binding":
+		return len(x.Binding) != 0
+	case "cosmos.crypto.frost.v1.SigningNonces.commitment":
+		return x.Commitment != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningNonces"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningNonces does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningNonces) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningNonces.identifier":
+		x.Identifier = uint32(0)
+	case "cosmos.crypto.frost.v1.SigningNonces.hiding":
+		x.Hiding = nil
+	case "cosmos.crypto.frost.v1.SigningNonces.binding":
+		x.Binding = nil
+	case "cosmos.crypto.frost.v1.SigningNonces.commitment":
+		x.Commitment = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningNonces"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningNonces does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SigningNonces) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.SigningNonces.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.SigningNonces.hiding":
+		value := x.Hiding
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crypto.frost.v1.SigningNonces.binding":
+		value := x.Binding
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crypto.frost.v1.SigningNonces.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningNonces"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningNonces does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningNonces) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningNonces.identifier":
+		x.Identifier = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.SigningNonces.hiding":
+		x.Hiding = value.Bytes()
+	case "cosmos.crypto.frost.v1.SigningNonces.binding":
+		x.Binding = value.Bytes()
+	case "cosmos.crypto.frost.v1.SigningNonces.commitment":
+		x.Commitment = value.Message().Interface().(*SigningCommitment)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningNonces"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningNonces does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningNonces) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningNonces.commitment":
+		if x.Commitment == nil {
+			x.Commitment = new(SigningCommitment)
+		}
+		return protoreflect.ValueOfMessage(x.Commitment.ProtoReflect())
+	case "cosmos.crypto.frost.v1.SigningNonces.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.crypto.frost.v1.SigningNonces is not mutable"))
+	case "cosmos.crypto.frost.v1.SigningNonces.hiding":
+		panic(fmt.Errorf("field hiding of message cosmos.crypto.frost.v1.SigningNonces is not mutable"))
+	case "cosmos.crypto.frost.v1.SigningNonces.binding":
+		panic(fmt.Errorf("field binding of message cosmos.crypto.frost.v1.SigningNonces is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningNonces"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningNonces does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SigningNonces) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningNonces.identifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.SigningNonces.hiding":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crypto.frost.v1.SigningNonces.binding":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crypto.frost.v1.SigningNonces.commitment":
+		m := new(SigningCommitment)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningNonces"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningNonces does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SigningNonces) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.SigningNonces", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SigningNonces) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningNonces) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SigningNonces) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SigningNonces) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SigningNonces)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Identifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Identifier))
+		}
+		l = len(x.Hiding)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Binding)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Commitment != nil {
+			l = options.Size(x.Commitment)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SigningNonces)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Commitment != nil {
+			encoded, err := options.Marshal(x.Commitment)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Binding) > 0 {
+			i -= len(x.Binding)
+			copy(dAtA[i:], x.Binding)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Binding)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Hiding) > 0 {
+			i -= len(x.Hiding)
+			copy(dAtA[i:], x.Hiding)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hiding)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Identifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Identifier))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SigningNonces)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningNonces: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				x.Identifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Identifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hiding = append(x.Hiding[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hiding == nil {
+					x.Hiding = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Binding = append(x.Binding[:0], dAtA[iNdEx:postIndex]...)
+				if x.Binding == nil {
+					x.Binding = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Commitment == nil {
+					x.Commitment = &SigningCommitment{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commitment); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SigningCommitment            protoreflect.MessageDescriptor
+	fd_SigningCommitment_identifier protoreflect.FieldDescriptor
+	fd_SigningCommitment_hiding     protoreflect.FieldDescriptor
+	fd_SigningCommitment_binding    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_SigningCommitment = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("SigningCommitment")
+	fd_SigningCommitment_identifier = md_SigningCommitment.Fields().ByName("identifier")
+	fd_SigningCommitment_hiding = md_SigningCommitment.Fields().ByName("hiding")
+	fd_SigningCommitment_binding = md_SigningCommitment.Fields().ByName("binding")
+}
+
+var _ protoreflect.Message = (*fastReflection_SigningCommitment)(nil)
+
+type fastReflection_SigningCommitment SigningCommitment
+
+func (x *SigningCommitment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SigningCommitment)(x)
+}
+
+func (x *SigningCommitment) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SigningCommitment_messageType fastReflection_SigningCommitment_messageType
+var _ protoreflect.MessageType = fastReflection_SigningCommitment_messageType{}
+
+type fastReflection_SigningCommitment_messageType struct{}
+
+func (x fastReflection_SigningCommitment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SigningCommitment)(nil)
+}
+func (x fastReflection_SigningCommitment_messageType) New() protoreflect.Message {
+	return new(fastReflection_SigningCommitment)
+}
+func (x fastReflection_SigningCommitment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningCommitment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SigningCommitment) Descriptor() protoreflect.MessageDescriptor {
+	return md_SigningCommitment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SigningCommitment) Type() protoreflect.MessageType {
+	return _fastReflection_SigningCommitment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SigningCommitment) New() protoreflect.Message {
+	return new(fastReflection_SigningCommitment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SigningCommitment) Interface() protoreflect.ProtoMessage {
+	return (*SigningCommitment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SigningCommitment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Identifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Identifier)
+		if !f(fd_SigningCommitment_identifier, value) {
+			return
+		}
+	}
+	if len(x.Hiding) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hiding)
+		if !f(fd_SigningCommitment_hiding, value) {
+			return
+		}
+	}
+	if len(x.Binding) != 0 {
+		value := protoreflect.ValueOfBytes(x.Binding)
+		if !f(fd_SigningCommitment_binding, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SigningCommitment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningCommitment.identifier":
+		return x.Identifier != uint32(0)
+	case "cosmos.crypto.frost.v1.SigningCommitment.hiding":
+		return len(x.Hiding) != 0
+	case "cosmos.crypto.frost.v1.SigningCommitment.binding":
+		return len(x.Binding) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningCommitment"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningCommitment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningCommitment.identifier":
+		x.Identifier = uint32(0)
+	case "cosmos.crypto.frost.v1.SigningCommitment.hiding":
+		x.Hiding = nil
+	case "cosmos.crypto.frost.v1.SigningCommitment.binding":
+		x.Binding = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningCommitment"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SigningCommitment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.SigningCommitment.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.SigningCommitment.hiding":
+		value := x.Hiding
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crypto.frost.v1.SigningCommitment.binding":
+		value := x.Binding
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningCommitment"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningCommitment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningCommitment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningCommitment.identifier":
+		x.Identifier = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.SigningCommitment.hiding":
+		x.Hiding = value.Bytes()
+	case "cosmos.crypto.frost.v1.SigningCommitment.binding":
+		x.Binding = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningCommitment"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningCommitment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningCommitment.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.crypto.frost.v1.SigningCommitment is not mutable"))
+	case "cosmos.crypto.frost.v1.SigningCommitment.hiding":
+		panic(fmt.Errorf("field hiding of message cosmos.crypto.frost.v1.SigningCommitment is not mutable"))
+	case "cosmos.crypto.frost.v1.SigningCommitment.binding":
+		panic(fmt.Errorf("field binding of message cosmos.crypto.frost.v1.SigningCommitment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningCommitment"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SigningCommitment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SigningCommitment.identifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.SigningCommitment.hiding":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crypto.frost.v1.SigningCommitment.binding":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SigningCommitment"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SigningCommitment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SigningCommitment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.SigningCommitment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SigningCommitment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SigningCommitment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SigningCommitment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SigningCommitment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SigningCommitment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Identifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Identifier))
+		}
+		l = len(x.Hiding)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Binding)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SigningCommitment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Binding) > 0 {
+			i -= len(x.Binding)
+			copy(dAtA[i:], x.Binding)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Binding)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Hiding) > 0 {
+			i -= len(x.Hiding)
+			copy(dAtA[i:], x.Hiding)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hiding)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Identifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Identifier))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SigningCommitment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningCommitment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SigningCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				x.Identifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Identifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hiding = append(x.Hiding[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hiding == nil {
+					x.Hiding = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Binding = append(x.Binding[:0], dAtA[iNdEx:postIndex]...)
+				if x.Binding == nil {
+					x.Binding = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignatureShare            protoreflect.MessageDescriptor
+	fd_SignatureShare_identifier protoreflect.FieldDescriptor
+	fd_SignatureShare_share      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_SignatureShare = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("SignatureShare")
+	fd_SignatureShare_identifier = md_SignatureShare.Fields().ByName("identifier")
+	fd_SignatureShare_share = md_SignatureShare.Fields().ByName("share")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignatureShare)(nil)
+
+type fastReflection_SignatureShare SignatureShare
+
+func (x *SignatureShare) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignatureShare)(x)
+}
+
+func (x *SignatureShare) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignatureShare_messageType fastReflection_SignatureShare_messageType
+var _ protoreflect.MessageType = fastReflection_SignatureShare_messageType{}
+
+type fastReflection_SignatureShare_messageType struct{}
+
+func (x fastReflection_SignatureShare_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignatureShare)(nil)
+}
+func (x fastReflection_SignatureShare_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignatureShare)
+}
+func (x fastReflection_SignatureShare_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignatureShare
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignatureShare) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignatureShare
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignatureShare) Type() protoreflect.MessageType {
+	return _fastReflection_SignatureShare_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignatureShare) New() protoreflect.Message {
+	return new(fastReflection_SignatureShare)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignatureShare) Interface() protoreflect.ProtoMessage {
+	return (*SignatureShare)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignatureShare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Identifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Identifier)
+		if !f(fd_SignatureShare_identifier, value) {
+			return
+		}
+	}
+	if len(x.Share) != 0 {
+		value := protoreflect.ValueOfBytes(x.Share)
+		if !f(fd_SignatureShare_share, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignatureShare) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SignatureShare.identifier":
+		return x.Identifier != uint32(0)
+	case "cosmos.crypto.frost.v1.SignatureShare.share":
+		return len(x.Share) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SignatureShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SignatureShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignatureShare) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SignatureShare.identifier":
+		x.Identifier = uint32(0)
+	case "cosmos.crypto.frost.v1.SignatureShare.share":
+		x.Share = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SignatureShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SignatureShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignatureShare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.SignatureShare.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crypto.frost.v1.SignatureShare.share":
+		value := x.Share
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SignatureShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SignatureShare does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignatureShare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SignatureShare.identifier":
+		x.Identifier = uint32(value.Uint())
+	case "cosmos.crypto.frost.v1.SignatureShare.share":
+		x.Share = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SignatureShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SignatureShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignatureShare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SignatureShare.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.crypto.frost.v1.SignatureShare is not mutable"))
+	case "cosmos.crypto.frost.v1.SignatureShare.share":
+		panic(fmt.Errorf("field share of message cosmos.crypto.frost.v1.SignatureShare is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SignatureShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SignatureShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignatureShare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SignatureShare.identifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crypto.frost.v1.SignatureShare.share":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SignatureShare"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SignatureShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignatureShare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.SignatureShare", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignatureShare) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignatureShare) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignatureShare) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignatureShare) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignatureShare)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Identifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.Identifier))
+		}
+		l = len(x.Share)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignatureShare)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Share) > 0 {
+			i -= len(x.Share)
+			copy(dAtA[i:], x.Share)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Share)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Identifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Identifier))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignatureShare)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignatureShare: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignatureShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				x.Identifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Identifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Share = append(x.Share[:0], dAtA[iNdEx:postIndex]...)
+				if x.Share == nil {
+					x.Share = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SchnorrPubKey     protoreflect.MessageDescriptor
+	fd_SchnorrPubKey_key protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crypto_frost_v1_frost_proto_init()
+	md_SchnorrPubKey = File_cosmos_crypto_frost_v1_frost_proto.Messages().ByName("SchnorrPubKey")
+	fd_SchnorrPubKey_key = md_SchnorrPubKey.Fields().ByName("key")
+}
+
+var _ protoreflect.Message = (*fastReflection_SchnorrPubKey)(nil)
+
+type fastReflection_SchnorrPubKey SchnorrPubKey
+
+func (x *SchnorrPubKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SchnorrPubKey)(x)
+}
+
+func (x *SchnorrPubKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SchnorrPubKey_messageType fastReflection_SchnorrPubKey_messageType
+var _ protoreflect.MessageType = fastReflection_SchnorrPubKey_messageType{}
+
+type fastReflection_SchnorrPubKey_messageType struct{}
+
+func (x fastReflection_SchnorrPubKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SchnorrPubKey)(nil)
+}
+func (x fastReflection_SchnorrPubKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_SchnorrPubKey)
+}
+func (x fastReflection_SchnorrPubKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchnorrPubKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SchnorrPubKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchnorrPubKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SchnorrPubKey) Type() protoreflect.MessageType {
+	return _fastReflection_SchnorrPubKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SchnorrPubKey) New() protoreflect.Message {
+	return new(fastReflection_SchnorrPubKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SchnorrPubKey) Interface() protoreflect.ProtoMessage {
+	return (*SchnorrPubKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SchnorrPubKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_SchnorrPubKey_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SchnorrPubKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SchnorrPubKey.key":
+		return len(x.Key) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SchnorrPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SchnorrPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchnorrPubKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SchnorrPubKey.key":
+		x.Key = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SchnorrPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SchnorrPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SchnorrPubKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crypto.frost.v1.SchnorrPubKey.key":
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SchnorrPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SchnorrPubKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchnorrPubKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SchnorrPubKey.key":
+		x.Key = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SchnorrPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SchnorrPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchnorrPubKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SchnorrPubKey.key":
+		panic(fmt.Errorf("field key of message cosmos.crypto.frost.v1.SchnorrPubKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SchnorrPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SchnorrPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SchnorrPubKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crypto.frost.v1.SchnorrPubKey.key":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crypto.frost.v1.SchnorrPubKey"))
+		}
+		panic(fmt.Errorf("message cosmos.crypto.frost.v1.SchnorrPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SchnorrPubKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crypto.frost.v1.SchnorrPubKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SchnorrPubKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchnorrPubKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SchnorrPubKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SchnorrPubKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SchnorrPubKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SchnorrPubKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SchnorrPubKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchnorrPubKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchnorrPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/crypto/frost/v1/frost.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// KeyShare is the key share of a participant of a FROST threshold key.
+type KeyShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ciphersuite is the FROST ciphersuite of the key.
+	Ciphersuite string `protobuf:"bytes,1,opt,name=ciphersuite,proto3" json:"ciphersuite,omitempty"`
+	// identifier is the identifier of the participant, from 1 to the number of participants.
+	Identifier uint32 `protobuf:"varint,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// min_signers is the number of participants required to sign.
+	MinSigners uint32 `protobuf:"varint,3,opt,name=min_signers,json=minSigners,proto3" json:"min_signers,omitempty"`
+	// signing_share is the secret signing share of the participant.
+	SigningShare []byte `protobuf:"bytes,4,opt,name=signing_share,json=signingShare,proto3" json:"signing_share,omitempty"`
+	// group_public_key is the public key of the threshold key.
+	GroupPublicKey []byte `protobuf:"bytes,5,opt,name=group_public_key,json=groupPublicKey,proto3" json:"group_public_key,omitempty"`
+	// verifying_shares are the public verifying shares of all the participants.
+	VerifyingShares []*VerifyingShare `protobuf:"bytes,6,rep,name=verifying_shares,json=verifyingShares,proto3" json:"verifying_shares,omitempty"`
+}
+
+func (x *KeyShare) Reset() {
+	*x = KeyShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyShare) ProtoMessage() {}
+
+// Deprecated: Use KeyShare.ProtoReflect.Descriptor instead.
+func (*KeyShare) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyShare) GetCiphersuite() string {
+	if x != nil {
+		return x.Ciphersuite
+	}
+	return ""
+}
+
+func (x *KeyShare) GetIdentifier() uint32 {
+	if x != nil {
+		return x.Identifier
+	}
+	return 0
+}
+
+func (x *KeyShare) GetMinSigners() uint32 {
+	if x != nil {
+		return x.MinSigners
+	}
+	return 0
+}
+
+func (x *KeyShare) GetSigningShare() []byte {
+	if x != nil {
+		return x.SigningShare
+	}
+	return nil
+}
+
+func (x *KeyShare) GetGroupPublicKey() []byte {
+	if x != nil {
+		return x.GroupPublicKey
+	}
+	return nil
+}
+
+func (x *KeyShare) GetVerifyingShares() []*VerifyingShare {
+	if x != nil {
+		return x.VerifyingShares
+	}
+	return nil
+}
+
+// VerifyingShare is the public verifying share of a participant.
+type VerifyingShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// verifying_share is the public key of the participant signing share.
+	VerifyingShare []byte `protobuf:"bytes,2,opt,name=verifying_share,json=verifyingShare,proto3" json:"verifying_share,omitempty"`
+}
+
+func (x *VerifyingShare) Reset() {
+	*x = VerifyingShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyingShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyingShare) ProtoMessage() {}
+
+// Deprecated: Use VerifyingShare.ProtoReflect.Descriptor instead.
+func (*VerifyingShare) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VerifyingShare) GetIdentifier() uint32 {
+	if x != nil {
+		return x.Identifier
+	}
+	return 0
+}
+
+func (x *VerifyingShare) GetVerifyingShare() []byte {
+	if x != nil {
+		return x.VerifyingShare
+	}
+	return nil
+}
+
+// DKGSecretPackage is the secret state kept by a participant between the rounds
+// of the distributed key generation.
+type DKGSecretPackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ciphersuite is the FROST ciphersuite of the key.
+	Ciphersuite string `protobuf:"bytes,1,opt,name=ciphersuite,proto3" json:"ciphersuite,omitempty"`
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// min_signers is the number of participants required to sign.
+	MinSigners uint32 `protobuf:"varint,3,opt,name=min_signers,json=minSigners,proto3" json:"min_signers,omitempty"`
+	// max_signers is the number of participants.
+	MaxSigners uint32 `protobuf:"varint,4,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
+	// coefficients are the coefficients of the secret polynomial of the participant.
+	Coefficients [][]byte `protobuf:"bytes,5,rep,name=coefficients,proto3" json:"coefficients,omitempty"`
+}
+
+func (x *DKGSecretPackage) Reset() {
+	*x = DKGSecretPackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGSecretPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGSecretPackage) ProtoMessage() {}
+
+// Deprecated: Use DKGSecretPackage.ProtoReflect.Descriptor instead.
+func (*DKGSecretPackage) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DKGSecretPackage) GetCiphersuite() string {
+	if x != nil {
+		return x.Ciphersuite
+	}
+	return ""
+}
+
+func (x *DKGSecretPackage) GetIdentifier() uint32 {
+	if x != nil {
+		return x.Identifier
+	}
+	return 0
+}
+
+func (x *DKGSecretPackage) GetMinSigners() uint32 {
+	if x != nil {
+		return x.MinSigners
+	}
+	return 0
+}
+
+func (x *DKGSecretPackage) GetMaxSigners() uint32 {
+	if x != nil {
+		return x.MaxSigners
+	}
+	return 0
+}
+
+func (x *DKGSecretPackage) GetCoefficients() [][]byte {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+// DKGRound1Package is the package broadcast by a participant to all the other
+// participants in the first round of the distributed key generation.
+type DKGRound1Package struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ciphersuite is the FROST ciphersuite of the key.
+	Ciphersuite string `protobuf:"bytes,1,opt,name=ciphersuite,proto3" json:"ciphersuite,omitempty"`
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// commitment is the commitment to the coefficients of the secret polynomial of the participant.
+	Commitment [][]byte `protobuf:"bytes,3,rep,name=commitment,proto3" json:"commitment,omitempty"`
+	// proof_of_knowledge is the proof of knowledge of the secret of the participant.
+	ProofOfKnowledge []byte `protobuf:"bytes,4,opt,name=proof_of_knowledge,json=proofOfKnowledge,proto3" json:"proof_of_knowledge,omitempty"`
+}
+
+func (x *DKGRound1Package) Reset() {
+	*x = DKGRound1Package{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGRound1Package) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGRound1Package) ProtoMessage() {}
+
+// Deprecated: Use DKGRound1Package.ProtoReflect.Descriptor instead.
+func (*DKGRound1Package) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DKGRound1Package) GetCiphersuite() string {
+	if x != nil {
+		return x.Ciphersuite
+	}
+	return ""
+}
+
+func (x *DKGRound1Package) GetIdentifier() uint32 {
+	if x != nil {
+		return x.Identifier
+	}
+	return 0
+}
+
+func (x *DKGRound1Package) GetCommitment() [][]byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *DKGRound1Package) GetProofOfKnowledge() []byte {
+	if x != nil {
+		return x.ProofOfKnowledge
+	}
+	return nil
+}
+
+// DKGRound2Package is the package sent by a participant to another participant
+// in the second round of the distributed key generation. It contains a secret
+// share, and must be sent over a confidential channel.
+type DKGRound2Package struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender is the identifier of the sending participant.
+	Sender uint32 `protobuf:"varint,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the identifier of the receiving participant.
+	Recipient uint32 `protobuf:"varint,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// signing_share is the secret share of the recipient computed by the sender.
+	SigningShare []byte `protobuf:"bytes,3,opt,name=signing_share,json=signingShare,proto3" json:"signing_share,omitempty"`
+}
+
+func (x *DKGRound2Package) Reset() {
+	*x = DKGRound2Package{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKGRound2Package) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKGRound2Package) ProtoMessage() {}
+
+// Deprecated: Use DKGRound2Package.ProtoReflect.Descriptor instead.
+func (*DKGRound2Package) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DKGRound2Package) GetSender() uint32 {
+	if x != nil {
+		return x.Sender
+	}
+	return 0
+}
+
+func (x *DKGRound2Package) GetRecipient() uint32 {
+	if x != nil {
+		return x.Recipient
+	}
+	return 0
+}
+
+func (x *DKGRound2Package) GetSigningShare() []byte {
+	if x != nil {
+		return x.SigningShare
+	}
+	return nil
+}
+
+// SigningNonces are the secret nonces generated by a participant for a single
+// signing operation. They must never be reused.
+type SigningNonces struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// hiding is the hiding nonce.
+	Hiding []byte `protobuf:"bytes,2,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	// binding is the binding nonce.
+	Binding []byte `protobuf:"bytes,3,opt,name=binding,proto3" json:"binding,omitempty"`
+	// commitment is the public commitment to the nonces.
+	Commitment *SigningCommitment `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *SigningNonces) Reset() {
+	*x = SigningNonces{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningNonces) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningNonces) ProtoMessage() {}
+
+// Deprecated: Use SigningNonces.ProtoReflect.Descriptor instead.
+func (*SigningNonces) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SigningNonces) GetIdentifier() uint32 {
+	if x != nil {
+		return x.Identifier
+	}
+	return 0
+}
+
+func (x *SigningNonces) GetHiding() []byte {
+	if x != nil {
+		return x.Hiding
+	}
+	return nil
+}
+
+func (x *SigningNonces) GetBinding() []byte {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+func (x *SigningNonces) GetCommitment() *SigningCommitment {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+// SigningCommitment is the public commitment of a participant to its signing nonces.
+type SigningCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// hiding is the commitment to the hiding nonce.
+	Hiding []byte `protobuf:"bytes,2,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	// binding is the commitment to the binding nonce.
+	Binding []byte `protobuf:"bytes,3,opt,name=binding,proto3" json:"binding,omitempty"`
+}
+
+func (x *SigningCommitment) Reset() {
+	*x = SigningCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningCommitment) ProtoMessage() {}
+
+// Deprecated: Use SigningCommitment.ProtoReflect.Descriptor instead.
+func (*SigningCommitment) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SigningCommitment) GetIdentifier() uint32 {
+	if x != nil {
+		return x.Identifier
+	}
+	return 0
+}
+
+func (x *SigningCommitment) GetHiding() []byte {
+	if x != nil {
+		return x.Hiding
+	}
+	return nil
+}
+
+func (x *SigningCommitment) GetBinding() []byte {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+// SignatureShare is the signature share produced by a participant.
+type SignatureShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// share is the signature share.
+	Share []byte `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *SignatureShare) Reset() {
+	*x = SignatureShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignatureShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureShare) ProtoMessage() {}
+
+// Deprecated: Use SignatureShare.ProtoReflect.Descriptor instead.
+func (*SignatureShare) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SignatureShare) GetIdentifier() uint32 {
+	if x != nil {
+		return x.Identifier
+	}
+	return 0
+}
+
+func (x *SignatureShare) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// SchnorrPubKey is the group public key of a FROST(secp256k1, SHA-256) threshold
+// key. Its signatures are FROST Schnorr signatures, which are not verifiable by a
+// secp256k1 public key, hence it has its own type and address.
+type SchnorrPubKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the compressed group public key.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SchnorrPubKey) Reset() {
+	*x = SchnorrPubKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crypto_frost_v1_frost_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchnorrPubKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchnorrPubKey) ProtoMessage() {}
+
+// Deprecated: Use SchnorrPubKey.ProtoReflect.Descriptor instead.
+func (*SchnorrPubKey) Descriptor() ([]byte, []int) {
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SchnorrPubKey) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+var File_cosmos_crypto_frost_v1_frost_proto protoreflect.FileDescriptor
+
+var file_cosmos_crypto_frost_v1_frost_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x2e, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x95, 0x02, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x75, 0x69, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x57, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x66, 0x72, 0x6f, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x0e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x69,
+	0x70, 0x68, 0x65, 0x72, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65,
+	0x72, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x69,
+	0x70, 0x68, 0x65, 0x72, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x5f, 0x6f, 0x66, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x22, 0x6d, 0x0a, 0x10, 0x44, 0x4b, 0x47, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x32, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x69, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x66,
+	0x72, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x69, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x0e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x42, 0xd8, 0x01,
+	0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x42, 0x0a, 0x46, 0x72, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2f, 0x66,
+	0x72, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x43, 0x46, 0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x46, 0x72, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x46,
+	0x72, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5c, 0x46, 0x72, 0x6f, 0x73, 0x74, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x3a, 0x3a, 0x46,
+	0x72, 0x6f, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_crypto_frost_v1_frost_proto_rawDescOnce sync.Once
+	file_cosmos_crypto_frost_v1_frost_proto_rawDescData = file_cosmos_crypto_frost_v1_frost_proto_rawDesc
+)
+
+func file_cosmos_crypto_frost_v1_frost_proto_rawDescGZIP() []byte {
+	file_cosmos_crypto_frost_v1_frost_proto_rawDescOnce.Do(func() {
+		file_cosmos_crypto_frost_v1_frost_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_crypto_frost_v1_frost_proto_rawDescData)
+	})
+	return file_cosmos_crypto_frost_v1_frost_proto_rawDescData
+}
+
+var file_cosmos_crypto_frost_v1_frost_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_crypto_frost_v1_frost_proto_goTypes = []interface{}{
+	(*KeyShare)(nil),          // 0: cosmos.crypto.frost.v1.KeyShare
+	(*VerifyingShare)(nil),    // 1: cosmos.crypto.frost.v1.VerifyingShare
+	(*DKGSecretPackage)(nil),  // 2: cosmos.crypto.frost.v1.DKGSecretPackage
+	(*DKGRound1Package)(nil),  // 3: cosmos.crypto.frost.v1.DKGRound1Package
+	(*DKGRound2Package)(nil),  // 4: cosmos.crypto.frost.v1.DKGRound2Package
+	(*SigningNonces)(nil),     // 5: cosmos.crypto.frost.v1.SigningNonces
+	(*SigningCommitment)(nil), // 6: cosmos.crypto.frost.v1.SigningCommitment
+	(*SignatureShare)(nil),    // 7: cosmos.crypto.frost.v1.SignatureShare
+	(*SchnorrPubKey)(nil),     // 8: cosmos.crypto.frost.v1.SchnorrPubKey
+}
+var file_cosmos_crypto_frost_v1_frost_proto_depIdxs = []int32{
+	1, // 0: cosmos.crypto.frost.v1.KeyShare.verifying_shares:type_name -> cosmos.crypto.frost.v1.VerifyingShare
+	6, // 1: cosmos.crypto.frost.v1.SigningNonces.commitment:type_name -> cosmos.crypto.frost.v1.SigningCommitment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_crypto_frost_v1_frost_proto_init() }
+func file_cosmos_crypto_frost_v1_frost_proto_init() {
+	if File_cosmos_crypto_frost_v1_frost_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyingShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGSecretPackage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGRound1Package); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKGRound2Package); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningNonces); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignatureShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crypto_frost_v1_frost_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchnorrPubKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_crypto_frost_v1_frost_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cosmos_crypto_frost_v1_frost_proto_goTypes,
+		DependencyIndexes: file_cosmos_crypto_frost_v1_frost_proto_depIdxs,
+		MessageInfos:      file_cosmos_crypto_frost_v1_frost_proto_msgTypes,
+	}.Build()
+	File_cosmos_crypto_frost_v1_frost_proto = out.File
+	file_cosmos_crypto_frost_v1_frost_proto_rawDesc = nil
+	file_cosmos_crypto_frost_v1_frost_proto_goTypes = nil
+	file_cosmos_crypto_frost_v1_frost_proto_depIdxs = nil
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/crypto/keyring/v1/remote_signer.proto
+
+package keyringv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RemoteSigner_Keys_FullMethodName = "/cosmos.crypto.keyring.v1.RemoteSigner/Keys"
+	RemoteSigner_Sign_FullMethodName = "/cosmos.crypto.keyring.v1.RemoteSigner/Sign"
+)
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RemoteSigner defines the service implemented by remote signers, holding
+// private keys on behalf of the remote keyring backend.
+type RemoteSignerClient interface {
+	// Keys returns the keys exposed by the signer.
+	Keys(ctx context.Context, in *KeysRequest, opts ...grpc.CallOption) (*KeysResponse, error)
+	// Sign signs a message with a key of the signer.
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemoteSignerClient(cc grpc.ClientConnInterface) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) Keys(ctx context.Context, in *KeysRequest, opts ...grpc.CallOption) (*KeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeysResponse)
+	err := c.cc.Invoke(ctx, RemoteSigner_Keys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, RemoteSigner_Sign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServer is the server API for RemoteSigner service.
+// All implementations must embed UnimplementedRemoteSignerServer
+// for forward compatibility.
+//
+// RemoteSigner defines the service implemented by remote signers, holding
+// private keys on behalf of the remote keyring backend.
+type RemoteSignerServer interface {
+	// Keys returns the keys exposed by the signer.
+	Keys(context.Context, *KeysRequest) (*KeysResponse, error)
+	// Sign signs a message with a key of the signer.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	mustEmbedUnimplementedRemoteSignerServer()
+}
+
+// UnimplementedRemoteSignerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRemoteSignerServer struct{}
+
+func (UnimplementedRemoteSignerServer) Keys(context.Context, *KeysRequest) (*KeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keys not implemented")
+}
+func (UnimplementedRemoteSignerServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedRemoteSignerServer) mustEmbedUnimplementedRemoteSignerServer() {}
+func (UnimplementedRemoteSignerServer) testEmbeddedByValue()                      {}
+
+// UnsafeRemoteSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemoteSignerServer will
+// result in compilation errors.
+type UnsafeRemoteSignerServer interface {
+	mustEmbedUnimplementedRemoteSignerServer()
+}
+
+func RegisterRemoteSignerServer(s grpc.ServiceRegistrar, srv RemoteSignerServer) {
+	// If the following call pancis, it indicates UnimplementedRemoteSignerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RemoteSigner_ServiceDesc, srv)
+}
+
+func _RemoteSigner_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).Keys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemoteSigner_Keys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).Keys(ctx, req.(*KeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemoteSigner_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemoteSigner_ServiceDesc is the grpc.ServiceDesc for RemoteSigner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemoteSigner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crypto.keyring.v1.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Keys",
+			Handler:    _RemoteSigner_Keys_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _RemoteSigner_Sign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crypto/keyring/v1/remote_signer.proto",
+}
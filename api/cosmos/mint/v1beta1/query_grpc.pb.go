@@ -22,6 +22,7 @@ const (
 	Query_Params_FullMethodName           = "/cosmos.mint.v1beta1.Query/Params"
 	Query_Inflation_FullMethodName        = "/cosmos.mint.v1beta1.Query/Inflation"
 	Query_AnnualProvisions_FullMethodName = "/cosmos.mint.v1beta1.Query/AnnualProvisions"
+	Query_SupplyProjection_FullMethodName = "/cosmos.mint.v1beta1.Query/SupplyProjection"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +37,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection projects the future supply of the mint denom following
+	// the configured inflation schedule.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +80,16 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyProjection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +102,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection projects the future supply of the mint denom following
+	// the configured inflation schedule.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +124,9 @@ func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationReques
 func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (UnimplementedQueryServer) SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +202,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +239,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
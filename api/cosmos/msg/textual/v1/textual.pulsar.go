@@ -30,6 +30,30 @@ var file_cosmos_msg_textual_v1_textual_proto_extTypes = []protoimpl.ExtensionInf
 		Tag:           "bytes,11110009,opt,name=expert_custom_renderer",
 		Filename:      "cosmos/msg/textual/v1/textual.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         11110010,
+		Name:          "cosmos.msg.textual.v1.summary",
+		Tag:           "bytes,11110010,opt,name=summary",
+		Filename:      "cosmos/msg/textual/v1/textual.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         11110011,
+		Name:          "cosmos.msg.textual.v1.label",
+		Tag:           "bytes,11110011,opt,name=label",
+		Filename:      "cosmos/msg/textual/v1/textual.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         11110012,
+		Name:          "cosmos.msg.textual.v1.hidden",
+		Tag:           "varint,11110012,opt,name=hidden",
+		Filename:      "cosmos/msg/textual/v1/textual.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MessageOptions.
@@ -46,6 +70,31 @@ var (
 	//
 	// optional string expert_custom_renderer = 11110009;
 	E_ExpertCustomRenderer = &file_cosmos_msg_textual_v1_textual_proto_extTypes[0]
+	// summary is a template of a one-line summary of the message, rendered by
+	// SIGN_MODE_TEXTUAL on a screen right after the message header. Fields are
+	// referenced by their protobuf name in braces, e.g.
+	// "Send {amount} to {to_address}", and replaced by the first line of their
+	// textual rendering. Literal braces are escaped as "{{" and "}}". Hidden
+	// fields cannot be referenced.
+	//
+	// optional string summary = 11110010;
+	E_Summary = &file_cosmos_msg_textual_v1_textual_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// label is the title of the field in SIGN_MODE_TEXTUAL, used instead of the
+	// sentence case of the field name. It must be unique among the titles of the
+	// fields of the message.
+	//
+	// optional string label = 11110011;
+	E_Label = &file_cosmos_msg_textual_v1_textual_proto_extTypes[2]
+	// hidden makes SIGN_MODE_TEXTUAL render the field on expert screens only,
+	// e.g. for fields that are not meaningful to users. The field is still part
+	// of the rendering, so that it can be parsed back.
+	//
+	// optional bool hidden = 11110012;
+	E_Hidden = &file_cosmos_msg_textual_v1_textual_proto_extTypes[3]
 )
 
 var File_cosmos_msg_textual_v1_textual_proto protoreflect.FileDescriptor
@@ -62,7 +111,18 @@ var file_cosmos_msg_textual_v1_textual_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf9, 0x8c, 0xa6, 0x05, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x72, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x3a, 0x3c, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xfa, 0x8c, 0xa6, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x3a, 0x36, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xfb,
+	0x8c, 0xa6, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x3a, 0x38,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xfc, 0x8c, 0xa6, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x74, 0x65, 0x78, 0x74,
 	0x75, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x54, 0x65, 0x78, 0x74, 0x75, 0x61, 0x6c, 0x50,
 	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
@@ -81,13 +141,17 @@ var file_cosmos_msg_textual_v1_textual_proto_rawDesc = []byte{
 
 var file_cosmos_msg_textual_v1_textual_proto_goTypes = []interface{}{
 	(*descriptorpb.MessageOptions)(nil), // 0: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 1: google.protobuf.FieldOptions
 }
 var file_cosmos_msg_textual_v1_textual_proto_depIdxs = []int32{
 	0, // 0: cosmos.msg.textual.v1.expert_custom_renderer:extendee -> google.protobuf.MessageOptions
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	0, // [0:1] is the sub-list for extension extendee
+	0, // 1: cosmos.msg.textual.v1.summary:extendee -> google.protobuf.MessageOptions
+	1, // 2: cosmos.msg.textual.v1.label:extendee -> google.protobuf.FieldOptions
+	1, // 3: cosmos.msg.textual.v1.hidden:extendee -> google.protobuf.FieldOptions
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	0, // [0:4] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
 }
 
@@ -103,7 +167,7 @@ func file_cosmos_msg_textual_v1_textual_proto_init() {
 			RawDescriptor: file_cosmos_msg_textual_v1_textual_proto_rawDesc,
 			NumEnums:      0,
 			NumMessages:   0,
-			NumExtensions: 1,
+			NumExtensions: 4,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_msg_textual_v1_textual_proto_goTypes,
@@ -25,6 +25,8 @@ var (
 	fd_ValidatorSigningInfo_jailed_until          protoreflect.FieldDescriptor
 	fd_ValidatorSigningInfo_tombstoned            protoreflect.FieldDescriptor
 	fd_ValidatorSigningInfo_missed_blocks_counter protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_downtime_offenses     protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_last_downtime_offense protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -36,6 +38,8 @@ func init() {
 	fd_ValidatorSigningInfo_jailed_until = md_ValidatorSigningInfo.Fields().ByName("jailed_until")
 	fd_ValidatorSigningInfo_tombstoned = md_ValidatorSigningInfo.Fields().ByName("tombstoned")
 	fd_ValidatorSigningInfo_missed_blocks_counter = md_ValidatorSigningInfo.Fields().ByName("missed_blocks_counter")
+	fd_ValidatorSigningInfo_downtime_offenses = md_ValidatorSigningInfo.Fields().ByName("downtime_offenses")
+	fd_ValidatorSigningInfo_last_downtime_offense = md_ValidatorSigningInfo.Fields().ByName("last_downtime_offense")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValidatorSigningInfo)(nil)
@@ -139,6 +143,18 @@ func (x *fastReflection_ValidatorSigningInfo) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.DowntimeOffenses != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DowntimeOffenses)
+		if !f(fd_ValidatorSigningInfo_downtime_offenses, value) {
+			return
+		}
+	}
+	if x.LastDowntimeOffense != nil {
+		value := protoreflect.ValueOfMessage(x.LastDowntimeOffense.ProtoReflect())
+		if !f(fd_ValidatorSigningInfo_last_downtime_offense, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -166,6 +182,10 @@ func (x *fastReflection_ValidatorSigningInfo) Has(fd protoreflect.FieldDescripto
 		return x.Tombstoned != false
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		return x.MissedBlocksCounter != int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_offenses":
+		return x.DowntimeOffenses != int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense":
+		return x.LastDowntimeOffense != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -194,6 +214,10 @@ func (x *fastReflection_ValidatorSigningInfo) Clear(fd protoreflect.FieldDescrip
 		x.Tombstoned = false
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		x.MissedBlocksCounter = int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_offenses":
+		x.DowntimeOffenses = int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense":
+		x.LastDowntimeOffense = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -228,6 +252,12 @@ func (x *fastReflection_ValidatorSigningInfo) Get(descriptor protoreflect.FieldD
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		value := x.MissedBlocksCounter
 		return protoreflect.ValueOfInt64(value)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_offenses":
+		value := x.DowntimeOffenses
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense":
+		value := x.LastDowntimeOffense
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -260,6 +290,10 @@ func (x *fastReflection_ValidatorSigningInfo) Set(fd protoreflect.FieldDescripto
 		x.Tombstoned = value.Bool()
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		x.MissedBlocksCounter = value.Int()
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_offenses":
+		x.DowntimeOffenses = value.Int()
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense":
+		x.LastDowntimeOffense = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -285,6 +319,11 @@ func (x *fastReflection_ValidatorSigningInfo) Mutable(fd protoreflect.FieldDescr
 			x.JailedUntil = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.JailedUntil.ProtoReflect())
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense":
+		if x.LastDowntimeOffense == nil {
+			x.LastDowntimeOffense = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LastDowntimeOffense.ProtoReflect())
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.address":
 		panic(fmt.Errorf("field address of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.start_height":
@@ -295,6 +334,8 @@ func (x *fastReflection_ValidatorSigningInfo) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field tombstoned of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		panic(fmt.Errorf("field missed_blocks_counter of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_offenses":
+		panic(fmt.Errorf("field downtime_offenses of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -321,6 +362,11 @@ func (x *fastReflection_ValidatorSigningInfo) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_offenses":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -410,6 +456,13 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 		if x.MissedBlocksCounter != 0 {
 			n += 1 + runtime.Sov(uint64(x.MissedBlocksCounter))
 		}
+		if x.DowntimeOffenses != 0 {
+			n += 1 + runtime.Sov(uint64(x.DowntimeOffenses))
+		}
+		if x.LastDowntimeOffense != nil {
+			l = options.Size(x.LastDowntimeOffense)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -439,6 +492,25 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.LastDowntimeOffense != nil {
+			encoded, err := options.Marshal(x.LastDowntimeOffense)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.DowntimeOffenses != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DowntimeOffenses))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.MissedBlocksCounter != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedBlocksCounter))
 			i--
@@ -679,6 +751,61 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenses", wireType)
+				}
+				x.DowntimeOffenses = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DowntimeOffenses |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeOffense", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastDowntimeOffense == nil {
+					x.LastDowntimeOffense = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastDowntimeOffense); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -714,6 +841,57 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_Params_6_list)(nil)
+
+type _Params_6_list struct {
+	list *[]*DowntimeTier
+}
+
+func (x *_Params_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DowntimeTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DowntimeTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_6_list) AppendMutable() protoreflect.Value {
+	v := new(DowntimeTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_6_list) NewElement() protoreflect.Value {
+	v := new(DowntimeTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                            protoreflect.MessageDescriptor
 	fd_Params_signed_blocks_window       protoreflect.FieldDescriptor
@@ -721,6 +899,8 @@ var (
 	fd_Params_downtime_jail_duration     protoreflect.FieldDescriptor
 	fd_Params_slash_fraction_double_sign protoreflect.FieldDescriptor
 	fd_Params_slash_fraction_downtime    protoreflect.FieldDescriptor
+	fd_Params_downtime_tiers             protoreflect.FieldDescriptor
+	fd_Params_downtime_offense_decay     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -731,6 +911,8 @@ func init() {
 	fd_Params_downtime_jail_duration = md_Params.Fields().ByName("downtime_jail_duration")
 	fd_Params_slash_fraction_double_sign = md_Params.Fields().ByName("slash_fraction_double_sign")
 	fd_Params_slash_fraction_downtime = md_Params.Fields().ByName("slash_fraction_downtime")
+	fd_Params_downtime_tiers = md_Params.Fields().ByName("downtime_tiers")
+	fd_Params_downtime_offense_decay = md_Params.Fields().ByName("downtime_offense_decay")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -828,6 +1010,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.DowntimeTiers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_6_list{list: &x.DowntimeTiers})
+		if !f(fd_Params_downtime_tiers, value) {
+			return
+		}
+	}
+	if x.DowntimeOffenseDecay != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimeOffenseDecay.ProtoReflect())
+		if !f(fd_Params_downtime_offense_decay, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -853,6 +1047,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.SlashFractionDoubleSign) != 0
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		return len(x.SlashFractionDowntime) != 0
+	case "cosmos.slashing.v1beta1.Params.downtime_tiers":
+		return len(x.DowntimeTiers) != 0
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay":
+		return x.DowntimeOffenseDecay != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -879,6 +1077,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SlashFractionDoubleSign = nil
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		x.SlashFractionDowntime = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_tiers":
+		x.DowntimeTiers = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay":
+		x.DowntimeOffenseDecay = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -910,6 +1112,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		value := x.SlashFractionDowntime
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.downtime_tiers":
+		if len(x.DowntimeTiers) == 0 {
+			return protoreflect.ValueOfList(&_Params_6_list{})
+		}
+		listValue := &_Params_6_list{list: &x.DowntimeTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay":
+		value := x.DowntimeOffenseDecay
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -940,6 +1151,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SlashFractionDoubleSign = value.Bytes()
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		x.SlashFractionDowntime = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.downtime_tiers":
+		lv := value.List()
+		clv := lv.(*_Params_6_list)
+		x.DowntimeTiers = *clv.list
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay":
+		x.DowntimeOffenseDecay = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -965,6 +1182,17 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.DowntimeJailDuration = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.DowntimeJailDuration.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.downtime_tiers":
+		if x.DowntimeTiers == nil {
+			x.DowntimeTiers = []*DowntimeTier{}
+		}
+		value := &_Params_6_list{list: &x.DowntimeTiers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay":
+		if x.DowntimeOffenseDecay == nil {
+			x.DowntimeOffenseDecay = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimeOffenseDecay.ProtoReflect())
 	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
 		panic(fmt.Errorf("field signed_blocks_window of message cosmos.slashing.v1beta1.Params is not mutable"))
 	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
@@ -997,6 +1225,12 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBytes(nil)
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.downtime_tiers":
+		list := []*DowntimeTier{}
+		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "cosmos.slashing.v1beta1.Params.downtime_offense_decay":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -1085,6 +1319,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.DowntimeTiers) > 0 {
+			for _, e := range x.DowntimeTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DowntimeOffenseDecay != nil {
+			l = options.Size(x.DowntimeOffenseDecay)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1114,6 +1358,36 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DowntimeOffenseDecay != nil {
+			encoded, err := options.Marshal(x.DowntimeOffenseDecay)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.DowntimeTiers) > 0 {
+			for iNdEx := len(x.DowntimeTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DowntimeTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if len(x.SlashFractionDowntime) > 0 {
 			i -= len(x.SlashFractionDowntime)
 			copy(dAtA[i:], x.SlashFractionDowntime)
@@ -1360,6 +1634,76 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					x.SlashFractionDowntime = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DowntimeTiers = append(x.DowntimeTiers, &DowntimeTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeTiers[len(x.DowntimeTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseDecay", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DowntimeOffenseDecay == nil {
+					x.DowntimeOffenseDecay = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeOffenseDecay); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1395,74 +1739,629 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/slashing/v1beta1/slashing.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_DowntimeTier                protoreflect.MessageDescriptor
+	fd_DowntimeTier_min_offenses   protoreflect.FieldDescriptor
+	fd_DowntimeTier_slash_fraction protoreflect.FieldDescriptor
+	fd_DowntimeTier_jail_duration  protoreflect.FieldDescriptor
 )
 
-// ValidatorSigningInfo defines a validator's signing info for monitoring their
-// liveness activity.
-type ValidatorSigningInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cosmos_slashing_v1beta1_slashing_proto_init()
+	md_DowntimeTier = File_cosmos_slashing_v1beta1_slashing_proto.Messages().ByName("DowntimeTier")
+	fd_DowntimeTier_min_offenses = md_DowntimeTier.Fields().ByName("min_offenses")
+	fd_DowntimeTier_slash_fraction = md_DowntimeTier.Fields().ByName("slash_fraction")
+	fd_DowntimeTier_jail_duration = md_DowntimeTier.Fields().ByName("jail_duration")
+}
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Height at which validator was first a candidate OR was un-jailed
-	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// DEPRECATED: Index which is incremented every time a validator is bonded in a block and
-	// _may_ have signed a pre-commit or not. This in conjunction with the
-	// signed_blocks_window param determines the index in the missed block bitmap.
-	//
-	// Deprecated: Do not use.
-	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
-	// Timestamp until which the validator is jailed due to liveness downtime.
-	JailedUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
-	// Whether or not a validator has been tombstoned (killed out of validator
-	// set). It is set once the validator commits an equivocation or for any other
-	// configured misbehavior.
-	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
-	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
-	// reads in the missed block bitmap.
-	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+var _ protoreflect.Message = (*fastReflection_DowntimeTier)(nil)
+
+type fastReflection_DowntimeTier DowntimeTier
+
+func (x *DowntimeTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DowntimeTier)(x)
 }
 
-func (x *ValidatorSigningInfo) Reset() {
-	*x = ValidatorSigningInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[0]
+func (x *DowntimeTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *ValidatorSigningInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_DowntimeTier_messageType fastReflection_DowntimeTier_messageType
+var _ protoreflect.MessageType = fastReflection_DowntimeTier_messageType{}
 
-func (*ValidatorSigningInfo) ProtoMessage() {}
+type fastReflection_DowntimeTier_messageType struct{}
 
-// Deprecated: Use ValidatorSigningInfo.ProtoReflect.Descriptor instead.
-func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_DowntimeTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DowntimeTier)(nil)
+}
+func (x fastReflection_DowntimeTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_DowntimeTier)
+}
+func (x fastReflection_DowntimeTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DowntimeTier
 }
 
-func (x *ValidatorSigningInfo) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DowntimeTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_DowntimeTier
 }
 
-func (x *ValidatorSigningInfo) GetStartHeight() int64 {
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DowntimeTier) Type() protoreflect.MessageType {
+	return _fastReflection_DowntimeTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DowntimeTier) New() protoreflect.Message {
+	return new(fastReflection_DowntimeTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DowntimeTier) Interface() protoreflect.ProtoMessage {
+	return (*DowntimeTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DowntimeTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinOffenses != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinOffenses)
+		if !f(fd_DowntimeTier_min_offenses, value) {
+			return
+		}
+	}
+	if len(x.SlashFraction) != 0 {
+		value := protoreflect.ValueOfBytes(x.SlashFraction)
+		if !f(fd_DowntimeTier_slash_fraction, value) {
+			return
+		}
+	}
+	if x.JailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+		if !f(fd_DowntimeTier_jail_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DowntimeTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.DowntimeTier.min_offenses":
+		return x.MinOffenses != int64(0)
+	case "cosmos.slashing.v1beta1.DowntimeTier.slash_fraction":
+		return len(x.SlashFraction) != 0
+	case "cosmos.slashing.v1beta1.DowntimeTier.jail_duration":
+		return x.JailDuration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeTier"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DowntimeTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.DowntimeTier.min_offenses":
+		x.MinOffenses = int64(0)
+	case "cosmos.slashing.v1beta1.DowntimeTier.slash_fraction":
+		x.SlashFraction = nil
+	case "cosmos.slashing.v1beta1.DowntimeTier.jail_duration":
+		x.JailDuration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeTier"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DowntimeTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.slashing.v1beta1.DowntimeTier.min_offenses":
+		value := x.MinOffenses
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.slashing.v1beta1.DowntimeTier.slash_fraction":
+		value := x.SlashFraction
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.DowntimeTier.jail_duration":
+		value := x.JailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeTier"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DowntimeTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.DowntimeTier.min_offenses":
+		x.MinOffenses = value.Int()
+	case "cosmos.slashing.v1beta1.DowntimeTier.slash_fraction":
+		x.SlashFraction = value.Bytes()
+	case "cosmos.slashing.v1beta1.DowntimeTier.jail_duration":
+		x.JailDuration = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeTier"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DowntimeTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.DowntimeTier.jail_duration":
+		if x.JailDuration == nil {
+			x.JailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.JailDuration.ProtoReflect())
+	case "cosmos.slashing.v1beta1.DowntimeTier.min_offenses":
+		panic(fmt.Errorf("field min_offenses of message cosmos.slashing.v1beta1.DowntimeTier is not mutable"))
+	case "cosmos.slashing.v1beta1.DowntimeTier.slash_fraction":
+		panic(fmt.Errorf("field slash_fraction of message cosmos.slashing.v1beta1.DowntimeTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeTier"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DowntimeTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.DowntimeTier.min_offenses":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.slashing.v1beta1.DowntimeTier.slash_fraction":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.DowntimeTier.jail_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.DowntimeTier"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.DowntimeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DowntimeTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.slashing.v1beta1.DowntimeTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DowntimeTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DowntimeTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DowntimeTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DowntimeTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DowntimeTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MinOffenses != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinOffenses))
+		}
+		l = len(x.SlashFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.JailDuration != nil {
+			l = options.Size(x.JailDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DowntimeTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.JailDuration != nil {
+			encoded, err := options.Marshal(x.JailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SlashFraction) > 0 {
+			i -= len(x.SlashFraction)
+			copy(dAtA[i:], x.SlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFraction)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MinOffenses != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinOffenses))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DowntimeTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DowntimeTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DowntimeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinOffenses", wireType)
+				}
+				x.MinOffenses = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinOffenses |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashFraction = append(x.SlashFraction[:0], dAtA[iNdEx:postIndex]...)
+				if x.SlashFraction == nil {
+					x.SlashFraction = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.JailDuration == nil {
+					x.JailDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/slashing/v1beta1/slashing.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidatorSigningInfo defines a validator's signing info for monitoring their
+// liveness activity.
+type ValidatorSigningInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Height at which validator was first a candidate OR was un-jailed
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// DEPRECATED: Index which is incremented every time a validator is bonded in a block and
+	// _may_ have signed a pre-commit or not. This in conjunction with the
+	// signed_blocks_window param determines the index in the missed block bitmap.
+	//
+	// Deprecated: Do not use.
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// Timestamp until which the validator is jailed due to liveness downtime.
+	JailedUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// Whether or not a validator has been tombstoned (killed out of validator
+	// set). It is set once the validator commits an equivocation or for any other
+	// configured misbehavior.
+	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
+	// reads in the missed block bitmap.
+	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Number of recent downtime offenses as of last_downtime_offense. It is
+	// decayed according to the downtime_offense_decay param when a new offense is
+	// recorded, and selects the applicable downtime tier.
+	DowntimeOffenses int64 `protobuf:"varint,7,opt,name=downtime_offenses,json=downtimeOffenses,proto3" json:"downtime_offenses,omitempty"`
+	// Timestamp of the last downtime offense.
+	LastDowntimeOffense *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_downtime_offense,json=lastDowntimeOffense,proto3" json:"last_downtime_offense,omitempty"`
+}
+
+func (x *ValidatorSigningInfo) Reset() {
+	*x = ValidatorSigningInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSigningInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSigningInfo) ProtoMessage() {}
+
+// Deprecated: Use ValidatorSigningInfo.ProtoReflect.Descriptor instead.
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorSigningInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorSigningInfo) GetStartHeight() int64 {
 	if x != nil {
 		return x.StartHeight
 	}
@@ -1498,6 +2397,20 @@ func (x *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (x *ValidatorSigningInfo) GetDowntimeOffenses() int64 {
+	if x != nil {
+		return x.DowntimeOffenses
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetLastDowntimeOffense() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDowntimeOffense
+	}
+	return nil
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1509,6 +2422,14 @@ type Params struct {
 	DowntimeJailDuration    *durationpb.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`
 	SlashFractionDoubleSign []byte               `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`
 	SlashFractionDowntime   []byte               `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`
+	// downtime_tiers escalates the downtime punishment with the number of recent
+	// downtime offenses of a validator. When empty, or when no tier applies,
+	// slash_fraction_downtime and downtime_jail_duration are used.
+	DowntimeTiers []*DowntimeTier `protobuf:"bytes,6,rep,name=downtime_tiers,json=downtimeTiers,proto3" json:"downtime_tiers,omitempty"`
+	// downtime_offense_decay is the duration after which a downtime offense is
+	// forgiven: every full period elapsed since the last offense decreases the
+	// number of recent offenses by one. A zero value disables the decay.
+	DowntimeOffenseDecay *durationpb.Duration `protobuf:"bytes,7,opt,name=downtime_offense_decay,json=downtimeOffenseDecay,proto3" json:"downtime_offense_decay,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1566,6 +2487,78 @@ func (x *Params) GetSlashFractionDowntime() []byte {
 	return nil
 }
 
+func (x *Params) GetDowntimeTiers() []*DowntimeTier {
+	if x != nil {
+		return x.DowntimeTiers
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeOffenseDecay() *durationpb.Duration {
+	if x != nil {
+		return x.DowntimeOffenseDecay
+	}
+	return nil
+}
+
+// DowntimeTier defines the punishment of a downtime offense for validators
+// with a given number of recent downtime offenses.
+type DowntimeTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// min_offenses is the number of recent downtime offenses, including the
+	// current one, from which the tier applies.
+	MinOffenses int64 `protobuf:"varint,1,opt,name=min_offenses,json=minOffenses,proto3" json:"min_offenses,omitempty"`
+	// slash_fraction is the fraction of the validator stake slashed. A zero
+	// value only jails the validator.
+	SlashFraction []byte `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// jail_duration is the duration for which the validator is jailed.
+	JailDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+}
+
+func (x *DowntimeTier) Reset() {
+	*x = DowntimeTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DowntimeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DowntimeTier) ProtoMessage() {}
+
+// Deprecated: Use DowntimeTier.ProtoReflect.Descriptor instead.
+func (*DowntimeTier) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DowntimeTier) GetMinOffenses() int64 {
+	if x != nil {
+		return x.MinOffenses
+	}
+	return 0
+}
+
+func (x *DowntimeTier) GetSlashFraction() []byte {
+	if x != nil {
+		return x.SlashFraction
+	}
+	return nil
+}
+
+func (x *DowntimeTier) GetJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.JailDuration
+	}
+	return nil
+}
+
 var File_cosmos_slashing_v1beta1_slashing_proto protoreflect.FileDescriptor
 
 var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
@@ -1581,7 +2574,7 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfd, 0x03, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
 	0x3b, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
@@ -1601,56 +2594,96 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62,
 	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x8d,
-	0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x69, 0x0a, 0x15, 0x6d,
-	0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
-	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5e, 0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x1a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f,
-	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
-	0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
-	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x6e, 0x0a, 0x17, 0x73,
-	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f,
-	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x21, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73,
-	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xe8,
-	0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x0d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6c,
-	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
-	0x43, 0x53, 0x58, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x17,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x15, 0xda, 0xb4, 0x2d, 0x11, 0x78, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x20, 0x76, 0x30, 0x2e, 0x33, 0x2e, 0x30, 0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x15,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66,
+	0x66, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0xda, 0xb4, 0x2d, 0x11, 0x78, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x20,
+	0x76, 0x30, 0x2e, 0x33, 0x2e, 0x30, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x6c, 0x61, 0x73,
+	0x74, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe6, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x69, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5e,
+	0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98,
+	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73,
+	0x0a, 0x1a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x12, 0x6e, 0x0a, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x67, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69,
+	0x65, 0x72, 0x42, 0x19, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xb4, 0x2d, 0x11, 0x78, 0x2f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x30, 0x2e, 0x33, 0x2e, 0x30, 0x52, 0x0d, 0x64,
+	0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x6e, 0x0a, 0x16,
+	0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65,
+	0x5f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f,
+	0x01, 0xda, 0xb4, 0x2d, 0x11, 0x78, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x20,
+	0x76, 0x30, 0x2e, 0x33, 0x2e, 0x30, 0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x63, 0x61, 0x79, 0x3a, 0x21, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+	0xf6, 0x01, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x65, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x65, 0x6e,
+	0x73, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x78, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x20, 0x76, 0x30, 0x2e, 0x33, 0x2e, 0x30, 0x42, 0xe8, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a,
+	0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0d, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x17,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x3a, 0x3a, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1665,21 +2698,26 @@ func file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP() []byte {
 	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescData
 }
 
-var file_cosmos_slashing_v1beta1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_slashing_v1beta1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cosmos_slashing_v1beta1_slashing_proto_goTypes = []interface{}{
 	(*ValidatorSigningInfo)(nil),  // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo
 	(*Params)(nil),                // 1: cosmos.slashing.v1beta1.Params
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+	(*DowntimeTier)(nil),          // 2: cosmos.slashing.v1beta1.DowntimeTier
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
 }
 var file_cosmos_slashing_v1beta1_slashing_proto_depIdxs = []int32{
-	2, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
-	3, // 1: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
+	3, // 1: cosmos.slashing.v1beta1.ValidatorSigningInfo.last_downtime_offense:type_name -> google.protobuf.Timestamp
+	4, // 2: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
+	2, // 3: cosmos.slashing.v1beta1.Params.downtime_tiers:type_name -> cosmos.slashing.v1beta1.DowntimeTier
+	4, // 4: cosmos.slashing.v1beta1.Params.downtime_offense_decay:type_name -> google.protobuf.Duration
+	4, // 5: cosmos.slashing.v1beta1.DowntimeTier.jail_duration:type_name -> google.protobuf.Duration
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_slashing_v1beta1_slashing_proto_init() }
@@ -1712,6 +2750,18 @@ func file_cosmos_slashing_v1beta1_slashing_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DowntimeTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1719,7 +2769,7 @@ func file_cosmos_slashing_v1beta1_slashing_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_slashing_v1beta1_slashing_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -13,6 +13,7 @@ import (
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -41,11 +42,14 @@ const (
 
 // InitChain implements the ABCI interface. It initializes the application's state
 // and sets up the initial validator set.
-func (app *BaseApp) InitChain(req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+func (app *BaseApp) InitChain(req *abci.InitChainRequest) (_ *abci.InitChainResponse, err error) {
 	if req.ChainId != app.chainID {
 		return nil, fmt.Errorf("invalid chain-id on InitChain; expected: %s, got: %s", app.chainID, req.ChainId)
 	}
 
+	_, span := telemetry.StartSpan(context.Background(), "InitChain", heightAttribute(req.InitialHeight))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// On a new chain, we consider the init chain block height as 0, even though
 	// req.InitialHeight is 1 by default.
 	initHeader := cmtproto.Header{ChainID: req.ChainId, Time: req.Time}
@@ -69,6 +73,7 @@ func (app *BaseApp) InitChain(req *abci.InitChainRequest) (*abci.InitChainRespon
 	// initialize states with a correct header
 	app.setState(execModeFinalize, initHeader)
 	app.setState(execModeCheck, initHeader)
+	app.finalizeBlockState.SetContext(withSpan(app.finalizeBlockState.Context(), span))
 
 	// Store the consensus params in the BaseApp's param store. Note, this must be
 	// done after the finalizeBlockState and context have been set as it's persisted
@@ -165,7 +170,7 @@ func (app *BaseApp) Info(_ *abci.InfoRequest) (*abci.InfoResponse, error) {
 
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
-func (app *BaseApp) Query(_ context.Context, req *abci.QueryRequest) (resp *abci.QueryResponse, err error) {
+func (app *BaseApp) Query(ctx context.Context, req *abci.QueryRequest) (resp *abci.QueryResponse, err error) {
 	// add panic recovery for all queries
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/8039
@@ -185,6 +190,12 @@ func (app *BaseApp) Query(_ context.Context, req *abci.QueryRequest) (resp *abci
 	start := telemetry.Now()
 	defer telemetry.MeasureSince(start, req.Path)
 
+	_, span := telemetry.StartSpan(ctx, "Query",
+		attribute.String(telemetry.AttributeKeyQueryPath, req.Path),
+		heightAttribute(req.Height),
+	)
+	defer span.End()
+
 	if req.Path == QueryPathBroadcastTx {
 		return queryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "can't route a broadcast tx message"), app.trace), nil
 	}
@@ -410,6 +421,9 @@ func (app *BaseApp) PrepareProposal(req *abci.PrepareProposalRequest) (resp *abc
 		return nil, errors.New("PrepareProposal handler not set")
 	}
 
+	_, span := telemetry.StartSpan(context.Background(), "PrepareProposal", heightAttribute(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// Abort any running OE so it cannot overlap with `PrepareProposal`. This could happen if optimistic
 	// `internalFinalizeBlock` from previous round takes a long time, but consensus has moved on to next round.
 	// Overlap is undesirable, since `internalFinalizeBlock` and `PrepareProposal` could share access to
@@ -417,6 +431,7 @@ func (app *BaseApp) PrepareProposal(req *abci.PrepareProposalRequest) (resp *abc
 	// No-op if OE is not enabled.
 	// Similar call to Abort() is done in `ProcessProposal`.
 	app.optimisticExec.Abort()
+	app.resetGasEstimate()
 
 	// Always reset state given that PrepareProposal can timeout and be called
 	// again in a subsequent round.
@@ -454,9 +469,9 @@ func (app *BaseApp) PrepareProposal(req *abci.PrepareProposalRequest) (resp *abc
 			Time:    req.Time,
 		}))
 
-	app.prepareProposalState.SetContext(app.prepareProposalState.Context().
+	app.prepareProposalState.SetContext(withSpan(app.prepareProposalState.Context().
 		WithConsensusParams(app.GetConsensusParams(app.prepareProposalState.Context())).
-		WithBlockGasMeter(app.getBlockGasMeter(app.prepareProposalState.Context())))
+		WithBlockGasMeter(app.getBlockGasMeter(app.prepareProposalState.Context())), span))
 
 	defer func() {
 		if err := recover(); err != nil {
@@ -506,6 +521,9 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 		return nil, errors.New("ProcessProposal called with invalid height")
 	}
 
+	_, span := telemetry.StartSpan(context.Background(), "ProcessProposal", heightAttribute(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// Always reset state given that ProcessProposal can timeout and be called
 	// again in a subsequent round.
 	header := cmtproto.Header{
@@ -526,6 +544,7 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 	if req.Height > app.initialHeight {
 		// abort any running OE
 		app.optimisticExec.Abort()
+		app.resetGasEstimate()
 		app.setState(execModeFinalize, header)
 	}
 
@@ -548,9 +567,9 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 			Time:    req.Time,
 		}))
 
-	app.processProposalState.SetContext(app.processProposalState.Context().
+	app.processProposalState.SetContext(withSpan(app.processProposalState.Context().
 		WithConsensusParams(app.GetConsensusParams(app.processProposalState.Context())).
-		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.Context())))
+		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.Context())), span))
 
 	defer func() {
 		if err := recover(); err != nil {
@@ -596,7 +615,10 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 // Agreed upon vote extensions are made available to the proposer of the next
 // height and are committed in the subsequent height, i.e. H+2. An error is
 // returned if vote extensions are not enabled or if extendVote fails or panics.
-func (app *BaseApp) ExtendVote(_ context.Context, req *abci.ExtendVoteRequest) (resp *abci.ExtendVoteResponse, err error) {
+func (app *BaseApp) ExtendVote(goCtx context.Context, req *abci.ExtendVoteRequest) (resp *abci.ExtendVoteResponse, err error) {
+	_, span := telemetry.StartSpan(goCtx, "ExtendVote", heightAttribute(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// Always reset state given that ExtendVote and VerifyVoteExtension can timeout
 	// and be called again in a subsequent round.
 	var ctx sdk.Context
@@ -644,6 +666,7 @@ func (app *BaseApp) ExtendVote(_ context.Context, req *abci.ExtendVoteRequest) (
 			Hash:    req.Hash,
 			Time:    req.Time,
 		})
+	ctx = withSpan(ctx, span)
 
 	// add a deferred recover handler in case extendVote panics
 	defer func() {
@@ -680,6 +703,9 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.VerifyVoteExtensionRequest) (r
 		return nil, errors.New("application VerifyVoteExtension handler not set")
 	}
 
+	_, span := telemetry.StartSpan(context.Background(), "VerifyVoteExtension", heightAttribute(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	var ctx sdk.Context
 
 	// If we're verifying the vote for the initial height, we need to use the
@@ -732,6 +758,7 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.VerifyVoteExtensionRequest) (r
 			Height:  req.Height,
 			Hash:    req.Hash,
 		})
+	ctx = withSpan(ctx, span)
 
 	resp, err = app.verifyVoteExt(ctx, req)
 	if err != nil {
@@ -746,7 +773,10 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.VerifyVoteExtensionRequest) (r
 // Execution flow or by the FinalizeBlock ABCI method. The context received is
 // only used to handle early cancellation, for anything related to state app.finalizeBlockState.Context()
 // must be used.
-func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (_ *abci.FinalizeBlockResponse, err error) {
+	_, span := telemetry.StartSpan(ctx, "FinalizeBlock", heightAttribute(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	var events []abci.Event
 
 	if err := app.checkHalt(req.Height, req.Time); err != nil {
@@ -780,7 +810,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	}
 
 	// Context is now updated with Header information.
-	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().
+	app.finalizeBlockState.SetContext(withSpan(app.finalizeBlockState.Context(), span).
 		WithBlockHeader(header).
 		WithHeaderHash(req.Hash).
 		WithHeaderInfo(coreheader.Info{
@@ -883,6 +913,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	events = append(events, endBlock.Events...)
 	cp := app.GetConsensusParams(app.finalizeBlockState.Context())
 
+	if app.gasProfiler != nil {
+		app.gasProfiler.EndBlock()
+	}
+
 	return &abci.FinalizeBlockResponse{
 		Events:                events,
 		TxResults:             txResults,
@@ -933,6 +967,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (res *abci.Fin
 		// if it was aborted, we need to reset the state
 		app.finalizeBlockState = nil
 		app.optimisticExec.Reset()
+		app.resetGasEstimate()
 	}
 
 	// if no OE is running, just run the block (this is either a block replay or a OE that got aborted)
@@ -969,8 +1004,11 @@ func (app *BaseApp) checkHalt(height int64, time time.Time) error {
 // defined in config, Commit will execute a deferred function call to check
 // against that height and gracefully halt if it matches the latest committed
 // height.
-func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
+func (app *BaseApp) Commit() (_ *abci.CommitResponse, err error) {
 	header := app.finalizeBlockState.Context().BlockHeader()
+
+	spanCtx, span := telemetry.StartSpan(context.Background(), "Commit", heightAttribute(header.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
 	if app.precommiter != nil {
@@ -986,7 +1024,9 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 		RetainHeight: retainHeight,
 	}
 
+	_, storeSpan := telemetry.StartSpan(spanCtx, "store.Commit")
 	app.cms.Commit()
+	storeSpan.End()
 
 	abciListeners := app.streamingManager.ABCIListeners
 	if len(abciListeners) > 0 {
@@ -1021,6 +1061,12 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 
 	app.finalizeBlockState = nil
 
+	// The transactions of the block are only added to the gas estimates once
+	// committed, as an optimistic execution of the block may be aborted.
+	if app.gasEstimator != nil {
+		app.gasEstimator.EndBlock()
+	}
+
 	if app.prepareCheckStater != nil {
 		app.prepareCheckStater(app.checkState.Context())
 	}
@@ -18,6 +18,7 @@ import (
 	cmtprotocrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmttypes "github.com/cometbft/cometbft/types"
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/jsonpb"
@@ -25,6 +26,11 @@ import (
 	gogotypes "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/mock/gomock"
 
 	coretesting "cosmossdk.io/core/testing"
@@ -36,11 +42,14 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -686,6 +695,187 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	tx := newTxCounter(t, suite.txConfig, suite.ac, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	require.True(t, res.TxResults[0].IsOK())
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	for _, name := range []string{"InitChain", "FinalizeBlock", "runTx", "AnteHandler", msgTypeURL, "Commit", "store.Commit"} {
+		require.Contains(t, spans, name)
+	}
+
+	// the transaction spans are nested in the block span
+	require.Equal(t, spans["FinalizeBlock"].SpanContext().SpanID(), spans["runTx"].Parent().SpanID())
+	require.Equal(t, spans["runTx"].SpanContext().SpanID(), spans["AnteHandler"].Parent().SpanID())
+	require.Equal(t, spans["runTx"].SpanContext().SpanID(), spans[msgTypeURL].Parent().SpanID())
+	require.Equal(t, spans["Commit"].SpanContext().SpanID(), spans["store.Commit"].Parent().SpanID())
+	require.Contains(t, spans["runTx"].Attributes(), attribute.String(telemetry.AttributeKeyExecMode, "finalize"))
+	require.Contains(t, spans["runTx"].Attributes(), attribute.String(telemetry.AttributeKeyTxHash, fmt.Sprintf("%X", tmhash.Sum(txBytes))))
+	require.Contains(t, spans[msgTypeURL].Attributes(), attribute.String(telemetry.AttributeKeyMsgTypeURL, msgTypeURL))
+}
+
+func TestABCI_FinalizeBlock_GasProfile(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	profiler := gasprofile.NewProfiler()
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetGasProfiler(profiler))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	var txBytes []byte
+	for height := int64(1); height <= 2; height++ {
+		tx := newTxCounter(t, suite.txConfig, suite.ac, height-1, height-1)
+		txBytes, err = suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Txs: [][]byte{txBytes}})
+		require.NoError(t, err)
+		require.True(t, res.TxResults[0].IsOK())
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	requireProfile := func(profile *gasprofile.GasProfile, blocks uint64) {
+		t.Helper()
+		require.Equal(t, blocks, profile.Blocks)
+		require.Len(t, profile.Msgs, 1)
+
+		msg := profile.Msgs[0]
+		require.Equal(t, msgTypeURL, msg.MsgTypeUrl)
+		require.Equal(t, blocks, msg.Count)
+		require.Positive(t, msg.Duration)
+		require.Len(t, msg.Stores, 2)
+
+		// the gas consumed directly by the handler is not attributed to a store
+		other := msg.Stores[0]
+		require.Empty(t, other.StoreKey)
+		require.Equal(t, 5*blocks, other.OtherGas)
+
+		store := msg.Stores[1]
+		require.Equal(t, capKey1.Name(), store.StoreKey)
+		require.Equal(t, blocks, store.Reads)
+		require.Equal(t, blocks, store.Writes)
+		require.Equal(t, other.OtherGas+store.ReadGas+store.WriteGas, msg.GasUsed)
+	}
+
+	// only the messages are profiled, not the ante handler
+	requireProfile(profiler.Profile(), 2)
+
+	// block replay is disabled by default
+	_, err = suite.baseApp.ReplayBlockGasProfile(context.Background(), 2)
+	require.ErrorContains(t, err, "block replay is disabled")
+
+	blocks := func(_ context.Context, height int64) (time.Time, [][]byte, error) {
+		require.Equal(t, int64(2), height)
+		return time.Now(), [][]byte{txBytes}, nil
+	}
+	suite.baseApp.SetGasProfileReplay(blocks, 0)
+
+	// replaying a block does not affect the profile of the finalized blocks
+	replayed, err := suite.baseApp.ReplayBlockGasProfile(context.Background(), 2)
+	require.NoError(t, err)
+	requireProfile(replayed, 1)
+	requireProfile(profiler.Profile(), 2)
+
+	// the replayed block state changes are discarded
+	_, err = suite.baseApp.ReplayBlockGasProfile(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), getIntFromStore(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1), deliverKey))
+
+	// the gas of a replay is capped
+	suite.baseApp.SetGasProfileReplay(blocks, 1)
+	replayed, err = suite.baseApp.ReplayBlockGasProfile(context.Background(), 2)
+	require.NoError(t, err)
+	require.Empty(t, replayed.Msgs)
+}
+
+func TestABCI_FinalizeBlock_GasEstimate(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	estimator := gasestimate.NewEstimator(2)
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetGasEstimator(estimator))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	msgTypeURL := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	for height := int64(1); height <= 3; height++ {
+		tx := newTxCounter(t, suite.txConfig, suite.ac, height-1, height-1)
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Txs: [][]byte{txBytes}})
+		require.NoError(t, err)
+		require.True(t, res.TxResults[0].IsOK())
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// only the transactions of the last 2 blocks are kept
+	_, _, samples := estimator.SuggestGasLimit([]string{msgTypeURL}, 1000)
+	require.Equal(t, uint64(2), samples)
+
+	tx := newTxCounter(t, suite.txConfig, suite.ac, 3, 3)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res, err := gasestimate.NewQueryServer(suite.baseApp, estimator).EstimateGas(context.Background(), &gasestimate.EstimateGasRequest{TxBytes: txBytes})
+	require.NoError(t, err)
+	require.Positive(t, res.GasUsed)
+	require.Len(t, res.Msgs, 1)
+	require.Equal(t, msgTypeURL, res.Msgs[0].MsgTypeUrl)
+	require.Equal(t, uint64(1), res.Msgs[0].Count)
+	require.Less(t, res.Msgs[0].GasUsed, res.GasUsed)
+	require.Equal(t, uint64(2), res.Samples)
+	require.True(t, res.GasMargin.GTE(gasestimate.MinGasMargin))
+	require.Greater(t, res.GasLimit, res.GasUsed)
+	// the counter transactions do not pay fees
+	require.Empty(t, res.Fees)
+
+	// the simulation does not change the state
+	require.Equal(t, int64(3), getIntFromStore(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1), deliverKey))
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -2679,6 +2869,52 @@ func TestOptimisticExecution(t *testing.T) {
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
 
+func TestOptimisticExecution_GasEstimate(t *testing.T) {
+	estimator := gasestimate.NewEstimator(2)
+	suite := NewBaseAppSuite(t, baseapp.SetOptimisticExecution(), baseapp.SetGasEstimator(estimator))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the optimistic execution starts after the initial height
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, suite.ac, 0, 1)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	respProcProp, err := suite.baseApp.ProcessProposal(&abci.ProcessProposalRequest{
+		Txs:    [][]byte{txBytes},
+		Height: 2,
+		Hash:   []byte("some-hash"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, respProcProp.Status)
+
+	// let the optimistic execution run the transaction
+	time.Sleep(100 * time.Millisecond)
+
+	// the optimistic execution is aborted as the block hash differs
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Txs:    [][]byte{txBytes},
+		Height: 2,
+		Hash:   []byte("other-hash"),
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// the transaction is only recorded once
+	_, _, samples := estimator.SuggestGasLimit([]string{sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})}, 1000)
+	require.Equal(t, uint64(1), samples)
+}
+
 func TestABCI_Proposal_FailReCheckTx(t *testing.T) {
 	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
 		TxPriority:      mempool.NewDefaultTxPriority(),
@@ -14,6 +14,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/core/header"
@@ -26,6 +27,8 @@ import (
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -189,6 +192,19 @@ type BaseApp struct {
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
 
+	// gasProfiler records the gas consumed and the time spent by the messages
+	// of the finalized blocks. It is nil unless enabled with SetGasProfiler.
+	gasProfiler *gasprofile.Profiler
+	// gasProfileBlocks loads the blocks replayed by the gas profile service. Block
+	// replay is disabled if it is nil.
+	gasProfileBlocks gasprofile.BlockFetcher
+	// gasProfileReplayMaxGas is the maximum gas of a block replay.
+	gasProfileReplayMaxGas uint64
+
+	// gasEstimator records the gas used and the gas prices of the transactions
+	// of the finalized blocks. It is nil unless enabled with SetGasEstimator.
+	gasEstimator *gasestimate.Estimator
+
 	// includeNestedMsgsGas holds a set of message types for which gas costs for its nested messages are calculated.
 	includeNestedMsgsGas map[string]struct{}
 }
@@ -836,12 +852,27 @@ type HasNestedMsgs interface {
 // both txbytes and the decoded tx are passed to runTx to avoid the state machine encoding the tx and decoding the transaction twice
 // passing the decoded tx to runTX is optional, it will be decoded if the tx is nil
 func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+}
+
+// runTxWithContext is runTx on the given context instead of the context of the
+// state of the execution mode, e.g. to replay a transaction.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	spanCtx, span := telemetry.StartSpan(ctx.Context(), "runTx",
+		attribute.String(telemetry.AttributeKeyExecMode, mode.String()),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+	// only hash the tx and branch the context when the span is traced, as runTx
+	// is on the hot path and tracing is disabled by default
+	if span.IsRecording() {
+		span.SetAttributes(txHashAttribute(txBytes))
+		ctx = ctx.WithContext(spanCtx)
+	}
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -918,7 +949,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
+		anteSpanCtx, anteSpan := telemetry.StartSpan(anteCtx.Context(), "AnteHandler")
+		if anteSpan.IsRecording() {
+			anteCtx = anteCtx.WithContext(anteSpanCtx)
+		}
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
+		telemetry.EndSpan(anteSpan, err)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -928,6 +964,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 			// the instantiated gas meter in the AnteHandler, so we update the context
 			// prior to returning.
 			ctx = newCtx.WithMultiStore(ms)
+			// restore the tx span as the parent of the next spans
+			if span.IsRecording() {
+				ctx = withSpan(ctx, span)
+			}
 		}
 
 		events := ctx.EventManager().Events()
@@ -954,7 +994,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		if err != nil {
 			return gInfo, nil, anteEvents, err
 		}
-	} else if mode == execModeFinalize {
+	} else if mode == execModeFinalize && !isReplay(ctx) {
 		err = app.mempool.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents,
@@ -993,7 +1033,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		// Note that the state is still preserved.
 		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
 
+		postSpanCtx, postSpan := telemetry.StartSpan(postCtx.Context(), "PostHandler")
+		if postSpan.IsRecording() {
+			postCtx = postCtx.WithContext(postSpanCtx)
+		}
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
+		telemetry.EndSpan(postSpan, errPostHandler)
 		if errPostHandler != nil {
 			return gInfo, nil, anteEvents, errors.Join(err, errPostHandler)
 		}
@@ -1011,6 +1056,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 			consumeBlockGas()
 
 			msCache.Write()
+			app.recordGasEstimate(ctx, tx, msgs, gasWanted)
 		}
 
 		if len(anteEvents) > 0 && (mode == execModeFinalize || mode == execModeSimulate) {
@@ -1044,7 +1090,16 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, reflectMsgs []proto
 		}
 
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgCtx, meter := ctx, (*gasprofile.Meter)(nil)
+		if profiler := app.gasProfilerFor(ctx, mode); profiler != nil {
+			meter = profiler.Meter(sdk.MsgTypeURL(msg), ctx.GasMeter())
+			msgCtx = ctx.WithGasMeter(meter)
+		}
+
+		msgResult, err := handler(msgCtx, msg)
+		if meter != nil {
+			meter.Finish()
+		}
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -0,0 +1,60 @@
+package baseapp
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ gasestimate.App = (*BaseApp)(nil)
+
+// SimulateGasProfile simulates the given transaction against the latest check
+// state and returns the gas it used and the gas profile of its messages.
+func (app *BaseApp) SimulateGasProfile(_ context.Context, txBytes []byte) (uint64, *gasprofile.GasProfile, error) {
+	profiler := gasprofile.NewProfiler()
+	ctx := app.getContextForTx(execModeSimulate, txBytes).WithValue(gasProfilerKey{}, profiler)
+
+	gasInfo, _, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes, nil)
+	if err != nil {
+		return gasInfo.GasUsed, nil, err
+	}
+
+	return gasInfo.GasUsed, profiler.Profile(), nil
+}
+
+// GasEstimator returns the estimator of the finalized blocks, nil if gas
+// estimation is disabled.
+func (app *BaseApp) GasEstimator() *gasestimate.Estimator {
+	return app.gasEstimator
+}
+
+// resetGasEstimate discards the transactions recorded in the gas estimator, if
+// enabled, by an aborted optimistic execution of the block, as the block is
+// executed again.
+func (app *BaseApp) resetGasEstimate() {
+	if app.gasEstimator != nil {
+		app.gasEstimator.ResetBlock()
+	}
+}
+
+// recordGasEstimate records a transaction successfully executed in a finalized
+// block in the gas estimator, if enabled.
+func (app *BaseApp) recordGasEstimate(ctx sdk.Context, tx sdk.Tx, msgs []sdk.Msg, gasWanted uint64) {
+	if app.gasEstimator == nil || isReplay(ctx) {
+		return
+	}
+
+	msgTypeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	var fee sdk.Coins
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		fee = feeTx.GetFee()
+	}
+
+	app.gasEstimator.RecordTx(msgTypeURLs, gasWanted, ctx.GasMeter().GasConsumed(), fee)
+}
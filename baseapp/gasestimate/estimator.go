@@ -0,0 +1,220 @@
+package gasestimate
+
+import (
+	stdmath "math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultWindow is the default number of recent blocks the suggestions are
+	// computed from.
+	DefaultWindow = 100
+
+	// marginDeviations is the number of standard deviations, relative to the
+	// mean gas used, applied as gas margin.
+	marginDeviations = 2
+	// minSamples is the minimum number of recently included transactions
+	// required to compute the gas margin from their variance.
+	minSamples = 2
+)
+
+var (
+	// DefaultGasMargin is the gas margin of the transactions without enough
+	// recently included transactions with the same message types.
+	DefaultGasMargin = math.LegacyNewDecWithPrec(3, 1)
+	// MinGasMargin is the minimum gas margin.
+	MinGasMargin = math.LegacyNewDecWithPrec(1, 1)
+	// MaxGasMargin is the maximum gas margin.
+	MaxGasMargin = math.LegacyOneDec()
+
+	// DefaultPercentiles are the default percentiles of the gas prices fees
+	// are suggested for.
+	DefaultPercentiles = []uint32{25, 50, 75}
+)
+
+// Estimator records the gas used and the gas prices of the transactions
+// included in the recent blocks, to suggest the gas limit and the fees of new
+// transactions. It is safe for concurrent use.
+type Estimator struct {
+	mu      sync.Mutex
+	window  int
+	blocks  []*blockSamples
+	current *blockSamples
+}
+
+// NewEstimator returns an Estimator suggesting gas limits and fees from the
+// given number of recent blocks.
+func NewEstimator(window int) *Estimator {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+
+	return &Estimator{window: window, current: newBlockSamples()}
+}
+
+// RecordTx records a transaction successfully included in the current block,
+// with the type URLs of its messages.
+func (e *Estimator) RecordTx(msgTypeURLs []string, gasWanted, gasUsed uint64, fee sdk.Coins) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.current.recordGas(txKey(msgTypeURLs), gasUsed)
+
+	if gasWanted == 0 {
+		return
+	}
+
+	gas := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasWanted))
+	for _, coin := range fee {
+		e.current.gasPrices[coin.Denom] = append(e.current.gasPrices[coin.Denom], coin.Amount.ToLegacyDec().Quo(gas))
+	}
+}
+
+// EndBlock adds the transactions recorded since the previous call to the
+// window, discarding the oldest block if the window is full.
+func (e *Estimator) EndBlock() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.blocks = append(e.blocks, e.current)
+	if len(e.blocks) > e.window {
+		e.blocks[0] = nil
+		e.blocks = e.blocks[1:]
+	}
+
+	e.current = newBlockSamples()
+}
+
+// ResetBlock discards the transactions recorded since the previous call to
+// EndBlock, as the execution of the block was aborted before its end.
+func (e *Estimator) ResetBlock() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.current = newBlockSamples()
+}
+
+// SuggestGasLimit returns the suggested gas limit of a transaction with the
+// given message types from its simulated gas, along with the gas margin applied
+// and the number of samples it is computed from.
+func (e *Estimator) SuggestGasLimit(msgTypeURLs []string, gasUsed uint64) (uint64, math.LegacyDec, uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	key := txKey(msgTypeURLs)
+
+	var total gasSamples
+	for _, block := range e.blocks {
+		samples := block.gas[key]
+		total.count += samples.count
+		total.sum += samples.sum
+		total.sumSquares += samples.sumSquares
+	}
+
+	margin := DefaultGasMargin
+	if total.count >= minSamples && total.sum > 0 {
+		mean := total.sum / float64(total.count)
+		variance := stdmath.Max(total.sumSquares/float64(total.count)-mean*mean, 0)
+		deviation := marginDeviations * stdmath.Sqrt(variance) / mean
+		margin = math.LegacyNewDecWithPrec(int64(stdmath.Ceil(deviation*1e6)), 6)
+		if margin.LT(MinGasMargin) {
+			margin = MinGasMargin
+		}
+		if margin.GT(MaxGasMargin) {
+			margin = MaxGasMargin
+		}
+	}
+
+	gasLimit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)).Mul(margin.Add(math.LegacyOneDec())).Ceil()
+	return gasLimit.TruncateInt().Uint64(), margin, total.count
+}
+
+// SuggestFees returns the fees of a transaction with the given gas limit for
+// each of the given percentiles of the recent gas prices. Percentiles must be
+// between 1 and 100.
+func (e *Estimator) SuggestFees(gasLimit uint64, percentiles []uint32) []*FeeSuggestion {
+	e.mu.Lock()
+	prices := make(map[string][]math.LegacyDec)
+	for _, block := range e.blocks {
+		for denom, p := range block.gasPrices {
+			prices[denom] = append(prices[denom], p...)
+		}
+	}
+	e.mu.Unlock()
+
+	if len(prices) == 0 {
+		return nil
+	}
+
+	denoms := make([]string, 0, len(prices))
+	for denom, p := range prices {
+		sort.Slice(p, func(i, j int) bool { return p[i].LT(p[j]) })
+		denoms = append(denoms, denom)
+	}
+	slices.Sort(denoms)
+
+	gas := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasLimit))
+	suggestions := make([]*FeeSuggestion, 0, len(percentiles))
+	for _, percentile := range percentiles {
+		suggestion := &FeeSuggestion{Percentile: percentile}
+		for _, denom := range denoms {
+			p := prices[denom]
+			// nearest-rank percentile
+			i := int(stdmath.Ceil(float64(percentile)*float64(len(p))/100)) - 1
+			price := p[max(i, 0)]
+
+			suggestion.GasPrices = append(suggestion.GasPrices, sdk.NewDecCoinFromDec(denom, price))
+			if fee := price.Mul(gas).Ceil().TruncateInt(); fee.IsPositive() {
+				suggestion.Fees = append(suggestion.Fees, sdk.NewCoin(denom, fee))
+			}
+		}
+
+		suggestions = append(suggestions, suggestion)
+	}
+
+	return suggestions
+}
+
+// txKey returns the key the gas used by the transactions with the given
+// message types is recorded under: their sorted and deduplicated type URLs.
+func txKey(msgTypeURLs []string) string {
+	urls := slices.Clone(msgTypeURLs)
+	slices.Sort(urls)
+	return strings.Join(slices.Compact(urls), ",")
+}
+
+// blockSamples are the samples recorded over a block.
+type blockSamples struct {
+	gas       map[string]gasSamples
+	gasPrices map[string][]math.LegacyDec
+}
+
+func newBlockSamples() *blockSamples {
+	return &blockSamples{
+		gas:       make(map[string]gasSamples),
+		gasPrices: make(map[string][]math.LegacyDec),
+	}
+}
+
+func (b *blockSamples) recordGas(key string, gasUsed uint64) {
+	samples := b.gas[key]
+	samples.count++
+	samples.sum += float64(gasUsed)
+	samples.sumSquares += float64(gasUsed) * float64(gasUsed)
+	b.gas[key] = samples
+}
+
+// gasSamples are the count, the sum and the sum of the squares of the gas used
+// by transactions, from which their mean and variance derive.
+type gasSamples struct {
+	count      uint64
+	sum        float64
+	sumSquares float64
+}
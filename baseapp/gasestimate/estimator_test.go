@@ -0,0 +1,85 @@
+package gasestimate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEstimator(t *testing.T) {
+	const (
+		send = "/cosmos.bank.v1beta1.MsgSend"
+		vote = "/cosmos.gov.v1.MsgVote"
+	)
+
+	estimator := NewEstimator(2)
+
+	// without samples, the default margin is applied
+	gasLimit, margin, samples := estimator.SuggestGasLimit([]string{send}, 1000)
+	require.Equal(t, uint64(1300), gasLimit)
+	require.Equal(t, DefaultGasMargin, margin)
+	require.Zero(t, samples)
+	require.Empty(t, estimator.SuggestFees(gasLimit, DefaultPercentiles))
+
+	// the oldest block is discarded
+	estimator.RecordTx([]string{send}, 100_000, 1_000_000, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000_000)))
+	estimator.EndBlock()
+
+	for i, gasUsed := range []uint64{900, 1000, 1100} {
+		fee := sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i+1)*1000), sdk.NewInt64Coin("atom", 500))
+		estimator.RecordTx([]string{send, send}, 1000, gasUsed, fee)
+	}
+	estimator.EndBlock()
+	estimator.RecordTx([]string{vote, send}, 1000, 1000, nil)
+	estimator.EndBlock()
+
+	// the margin is 2 standard deviations of the gas used relative to its mean
+	gasLimit, margin, samples = estimator.SuggestGasLimit([]string{send}, 1000)
+	require.Equal(t, uint64(3), samples)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.163300"), margin)
+	require.Equal(t, uint64(1164), gasLimit)
+
+	// a single sample is not enough to compute the margin
+	gasLimit, margin, samples = estimator.SuggestGasLimit([]string{send, vote}, 1000)
+	require.Equal(t, uint64(1), samples)
+	require.Equal(t, DefaultGasMargin, margin)
+	require.Equal(t, uint64(1300), gasLimit)
+
+	fees := estimator.SuggestFees(2000, []uint32{1, 50, 100})
+	require.Equal(t, []*FeeSuggestion{
+		{
+			Percentile: 1,
+			GasPrices:  sdk.DecCoins{sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(5, 1)), sdk.NewInt64DecCoin("stake", 1)},
+			Fees:       sdk.Coins{sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 2000)},
+		},
+		{
+			Percentile: 50,
+			GasPrices:  sdk.DecCoins{sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(5, 1)), sdk.NewInt64DecCoin("stake", 2)},
+			Fees:       sdk.Coins{sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 4000)},
+		},
+		{
+			Percentile: 100,
+			GasPrices:  sdk.DecCoins{sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(5, 1)), sdk.NewInt64DecCoin("stake", 3)},
+			Fees:       sdk.Coins{sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 6000)},
+		},
+	}, fees)
+
+	// the margin is at least the minimum margin
+	estimator.RecordTx([]string{vote}, 1000, 1000, nil)
+	estimator.RecordTx([]string{vote}, 1000, 1000, nil)
+	estimator.EndBlock()
+	gasLimit, margin, _ = estimator.SuggestGasLimit([]string{vote}, 1000)
+	require.Equal(t, MinGasMargin, margin)
+	require.Equal(t, uint64(1100), gasLimit)
+
+	// the transactions of an aborted block are discarded
+	estimator.RecordTx([]string{vote}, 1000, 900, nil)
+	estimator.ResetBlock()
+	estimator.EndBlock()
+	_, _, samples = estimator.SuggestGasLimit([]string{vote}, 1000)
+	require.Equal(t, uint64(2), samples)
+}
@@ -0,0 +1,1250 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/gasestimate/v1beta1/query.proto
+
+package gasestimate
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	gasprofile "github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EstimateGasRequest defines the request structure for the EstimateGas gRPC query.
+type EstimateGasRequest struct {
+	// tx_bytes is the raw transaction to estimate. As for a simulation, its
+	// signatures do not need to be valid.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// percentiles are the percentiles, between 1 and 100, of the gas prices of
+	// the recently included transactions to suggest fees for. Defaults to 25, 50
+	// and 75.
+	Percentiles []uint32 `protobuf:"varint,2,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (m *EstimateGasRequest) Reset()         { *m = EstimateGasRequest{} }
+func (m *EstimateGasRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasRequest) ProtoMessage()    {}
+func (*EstimateGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7849d52876eb5832, []int{0}
+}
+func (m *EstimateGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasRequest.Merge(m, src)
+}
+func (m *EstimateGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasRequest proto.InternalMessageInfo
+
+func (m *EstimateGasRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *EstimateGasRequest) GetPercentiles() []uint32 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// EstimateGasResponse defines the response structure for the EstimateGas gRPC query.
+type EstimateGasResponse struct {
+	// gas_used is the gas consumed by the simulated transaction.
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// msgs are the gas profiles of the messages of the transaction, per message
+	// type. The gas consumed outside of the messages, e.g. by the signature
+	// verification, is not part of them.
+	Msgs []*gasprofile.MsgGasProfile `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// gas_limit is the suggested gas limit of the transaction: the gas used
+	// increased by the gas margin.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_margin is the margin applied to the gas used, from the variance of the
+	// gas used by the recently included transactions with the same message
+	// types.
+	GasMargin cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=gas_margin,json=gasMargin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_margin"`
+	// samples is the number of recently included transactions the gas margin
+	// is computed from. A default margin is used without enough samples.
+	Samples uint64 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	// fees are the suggested fees of the transaction for each percentile. It is
+	// empty if no transaction paying fees was recently included.
+	Fees []*FeeSuggestion `protobuf:"bytes,6,rep,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
+func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasResponse) ProtoMessage()    {}
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7849d52876eb5832, []int{1}
+}
+func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasResponse.Merge(m, src)
+}
+func (m *EstimateGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasResponse proto.InternalMessageInfo
+
+func (m *EstimateGasResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EstimateGasResponse) GetMsgs() []*gasprofile.MsgGasProfile {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *EstimateGasResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EstimateGasResponse) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func (m *EstimateGasResponse) GetFees() []*FeeSuggestion {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// FeeSuggestion is the fee suggested for a transaction from a percentile of the
+// gas prices of the recently included transactions.
+type FeeSuggestion struct {
+	Percentile uint32 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// gas_prices are the percentile of the gas prices, per fee denom.
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+	// fees are the gas prices multiplied by the suggested gas limit, rounded up.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *FeeSuggestion) Reset()         { *m = FeeSuggestion{} }
+func (m *FeeSuggestion) String() string { return proto.CompactTextString(m) }
+func (*FeeSuggestion) ProtoMessage()    {}
+func (*FeeSuggestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7849d52876eb5832, []int{2}
+}
+func (m *FeeSuggestion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSuggestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSuggestion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSuggestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSuggestion.Merge(m, src)
+}
+func (m *FeeSuggestion) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSuggestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSuggestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSuggestion proto.InternalMessageInfo
+
+func (m *FeeSuggestion) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *FeeSuggestion) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func (m *FeeSuggestion) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EstimateGasRequest)(nil), "cosmos.base.gasestimate.v1beta1.EstimateGasRequest")
+	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.base.gasestimate.v1beta1.EstimateGasResponse")
+	proto.RegisterType((*FeeSuggestion)(nil), "cosmos.base.gasestimate.v1beta1.FeeSuggestion")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/gasestimate/v1beta1/query.proto", fileDescriptor_7849d52876eb5832)
+}
+
+var fileDescriptor_7849d52876eb5832 = []byte{
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x6f, 0x13, 0x4d,
+	0x10, 0xf6, 0x39, 0x7e, 0xe3, 0x37, 0x1b, 0xd2, 0x2c, 0x14, 0xce, 0x87, 0xce, 0x96, 0x2b, 0x93,
+	0x28, 0x7b, 0xf9, 0xaa, 0xe8, 0x30, 0x81, 0x48, 0x28, 0x91, 0xc2, 0x45, 0x34, 0x34, 0xd6, 0xfa,
+	0x3c, 0xd9, 0xac, 0xe2, 0xbb, 0xbd, 0xdc, 0xac, 0xa3, 0xb8, 0xcd, 0x2f, 0x40, 0xe2, 0x5f, 0x50,
+	0x50, 0x51, 0x51, 0x51, 0xa6, 0x8c, 0xa0, 0x41, 0x14, 0x01, 0x25, 0xfc, 0x10, 0xb4, 0x1f, 0x16,
+	0x4e, 0x88, 0x08, 0x54, 0xde, 0x79, 0x66, 0xe6, 0x79, 0x66, 0x1e, 0x8d, 0x8f, 0x2c, 0x25, 0x0a,
+	0x53, 0x85, 0x51, 0x97, 0x23, 0x44, 0x82, 0x23, 0xa0, 0x96, 0x29, 0xd7, 0x10, 0x1d, 0xaf, 0x76,
+	0x41, 0xf3, 0xd5, 0xe8, 0x68, 0x00, 0xc5, 0x90, 0xe5, 0x85, 0xd2, 0x8a, 0xd6, 0x5d, 0x31, 0x33,
+	0xc5, 0x6c, 0xac, 0x98, 0xf9, 0xe2, 0xb9, 0x05, 0xa1, 0x94, 0xe8, 0x43, 0xc4, 0x73, 0x19, 0xf1,
+	0x2c, 0x53, 0x9a, 0x6b, 0xa9, 0x32, 0x74, 0xed, 0x73, 0x0f, 0x84, 0x12, 0xca, 0x3e, 0x23, 0xf3,
+	0xf2, 0xe8, 0xac, 0x23, 0xed, 0xb8, 0x84, 0x57, 0x70, 0xa9, 0x70, 0x7c, 0xb8, 0xd1, 0x40, 0x89,
+	0x92, 0x99, 0xcf, 0x2f, 0xde, 0x18, 0x3e, 0x2f, 0xd4, 0xbe, 0xec, 0xdf, 0x3a, 0x7b, 0xf3, 0x05,
+	0xa1, 0x4f, 0xfd, 0xb8, 0x5b, 0x1c, 0x63, 0x38, 0x1a, 0x00, 0x6a, 0x3a, 0x4b, 0xfe, 0xd7, 0x27,
+	0x9d, 0xee, 0x50, 0x03, 0xd6, 0x82, 0x46, 0xd0, 0xba, 0x17, 0x57, 0xf5, 0x49, 0xdb, 0x84, 0xb4,
+	0x41, 0xa6, 0x73, 0x28, 0x12, 0xc8, 0xb4, 0xec, 0x03, 0xd6, 0xca, 0x8d, 0x89, 0xd6, 0x4c, 0x3c,
+	0x0e, 0x35, 0x3f, 0x96, 0xc9, 0xfd, 0x6b, 0x9c, 0x98, 0xab, 0x0c, 0xc1, 0x90, 0x0a, 0x8e, 0x9d,
+	0x01, 0x42, 0xcf, 0x92, 0x56, 0xe2, 0xaa, 0xe0, 0xf8, 0x12, 0xa1, 0x47, 0x1f, 0x93, 0x4a, 0x8a,
+	0xc2, 0xb1, 0x4d, 0xaf, 0x2d, 0xb3, 0x1b, 0x86, 0xfa, 0x05, 0x46, 0x7e, 0xb2, 0x1d, 0x14, 0x5b,
+	0x1c, 0x77, 0x1d, 0x1a, 0xdb, 0x56, 0x3a, 0x4f, 0xa6, 0x0c, 0x7b, 0x5f, 0xa6, 0x52, 0xd7, 0x26,
+	0x2c, 0xbd, 0x91, 0xdb, 0x36, 0x31, 0xdd, 0x25, 0xc4, 0x24, 0x53, 0x5e, 0x08, 0x99, 0xd5, 0x2a,
+	0x8d, 0xa0, 0x35, 0xd5, 0x5e, 0x3d, 0xbb, 0xa8, 0x97, 0xbe, 0x5e, 0xd4, 0xe7, 0x9d, 0x18, 0xf6,
+	0x0e, 0x99, 0x54, 0x51, 0xca, 0xf5, 0x01, 0xdb, 0x06, 0xc1, 0x93, 0xe1, 0x26, 0x24, 0x9f, 0xde,
+	0x2f, 0x13, 0x3f, 0xcb, 0x26, 0x24, 0xb1, 0x51, 0xd8, 0xb1, 0x1c, 0xb4, 0x46, 0xaa, 0xc8, 0xd3,
+	0xdc, 0x58, 0xf0, 0x9f, 0xdb, 0xc5, 0x87, 0xb4, 0x4d, 0x2a, 0xfb, 0x00, 0x58, 0x9b, 0xb4, 0xbb,
+	0x30, 0x76, 0xc7, 0x71, 0xb0, 0x67, 0x00, 0x7b, 0x03, 0x21, 0x0c, 0xae, 0xb2, 0xd8, 0xf6, 0x36,
+	0x4f, 0xcb, 0x64, 0xe6, 0x1a, 0x4e, 0x43, 0x42, 0x7e, 0x79, 0x6c, 0xed, 0x9b, 0x89, 0xc7, 0x10,
+	0x9a, 0xbb, 0x0d, 0xf3, 0x42, 0x26, 0x30, 0xf2, 0x71, 0xe1, 0x9a, 0xf6, 0x48, 0x6f, 0x13, 0x92,
+	0x27, 0x4a, 0x66, 0xed, 0x75, 0xb3, 0xff, 0xdb, 0x6f, 0xf5, 0x25, 0x21, 0xf5, 0xc1, 0xa0, 0xcb,
+	0x12, 0x95, 0xfa, 0x33, 0xf3, 0x3f, 0xcb, 0xd8, 0x3b, 0x8c, 0xf4, 0x30, 0x07, 0x1c, 0xf5, 0xa0,
+	0x75, 0x60, 0xd7, 0x6a, 0xd0, 0x8e, 0xdf, 0x73, 0xc2, 0x6a, 0xcd, 0xde, 0xaa, 0x65, 0x85, 0x56,
+	0xbc, 0x50, 0xeb, 0x2f, 0x84, 0x9c, 0x8a, 0x25, 0x5e, 0xfb, 0x10, 0x90, 0xea, 0x1e, 0x14, 0xc7,
+	0x32, 0x01, 0xfa, 0x2e, 0x20, 0xd3, 0x63, 0x37, 0x45, 0xd7, 0xef, 0xb4, 0xf5, 0xf7, 0xab, 0x9e,
+	0xdb, 0xf8, 0xb7, 0x26, 0x77, 0xb6, 0xcd, 0x8d, 0xd3, 0xcf, 0x3f, 0xde, 0x94, 0x59, 0xf3, 0x61,
+	0x74, 0xd7, 0x37, 0x61, 0x04, 0x3c, 0x0a, 0x16, 0xdb, 0xcf, 0xcf, 0x2e, 0xc3, 0xe0, 0xfc, 0x32,
+	0x0c, 0xbe, 0x5f, 0x86, 0xc1, 0xeb, 0xab, 0xb0, 0x74, 0x7e, 0x15, 0x96, 0xbe, 0x5c, 0x85, 0xa5,
+	0x57, 0x2b, 0x7f, 0xb4, 0xc1, 0x90, 0xf3, 0x3c, 0x1f, 0xe7, 0xef, 0x4e, 0xda, 0xbf, 0xea, 0xfa,
+	0xcf, 0x01, 0x00, 0x92, 0x98, 0x61, 0x01, 0x95, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// EstimateGas simulates a transaction against the latest state and returns
+	// its gas consumption, a gas limit and fees suggested from the transactions
+	// included in the recent blocks.
+	EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.gasestimate.v1beta1.Service/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// EstimateGas simulates a transaction against the latest state and returns
+	// its gas consumption, a gas limit and fees suggested from the transactions
+	// included in the recent blocks.
+	EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) EstimateGas(ctx context.Context, req *EstimateGasRequest) (*EstimateGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.gasestimate.v1beta1.Service/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EstimateGas(ctx, req.(*EstimateGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Service_serviceDesc = _Service_serviceDesc
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.gasestimate.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Service_EstimateGas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/gasestimate/v1beta1/query.proto",
+}
+
+func (m *EstimateGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		dAtA2 := make([]byte, len(m.Percentiles)*10)
+		var j1 int
+		for _, num := range m.Percentiles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Samples != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.GasMargin.Size()
+		i -= size
+		if _, err := m.GasMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSuggestion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSuggestion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSuggestion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Percentile != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EstimateGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Percentiles) > 0 {
+		l = 0
+		for _, e := range m.Percentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EstimateGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = m.GasMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovQuery(uint64(m.Samples))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSuggestion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovQuery(uint64(m.Percentile))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EstimateGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Percentiles = append(m.Percentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Percentiles = append(m.Percentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &gasprofile.MsgGasProfile{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMargin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, &FeeSuggestion{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSuggestion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSuggestion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSuggestion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
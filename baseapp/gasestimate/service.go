@@ -0,0 +1,78 @@
+package gasestimate
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
+)
+
+// App defines the application simulating the transactions to estimate.
+type App interface {
+	// SimulateGasProfile simulates the given transaction against the latest
+	// state and returns the gas it used and the gas profile of its messages.
+	SimulateGasProfile(ctx context.Context, txBytes []byte) (uint64, *gasprofile.GasProfile, error)
+}
+
+// RegisterService registers the gas estimate gRPC service on the provided gRPC
+// router.
+func RegisterService(server gogogrpc.Server, app App, estimator *Estimator) {
+	RegisterServiceServer(server, NewQueryServer(app, estimator))
+}
+
+// RegisterGRPCGatewayRoutes mounts the gas estimate gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	app       App
+	estimator *Estimator
+}
+
+func NewQueryServer(app App, estimator *Estimator) ServiceServer {
+	return queryServer{app: app, estimator: estimator}
+}
+
+func (s queryServer) EstimateGas(ctx context.Context, req *EstimateGasRequest) (*EstimateGasResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	percentiles := req.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = DefaultPercentiles
+	}
+	for _, percentile := range percentiles {
+		if percentile == 0 || percentile > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid percentile %d, it must be between 1 and 100", percentile)
+		}
+	}
+
+	gasUsed, profile, err := s.app.SimulateGasProfile(ctx, req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasUsed)
+	}
+
+	msgTypeURLs := make([]string, len(profile.Msgs))
+	for i, msg := range profile.Msgs {
+		msgTypeURLs[i] = msg.MsgTypeUrl
+	}
+
+	gasLimit, margin, samples := s.estimator.SuggestGasLimit(msgTypeURLs, gasUsed)
+	return &EstimateGasResponse{
+		GasUsed:   gasUsed,
+		Msgs:      profile.Msgs,
+		GasLimit:  gasLimit,
+		GasMargin: margin,
+		Samples:   samples,
+		Fees:      s.estimator.SuggestFees(gasLimit, percentiles),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	coreheader "cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ gasprofile.App = (*BaseApp)(nil)
+
+// gasProfilerKey is the context key of the profiler of a block replay or of a
+// gas estimation.
+type gasProfilerKey struct{}
+
+// GasProfiler returns the profiler of the finalized blocks, nil if gas
+// profiling is disabled.
+func (app *BaseApp) GasProfiler() *gasprofile.Profiler {
+	return app.gasProfiler
+}
+
+// DefaultGasProfileReplayMaxGas is the default maximum gas of a block replay.
+const DefaultGasProfileReplayMaxGas uint64 = 100_000_000
+
+// SetGasProfileReplay enables the replay of the blocks returned by the given
+// fetcher by the gas profile gRPC service, which is disabled by default. A
+// replay consumes at most maxGas, or the maximum gas of a block if lower, or
+// DefaultGasProfileReplayMaxGas if maxGas is zero. It must be called before the
+// node serves queries.
+func (app *BaseApp) SetGasProfileReplay(blocks gasprofile.BlockFetcher, maxGas uint64) {
+	if maxGas == 0 {
+		maxGas = DefaultGasProfileReplayMaxGas
+	}
+
+	app.gasProfileBlocks = blocks
+	app.gasProfileReplayMaxGas = maxGas
+}
+
+// ReplayBlockGasProfile re-executes the transactions of the block at the given
+// height on the state of the previous height and returns their gas profile.
+// Only the transactions are replayed, not the PreBlock, BeginBlock and EndBlock
+// logic, and the state changes are discarded. The transactions are loaded from
+// the block store of the node, and the replay is capped in gas.
+func (app *BaseApp) ReplayBlockGasProfile(ctx context.Context, height int64) (*gasprofile.GasProfile, error) {
+	if app.gasProfileBlocks == nil {
+		return nil, status.Error(codes.FailedPrecondition, "block replay is disabled")
+	}
+
+	blockTime, txs, err := app.gasProfileBlocks(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load block %d: %w", height, err)
+	}
+
+	sdkCtx, err := app.CreateQueryContextWithCheckHeader(height-1, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	header := sdkCtx.BlockHeader()
+	header.Height = height
+	header.Time = blockTime
+
+	profiler := gasprofile.NewProfiler()
+	sdkCtx = sdkCtx.
+		WithValue(gasProfilerKey{}, profiler).
+		WithIsCheckTx(false).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithIsSigverifyTx(app.sigverifyTx).
+		WithBlockHeader(header).
+		WithHeaderInfo(coreheader.Info{ChainID: app.chainID, Height: height, Time: blockTime})
+	sdkCtx = sdkCtx.WithConsensusParams(app.GetConsensusParams(sdkCtx))
+
+	maxGas := app.gasProfileReplayMaxGas
+	if blockMaxGas := app.GetMaximumBlockGas(sdkCtx); blockMaxGas > 0 && blockMaxGas < maxGas {
+		maxGas = blockMaxGas
+	}
+	blockGasMeter := storetypes.NewGasMeter(maxGas)
+	sdkCtx = sdkCtx.WithBlockGasMeter(blockGasMeter)
+
+	for _, txBytes := range txs {
+		if blockGasMeter.IsOutOfGas() {
+			break
+		}
+
+		// failed transactions consume gas too, so their result is ignored
+		_, _, _, _ = app.runTxWithContext(
+			sdkCtx.WithTxBytes(txBytes).WithGasMeter(storetypes.NewGasMeter(blockGasMeter.GasRemaining())),
+			execModeFinalize, txBytes, nil,
+		)
+	}
+
+	profiler.EndBlock()
+	return profiler.Profile(), nil
+}
+
+// gasProfilerFor returns the profiler of the messages executed in the given
+// context and execution mode, if any.
+func (app *BaseApp) gasProfilerFor(ctx sdk.Context, mode execMode) *gasprofile.Profiler {
+	if profiler, ok := ctx.Value(gasProfilerKey{}).(*gasprofile.Profiler); ok {
+		return profiler
+	}
+
+	if mode == execModeFinalize {
+		return app.gasProfiler
+	}
+
+	return nil
+}
+
+// isReplay returns true if the context is the context of a block replay.
+func isReplay(ctx sdk.Context) bool {
+	_, ok := ctx.Value(gasProfilerKey{}).(*gasprofile.Profiler)
+	return ok
+}
@@ -0,0 +1,183 @@
+package gasprofile
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Profiler aggregates, per message type and per store key, the gas consumed
+// and the wall time spent by the executed messages. It is safe for concurrent
+// use.
+type Profiler struct {
+	mu     sync.Mutex
+	blocks uint64
+	msgs   map[string]*MsgGasProfile
+}
+
+// NewProfiler returns an empty Profiler.
+func NewProfiler() *Profiler {
+	return &Profiler{msgs: make(map[string]*MsgGasProfile)}
+}
+
+// Meter returns a gas meter recording the gas consumed by a message of the
+// given type and forwarding it to the parent meter. The gas consumption is
+// added to the profile when the meter is finished.
+func (p *Profiler) Meter(msgTypeURL string, parent storetypes.GasMeter) *Meter {
+	return &Meter{
+		GasMeter:   parent,
+		profiler:   p,
+		msgTypeURL: msgTypeURL,
+		stores:     make(map[string]*StoreGasProfile),
+		start:      time.Now(),
+	}
+}
+
+// EndBlock increments the number of blocks the profile is aggregated over.
+func (p *Profiler) EndBlock() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.blocks++
+}
+
+// Profile returns a snapshot of the aggregated profile.
+func (p *Profiler) Profile() *GasProfile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	profile := &GasProfile{Blocks: p.blocks, Msgs: make([]*MsgGasProfile, 0, len(p.msgs))}
+	for _, msg := range p.msgs {
+		stores := make([]*StoreGasProfile, 0, len(msg.Stores))
+		for _, store := range msg.Stores {
+			s := *store
+			stores = append(stores, &s)
+		}
+
+		m := *msg
+		m.Stores = stores
+		profile.Msgs = append(profile.Msgs, &m)
+	}
+
+	sort.Slice(profile.Msgs, func(i, j int) bool { return profile.Msgs[i].MsgTypeUrl < profile.Msgs[j].MsgTypeUrl })
+	return profile
+}
+
+// Reset discards the aggregated profile.
+func (p *Profiler) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.blocks = 0
+	p.msgs = make(map[string]*MsgGasProfile)
+}
+
+// record adds the profile of an executed message.
+func (p *Profiler) record(msgTypeURL string, gasUsed uint64, duration time.Duration, stores map[string]*StoreGasProfile) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	msg, ok := p.msgs[msgTypeURL]
+	if !ok {
+		msg = &MsgGasProfile{MsgTypeUrl: msgTypeURL}
+		p.msgs[msgTypeURL] = msg
+	}
+
+	msg.Count++
+	msg.GasUsed += gasUsed
+	msg.Duration += duration
+
+	for storeKey, s := range stores {
+		i := sort.Search(len(msg.Stores), func(i int) bool { return msg.Stores[i].StoreKey >= storeKey })
+		if i == len(msg.Stores) || msg.Stores[i].StoreKey != storeKey {
+			msg.Stores = append(msg.Stores, nil)
+			copy(msg.Stores[i+1:], msg.Stores[i:])
+			msg.Stores[i] = &StoreGasProfile{StoreKey: storeKey}
+		}
+
+		store := msg.Stores[i]
+		store.Reads += s.Reads
+		store.ReadGas += s.ReadGas
+		store.Writes += s.Writes
+		store.WriteGas += s.WriteGas
+		store.Iterations += s.Iterations
+		store.IterationGas += s.IterationGas
+		store.OtherGas += s.OtherGas
+	}
+}
+
+var _ sdk.StoreGasMeter = (*Meter)(nil)
+
+// Meter is the gas meter of a profiled message. It is not safe for concurrent
+// use, as any gas meter.
+type Meter struct {
+	storetypes.GasMeter
+
+	profiler   *Profiler
+	msgTypeURL string
+	gasUsed    uint64
+	stores     map[string]*StoreGasProfile
+	start      time.Time
+}
+
+// ConsumeGas implements the storetypes.GasMeter interface. Gas consumed
+// directly on the message meter is not attributed to any store.
+func (m *Meter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.consumeGas("", amount, descriptor)
+}
+
+// ForStore implements the sdk.StoreGasMeter interface.
+func (m *Meter) ForStore(storeKey string) storetypes.GasMeter {
+	return storeMeter{Meter: m, storeKey: storeKey}
+}
+
+// Finish adds the gas consumed and the time elapsed since the creation of the
+// meter to the profile of the message type.
+func (m *Meter) Finish() {
+	m.profiler.record(m.msgTypeURL, m.gasUsed, time.Since(m.start), m.stores)
+}
+
+func (m *Meter) consumeGas(storeKey string, amount storetypes.Gas, descriptor string) {
+	store, ok := m.stores[storeKey]
+	if !ok {
+		store = &StoreGasProfile{StoreKey: storeKey}
+		m.stores[storeKey] = store
+	}
+
+	switch descriptor {
+	case storetypes.GasReadCostFlatDesc, storetypes.GasHasDesc:
+		store.Reads++
+		store.ReadGas += amount
+	case storetypes.GasReadPerByteDesc, storetypes.GasValuePerByteDesc:
+		store.ReadGas += amount
+	case storetypes.GasWriteCostFlatDesc, storetypes.GasDeleteDesc:
+		store.Writes++
+		store.WriteGas += amount
+	case storetypes.GasWritePerByteDesc:
+		store.WriteGas += amount
+	case storetypes.GasIterNextCostFlatDesc:
+		store.Iterations++
+		store.IterationGas += amount
+	default:
+		store.OtherGas += amount
+	}
+
+	m.gasUsed += amount
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// storeMeter is the gas meter of the accesses of a profiled message to a
+// store.
+type storeMeter struct {
+	*Meter
+	storeKey string
+}
+
+// ConsumeGas implements the storetypes.GasMeter interface.
+func (m storeMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.consumeGas(m.storeKey, amount, descriptor)
+}
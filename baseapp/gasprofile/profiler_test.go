@@ -0,0 +1,55 @@
+package gasprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+func TestProfiler(t *testing.T) {
+	profiler := NewProfiler()
+	parent := storetypes.NewInfiniteGasMeter()
+
+	for i := 0; i < 2; i++ {
+		meter := profiler.Meter("/cosmos.bank.v1beta1.MsgSend", parent)
+		bank := meter.ForStore("bank")
+		bank.ConsumeGas(10, storetypes.GasReadCostFlatDesc)
+		bank.ConsumeGas(3, storetypes.GasReadPerByteDesc)
+		bank.ConsumeGas(20, storetypes.GasWriteCostFlatDesc)
+		bank.ConsumeGas(6, storetypes.GasWritePerByteDesc)
+		bank.ConsumeGas(1, storetypes.GasIterNextCostFlatDesc)
+		meter.ForStore("acc").ConsumeGas(5, storetypes.GasHasDesc)
+		meter.ConsumeGas(7, "signature")
+		meter.Finish()
+	}
+	profiler.Meter("/cosmos.gov.v1.MsgVote", parent).Finish()
+	profiler.EndBlock()
+
+	// the consumed gas is forwarded to the parent meter
+	require.Equal(t, storetypes.Gas(2*52), parent.GasConsumed())
+
+	profile := profiler.Profile()
+	require.Equal(t, uint64(1), profile.Blocks)
+	require.Len(t, profile.Msgs, 2)
+	require.Equal(t, "/cosmos.gov.v1.MsgVote", profile.Msgs[1].MsgTypeUrl)
+	require.Equal(t, uint64(1), profile.Msgs[1].Count)
+
+	send := profile.Msgs[0]
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", send.MsgTypeUrl)
+	require.Equal(t, uint64(2), send.Count)
+	require.Equal(t, uint64(2*52), send.GasUsed)
+	require.Equal(t, []*StoreGasProfile{
+		{OtherGas: 14},
+		{StoreKey: "acc", Reads: 2, ReadGas: 10},
+		{StoreKey: "bank", Reads: 2, ReadGas: 26, Writes: 2, WriteGas: 52, Iterations: 2, IterationGas: 2},
+	}, send.Stores)
+
+	// the profile is a snapshot
+	send.Stores[0].OtherGas = 0
+	require.Equal(t, uint64(14), profiler.Profile().Msgs[0].Stores[0].OtherGas)
+
+	profiler.Reset()
+	require.Equal(t, &GasProfile{Msgs: []*MsgGasProfile{}}, profiler.Profile())
+}
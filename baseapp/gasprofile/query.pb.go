@@ -0,0 +1,1955 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/gasprofile/v1beta1/query.proto
+
+package gasprofile
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasProfileRequest defines the request structure for the GasProfile gRPC query.
+type GasProfileRequest struct {
+}
+
+func (m *GasProfileRequest) Reset()         { *m = GasProfileRequest{} }
+func (m *GasProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*GasProfileRequest) ProtoMessage()    {}
+func (*GasProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d219404dae284c, []int{0}
+}
+func (m *GasProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfileRequest.Merge(m, src)
+}
+func (m *GasProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasProfileRequest proto.InternalMessageInfo
+
+// GasProfileResponse defines the response structure for the GasProfile gRPC query.
+type GasProfileResponse struct {
+	Profile *GasProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (m *GasProfileResponse) Reset()         { *m = GasProfileResponse{} }
+func (m *GasProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*GasProfileResponse) ProtoMessage()    {}
+func (*GasProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d219404dae284c, []int{1}
+}
+func (m *GasProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfileResponse.Merge(m, src)
+}
+func (m *GasProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasProfileResponse proto.InternalMessageInfo
+
+func (m *GasProfileResponse) GetProfile() *GasProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// ReplayBlockRequest defines the request structure for the ReplayBlock gRPC query.
+type ReplayBlockRequest struct {
+	// height is the height of the block to replay. Its transactions are loaded
+	// from the block store of the node.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReplayBlockRequest) Reset()         { *m = ReplayBlockRequest{} }
+func (m *ReplayBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayBlockRequest) ProtoMessage()    {}
+func (*ReplayBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d219404dae284c, []int{2}
+}
+func (m *ReplayBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayBlockRequest.Merge(m, src)
+}
+func (m *ReplayBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayBlockRequest proto.InternalMessageInfo
+
+func (m *ReplayBlockRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ReplayBlockResponse defines the response structure for the ReplayBlock gRPC query.
+type ReplayBlockResponse struct {
+	Profile *GasProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (m *ReplayBlockResponse) Reset()         { *m = ReplayBlockResponse{} }
+func (m *ReplayBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayBlockResponse) ProtoMessage()    {}
+func (*ReplayBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d219404dae284c, []int{3}
+}
+func (m *ReplayBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayBlockResponse.Merge(m, src)
+}
+func (m *ReplayBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayBlockResponse proto.InternalMessageInfo
+
+func (m *ReplayBlockResponse) GetProfile() *GasProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// GasProfile is the gas and latency profile of the messages executed over one
+// or more blocks.
+type GasProfile struct {
+	// blocks is the number of blocks the profile was aggregated over.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// msgs are the per message type profiles, sorted by message type URL.
+	Msgs []*MsgGasProfile `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *GasProfile) Reset()         { *m = GasProfile{} }
+func (m *GasProfile) String() string { return proto.CompactTextString(m) }
+func (*GasProfile) ProtoMessage()    {}
+func (*GasProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d219404dae284c, []int{4}
+}
+func (m *GasProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfile.Merge(m, src)
+}
+func (m *GasProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasProfile proto.InternalMessageInfo
+
+func (m *GasProfile) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *GasProfile) GetMsgs() []*MsgGasProfile {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// MsgGasProfile is the gas and latency profile of a message type.
+type MsgGasProfile struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// count is the number of executed messages.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// gas_used is the total gas consumed by the message handlers.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// duration is the total wall time spent in the message handlers.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// stores are the per store key profiles, sorted by store key. Gas not
+	// consumed by a store access is reported with an empty store key.
+	Stores []*StoreGasProfile `protobuf:"bytes,5,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (m *MsgGasProfile) Reset()         { *m = MsgGasProfile{} }
+func (m *MsgGasProfile) String() string { return proto.CompactTextString(m) }
+func (*MsgGasProfile) ProtoMessage()    {}
+func (*MsgGasProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d219404dae284c, []int{5}
+}
+func (m *MsgGasProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasProfile.Merge(m, src)
+}
+func (m *MsgGasProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasProfile proto.InternalMessageInfo
+
+func (m *MsgGasProfile) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgGasProfile) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MsgGasProfile) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *MsgGasProfile) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgGasProfile) GetStores() []*StoreGasProfile {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+// StoreGasProfile is the gas consumed by the accesses of a message type to a
+// store.
+type StoreGasProfile struct {
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// reads is the number of Get and Has calls.
+	Reads uint64 `protobuf:"varint,2,opt,name=reads,proto3" json:"reads,omitempty"`
+	// read_gas is the gas consumed by reads, including the per byte cost.
+	ReadGas uint64 `protobuf:"varint,3,opt,name=read_gas,json=readGas,proto3" json:"read_gas,omitempty"`
+	// writes is the number of Set and Delete calls.
+	Writes uint64 `protobuf:"varint,4,opt,name=writes,proto3" json:"writes,omitempty"`
+	// write_gas is the gas consumed by writes, including the per byte cost.
+	WriteGas uint64 `protobuf:"varint,5,opt,name=write_gas,json=writeGas,proto3" json:"write_gas,omitempty"`
+	// iterations is the number of iterator steps.
+	Iterations uint64 `protobuf:"varint,6,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// iteration_gas is the gas consumed by iterator steps, excluding the per
+	// byte cost of the read keys and values which is accounted as read gas.
+	IterationGas uint64 `protobuf:"varint,7,opt,name=iteration_gas,json=iterationGas,proto3" json:"iteration_gas,omitempty"`
+	// other_gas is the gas consumed for any other purpose.
+	OtherGas uint64 `protobuf:"varint,8,opt,name=other_gas,json=otherGas,proto3" json:"other_gas,omitempty"`
+}
+
+func (m *StoreGasProfile) Reset()         { *m = StoreGasProfile{} }
+func (m *StoreGasProfile) String() string { return proto.CompactTextString(m) }
+func (*StoreGasProfile) ProtoMessage()    {}
+func (*StoreGasProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d219404dae284c, []int{6}
+}
+func (m *StoreGasProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreGasProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreGasProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreGasProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreGasProfile.Merge(m, src)
+}
+func (m *StoreGasProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreGasProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreGasProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreGasProfile proto.InternalMessageInfo
+
+func (m *StoreGasProfile) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreGasProfile) GetReads() uint64 {
+	if m != nil {
+		return m.Reads
+	}
+	return 0
+}
+
+func (m *StoreGasProfile) GetReadGas() uint64 {
+	if m != nil {
+		return m.ReadGas
+	}
+	return 0
+}
+
+func (m *StoreGasProfile) GetWrites() uint64 {
+	if m != nil {
+		return m.Writes
+	}
+	return 0
+}
+
+func (m *StoreGasProfile) GetWriteGas() uint64 {
+	if m != nil {
+		return m.WriteGas
+	}
+	return 0
+}
+
+func (m *StoreGasProfile) GetIterations() uint64 {
+	if m != nil {
+		return m.Iterations
+	}
+	return 0
+}
+
+func (m *StoreGasProfile) GetIterationGas() uint64 {
+	if m != nil {
+		return m.IterationGas
+	}
+	return 0
+}
+
+func (m *StoreGasProfile) GetOtherGas() uint64 {
+	if m != nil {
+		return m.OtherGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GasProfileRequest)(nil), "cosmos.base.gasprofile.v1beta1.GasProfileRequest")
+	proto.RegisterType((*GasProfileResponse)(nil), "cosmos.base.gasprofile.v1beta1.GasProfileResponse")
+	proto.RegisterType((*ReplayBlockRequest)(nil), "cosmos.base.gasprofile.v1beta1.ReplayBlockRequest")
+	proto.RegisterType((*ReplayBlockResponse)(nil), "cosmos.base.gasprofile.v1beta1.ReplayBlockResponse")
+	proto.RegisterType((*GasProfile)(nil), "cosmos.base.gasprofile.v1beta1.GasProfile")
+	proto.RegisterType((*MsgGasProfile)(nil), "cosmos.base.gasprofile.v1beta1.MsgGasProfile")
+	proto.RegisterType((*StoreGasProfile)(nil), "cosmos.base.gasprofile.v1beta1.StoreGasProfile")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/gasprofile/v1beta1/query.proto", fileDescriptor_35d219404dae284c)
+}
+
+var fileDescriptor_35d219404dae284c = []byte{
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xa6, 0x69, 0x92, 0xbe, 0xb6, 0x88, 0xd3, 0x22, 0xdb, 0x54, 0xb6, 0x65, 0x05, 0xad,
+	0xd5, 0xee, 0x92, 0xf4, 0xe6, 0x45, 0x2c, 0x85, 0x20, 0x22, 0xc8, 0xd6, 0x5e, 0xea, 0x21, 0x4c,
+	0x92, 0xe9, 0x64, 0x69, 0x92, 0xd9, 0xee, 0xdb, 0xad, 0xe4, 0xea, 0x2f, 0x10, 0xbc, 0xf8, 0x03,
+	0xbc, 0xf8, 0x4f, 0x7a, 0x2c, 0x78, 0xf1, 0x20, 0x2a, 0xad, 0x47, 0xcf, 0x9e, 0x65, 0xde, 0x4e,
+	0xda, 0xad, 0x82, 0x21, 0xe0, 0x29, 0xf3, 0xde, 0xfb, 0xbe, 0xf7, 0xbd, 0x2f, 0x33, 0x6f, 0x61,
+	0xb3, 0xa3, 0x70, 0xa0, 0xd0, 0x6f, 0x73, 0x14, 0xbe, 0xe4, 0x18, 0xc5, 0xea, 0x30, 0xec, 0x0b,
+	0xff, 0xa4, 0xde, 0x16, 0x09, 0xaf, 0xfb, 0xc7, 0xa9, 0x88, 0x47, 0x5e, 0x14, 0xab, 0x44, 0x31,
+	0x27, 0xc3, 0x7a, 0x1a, 0xeb, 0x5d, 0x61, 0x3d, 0x83, 0xad, 0xdd, 0x96, 0x4a, 0xc9, 0xbe, 0xf0,
+	0x79, 0x14, 0xfa, 0x7c, 0x38, 0x54, 0x09, 0x4f, 0x42, 0x35, 0xc4, 0x8c, 0x5d, 0x73, 0x4c, 0x95,
+	0xa2, 0x76, 0x7a, 0xe8, 0x77, 0xd3, 0x98, 0x00, 0xa6, 0xbe, 0x2c, 0x95, 0x54, 0x74, 0xf4, 0xf5,
+	0x29, 0xcb, 0xba, 0x4b, 0x70, 0xb3, 0xc9, 0xf1, 0x45, 0xa6, 0x14, 0x88, 0xe3, 0x54, 0x60, 0xe2,
+	0x1e, 0x00, 0xcb, 0x27, 0x31, 0x52, 0x43, 0x14, 0x6c, 0x17, 0x2a, 0x66, 0x22, 0xdb, 0x5a, 0xb7,
+	0x36, 0xe6, 0x1b, 0x9b, 0xde, 0xbf, 0x07, 0xf6, 0x72, 0x4d, 0xc6, 0x54, 0xf7, 0x21, 0xb0, 0x40,
+	0x44, 0x7d, 0x3e, 0xda, 0xe9, 0xab, 0xce, 0x91, 0x51, 0x64, 0xb7, 0xa0, 0xdc, 0x13, 0xa1, 0xec,
+	0x25, 0xd4, 0x7a, 0x26, 0x30, 0x91, 0xfb, 0x0a, 0x96, 0xae, 0xa1, 0xff, 0xeb, 0x28, 0x12, 0xe0,
+	0x2a, 0xad, 0x47, 0x68, 0x6b, 0x11, 0xa4, 0x96, 0xa5, 0xc0, 0x44, 0xec, 0x09, 0x94, 0x06, 0x28,
+	0xd1, 0x2e, 0xae, 0xcf, 0x6c, 0xcc, 0x37, 0xb6, 0x26, 0x09, 0x3d, 0x47, 0x99, 0xd3, 0x22, 0xaa,
+	0xfb, 0xd3, 0x82, 0xc5, 0x6b, 0x79, 0xb6, 0x0e, 0x0b, 0x03, 0x94, 0xad, 0x64, 0x14, 0x89, 0x56,
+	0x1a, 0xf7, 0x49, 0x72, 0x2e, 0x80, 0x01, 0xca, 0x97, 0xa3, 0x48, 0xec, 0xc7, 0x7d, 0xb6, 0x0c,
+	0xb3, 0x1d, 0x95, 0x0e, 0x13, 0xbb, 0x48, 0xd3, 0x64, 0x01, 0x5b, 0x81, 0xaa, 0xe4, 0xd8, 0x4a,
+	0x51, 0x74, 0xed, 0x19, 0x2a, 0x54, 0x24, 0xc7, 0x7d, 0x14, 0x5d, 0xf6, 0x18, 0xaa, 0xe3, 0x1b,
+	0xb7, 0x4b, 0xf4, 0xa7, 0xac, 0x78, 0xd9, 0x93, 0xf0, 0xc6, 0x4f, 0xc2, 0xdb, 0x35, 0x80, 0x9d,
+	0xea, 0xe9, 0xd7, 0xb5, 0xc2, 0xfb, 0x6f, 0x6b, 0x56, 0x70, 0x49, 0x62, 0x4d, 0x28, 0x63, 0xa2,
+	0x62, 0x81, 0xf6, 0x2c, 0x59, 0xf5, 0x27, 0x59, 0xdd, 0xd3, 0xe8, 0x9c, 0x59, 0x43, 0x77, 0x7f,
+	0x59, 0x70, 0xe3, 0x8f, 0x1a, 0x5b, 0x85, 0x39, 0xaa, 0xb6, 0x8e, 0xc4, 0xc8, 0xb8, 0xad, 0x52,
+	0xe2, 0x99, 0x18, 0x69, 0xaf, 0xb1, 0xe0, 0x5d, 0x1c, 0x7b, 0xa5, 0x40, 0x7b, 0xd5, 0x87, 0x96,
+	0xe4, 0x38, 0xf6, 0xaa, 0xe3, 0x26, 0x47, 0x7d, 0x57, 0xaf, 0xe3, 0x30, 0x11, 0x48, 0x4e, 0x4b,
+	0x81, 0x89, 0xb4, 0x0a, 0x9d, 0x88, 0x33, 0x4b, 0xa5, 0x2a, 0x25, 0x34, 0xc9, 0x01, 0x08, 0x13,
+	0x91, 0x99, 0x45, 0xbb, 0x4c, 0xd5, 0x5c, 0x86, 0xdd, 0x81, 0xc5, 0xcb, 0x88, 0x1a, 0x54, 0x08,
+	0xb2, 0x70, 0x99, 0xd4, 0x4d, 0x56, 0x61, 0x4e, 0x25, 0x3d, 0x11, 0x13, 0xa0, 0x9a, 0x29, 0x50,
+	0xa2, 0xc9, 0xb1, 0xf1, 0xa5, 0x08, 0x95, 0x3d, 0x11, 0x9f, 0x84, 0x1d, 0xc1, 0x3e, 0x58, 0xd7,
+	0x5e, 0x57, 0x7d, 0x8a, 0x07, 0x9a, 0xed, 0x44, 0xad, 0x31, 0x0d, 0x25, 0x5b, 0x0c, 0xd7, 0x7f,
+	0xf3, 0xe9, 0xc7, 0xbb, 0xe2, 0x7d, 0x76, 0xcf, 0x9f, 0xf0, 0xdd, 0x31, 0x31, 0xfb, 0x68, 0xc1,
+	0x7c, 0x6e, 0xc3, 0xd8, 0x44, 0xd1, 0xbf, 0x97, 0xb7, 0xb6, 0x3d, 0x15, 0xc7, 0x4c, 0x5a, 0xa7,
+	0x49, 0x1f, 0xb8, 0x77, 0x27, 0x4d, 0x1a, 0x13, 0xf9, 0x91, 0xb5, 0xb9, 0xf3, 0xf4, 0xf4, 0xdc,
+	0xb1, 0xce, 0xce, 0x1d, 0xeb, 0xfb, 0xb9, 0x63, 0xbd, 0xbd, 0x70, 0x0a, 0x67, 0x17, 0x4e, 0xe1,
+	0xf3, 0x85, 0x53, 0x38, 0xf0, 0x65, 0x98, 0xf4, 0xd2, 0xb6, 0xd7, 0x51, 0x83, 0x71, 0xbb, 0xec,
+	0x67, 0x0b, 0xbb, 0x47, 0xd4, 0x99, 0x47, 0x51, 0xae, 0x79, 0xbb, 0x4c, 0x2b, 0xb1, 0xfd, 0x7b,
+	0x00, 0x5b, 0x15, 0xbb, 0xbe, 0x9f, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// GasProfile queries the gas profile aggregated over the blocks finalized by
+	// the node since the profiler was enabled.
+	GasProfile(ctx context.Context, in *GasProfileRequest, opts ...grpc.CallOption) (*GasProfileResponse, error)
+	// ReplayBlock re-executes the transactions of a block on the state of the
+	// previous height and returns their gas profile. State changes are discarded.
+	// It is disabled unless enabled in the node configuration.
+	ReplayBlock(ctx context.Context, in *ReplayBlockRequest, opts ...grpc.CallOption) (*ReplayBlockResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) GasProfile(ctx context.Context, in *GasProfileRequest, opts ...grpc.CallOption) (*GasProfileResponse, error) {
+	out := new(GasProfileResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.gasprofile.v1beta1.Service/GasProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ReplayBlock(ctx context.Context, in *ReplayBlockRequest, opts ...grpc.CallOption) (*ReplayBlockResponse, error) {
+	out := new(ReplayBlockResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.gasprofile.v1beta1.Service/ReplayBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// GasProfile queries the gas profile aggregated over the blocks finalized by
+	// the node since the profiler was enabled.
+	GasProfile(context.Context, *GasProfileRequest) (*GasProfileResponse, error)
+	// ReplayBlock re-executes the transactions of a block on the state of the
+	// previous height and returns their gas profile. State changes are discarded.
+	// It is disabled unless enabled in the node configuration.
+	ReplayBlock(context.Context, *ReplayBlockRequest) (*ReplayBlockResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) GasProfile(ctx context.Context, req *GasProfileRequest) (*GasProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasProfile not implemented")
+}
+func (*UnimplementedServiceServer) ReplayBlock(ctx context.Context, req *ReplayBlockRequest) (*ReplayBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayBlock not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_GasProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GasProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.gasprofile.v1beta1.Service/GasProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GasProfile(ctx, req.(*GasProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ReplayBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ReplayBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.gasprofile.v1beta1.Service/ReplayBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ReplayBlock(ctx, req.(*ReplayBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Service_serviceDesc = _Service_serviceDesc
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.gasprofile.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GasProfile",
+			Handler:    _Service_GasProfile_Handler,
+		},
+		{
+			MethodName: "ReplayBlock",
+			Handler:    _Service_ReplayBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/gasprofile/v1beta1/query.proto",
+}
+
+func (m *GasProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GasProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreGasProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreGasProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreGasProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OtherGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OtherGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IterationGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IterationGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Iterations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Iterations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WriteGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Writes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Writes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReadGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reads != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reads))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GasProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplayBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ReplayBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GasProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGasProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreGasProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Reads != 0 {
+		n += 1 + sovQuery(uint64(m.Reads))
+	}
+	if m.ReadGas != 0 {
+		n += 1 + sovQuery(uint64(m.ReadGas))
+	}
+	if m.Writes != 0 {
+		n += 1 + sovQuery(uint64(m.Writes))
+	}
+	if m.WriteGas != 0 {
+		n += 1 + sovQuery(uint64(m.WriteGas))
+	}
+	if m.Iterations != 0 {
+		n += 1 + sovQuery(uint64(m.Iterations))
+	}
+	if m.IterationGas != 0 {
+		n += 1 + sovQuery(uint64(m.IterationGas))
+	}
+	if m.OtherGas != 0 {
+		n += 1 + sovQuery(uint64(m.OtherGas))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &GasProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &GasProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &MsgGasProfile{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, &StoreGasProfile{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreGasProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreGasProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreGasProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			m.Reads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reads |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadGas", wireType)
+			}
+			m.ReadGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			m.Writes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Writes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteGas", wireType)
+			}
+			m.WriteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iterations", wireType)
+			}
+			m.Iterations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iterations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterationGas", wireType)
+			}
+			m.IterationGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterationGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherGas", wireType)
+			}
+			m.OtherGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OtherGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,73 @@
+package gasprofile
+
+import (
+	"context"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// App defines the application whose gas consumption is profiled.
+type App interface {
+	// GasProfiler returns the profiler of the finalized blocks, nil if gas
+	// profiling is disabled.
+	GasProfiler() *Profiler
+	// ReplayBlockGasProfile re-executes the transactions of the block at the
+	// given height and returns their gas profile.
+	ReplayBlockGasProfile(ctx context.Context, height int64) (*GasProfile, error)
+}
+
+// BlockFetcher returns the time and the raw transactions of the block at the
+// given height, as stored by the node.
+type BlockFetcher func(ctx context.Context, height int64) (blockTime time.Time, txs [][]byte, err error)
+
+// RegisterService registers the gas profile gRPC service on the provided gRPC
+// router.
+func RegisterService(server gogogrpc.Server, app App) {
+	RegisterServiceServer(server, NewQueryServer(app))
+}
+
+// RegisterGRPCGatewayRoutes mounts the gas profile gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	app App
+}
+
+func NewQueryServer(app App) ServiceServer {
+	return queryServer{app: app}
+}
+
+func (s queryServer) GasProfile(_ context.Context, _ *GasProfileRequest) (*GasProfileResponse, error) {
+	profiler := s.app.GasProfiler()
+	if profiler == nil {
+		return nil, status.Error(codes.FailedPrecondition, "gas profiling is disabled")
+	}
+
+	return &GasProfileResponse{Profile: profiler.Profile()}, nil
+}
+
+func (s queryServer) ReplayBlock(ctx context.Context, req *ReplayBlockRequest) (*ReplayBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height <= 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot replay block %d, the height must be greater than 1", req.Height)
+	}
+
+	profile, err := s.app.ReplayBlockGasProfile(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReplayBlockResponse{Profile: profile}, nil
+}
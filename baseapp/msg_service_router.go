@@ -8,6 +8,7 @@ import (
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/runtime/protoiface"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp/internal/protocompat"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -190,8 +192,12 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 		)
 	}
 
-	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
-		ctx = ctx.WithEventManager(sdk.NewEventManager())
+	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (_ *sdk.Result, err error) {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		spanCtx, span := telemetry.StartSpan(ctx.Context(), msgTypeURL, attribute.String(telemetry.AttributeKeyMsgTypeURL, msgTypeURL))
+		defer func() { telemetry.EndSpan(span, err) }()
+
+		ctx = ctx.WithContext(spanCtx).WithEventManager(sdk.NewEventManager())
 		interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 			goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
 			return handler(goCtx, msg)
@@ -204,14 +210,13 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 		}
 
 		if msr.circuitBreaker != nil {
-			msgURL := sdk.MsgTypeURL(msg)
-			isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
+			isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgTypeURL)
 			if err != nil {
 				return nil, err
 			}
 
 			if !isAllowed {
-				return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
+				return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgTypeURL)
 			}
 		}
 
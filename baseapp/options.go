@@ -15,6 +15,8 @@ import (
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -124,6 +126,26 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetGasProfiler enables the profiling of the gas consumed and the time spent
+// by the messages of the finalized blocks, and registers the gas profile gRPC
+// service. Its block replay must be enabled with SetGasProfileReplay.
+func SetGasProfiler(profiler *gasprofile.Profiler) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.gasProfiler = profiler
+		gasprofile.RegisterService(app.grpcQueryRouter, app)
+	}
+}
+
+// SetGasEstimator enables the recording of the gas used and the gas prices of
+// the transactions of the finalized blocks, and registers the gas estimate gRPC
+// service, which suggests gas limits and fees from them.
+func SetGasEstimator(estimator *gasestimate.Estimator) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.gasEstimator = estimator
+		gasestimate.RegisterService(app.grpcQueryRouter, app, estimator)
+	}
+}
+
 // SetIncludeNestedMsgsGas sets the message types for which gas costs for its nested messages are calculated when simulating.
 func SetIncludeNestedMsgsGas(msgs []sdk.Msg) func(*BaseApp) {
 	return func(app *BaseApp) {
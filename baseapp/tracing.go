@@ -0,0 +1,57 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// String implements the fmt.Stringer interface.
+func (m execMode) String() string {
+	switch m {
+	case execModeCheck:
+		return "check"
+	case execModeReCheck:
+		return "recheck"
+	case execModeSimulate:
+		return "simulate"
+	case execModePrepareProposal:
+		return "prepare_proposal"
+	case execModeProcessProposal:
+		return "process_proposal"
+	case execModeVoteExtension:
+		return "vote_extension"
+	case execModeVerifyVoteExtension:
+		return "verify_vote_extension"
+	case execModeFinalize:
+		return "finalize"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(m))
+	}
+}
+
+// withSpan sets the span in the base context of the given context, so that the
+// spans started from the context, e.g. by runTx, are children of the span.
+func withSpan(ctx sdk.Context, span trace.Span) sdk.Context {
+	goCtx := ctx.Context()
+	if goCtx == nil {
+		goCtx = context.Background()
+	}
+	return ctx.WithContext(trace.ContextWithSpan(goCtx, span))
+}
+
+// heightAttribute returns the block height span attribute.
+func heightAttribute(height int64) attribute.KeyValue {
+	return attribute.Int64(telemetry.AttributeKeyBlockHeight, height)
+}
+
+// txHashAttribute returns the transaction hash span attribute.
+func txHashAttribute(txBytes []byte) attribute.KeyValue {
+	return attribute.String(telemetry.AttributeKeyTxHash, fmt.Sprintf("%X", tmhash.Sum(txBytes)))
+}
@@ -131,7 +131,12 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithChainID(chainID)
 	}
 
-	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
+	if flagSet.Changed(flags.FlagKeyringRemoteSigner) {
+		remoteSigner, _ := flagSet.GetString(flags.FlagKeyringRemoteSigner)
+		clientCtx = clientCtx.WithKeyringRemoteSigner(remoteSigner)
+	}
+
+	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) || flagSet.Changed(flags.FlagKeyringRemoteSigner) {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
 		if keyringBackend != "" {
@@ -35,6 +35,7 @@ type Config struct {
 	ChainID               string     `mapstructure:"chain-id" json:"chain-id"`
 	KeyringBackend        string     `mapstructure:"keyring-backend" json:"keyring-backend"`
 	KeyringDefaultKeyName string     `mapstructure:"keyring-default-keyname" json:"keyring-default-keyname"`
+	KeyringRemoteSigner   string     `mapstructure:"keyring-remote-signer" json:"keyring-remote-signer"`
 	Output                string     `mapstructure:"output" json:"output"`
 	Node                  string     `mapstructure:"node" json:"node"`
 	BroadcastMode         string     `mapstructure:"broadcast-mode" json:"broadcast-mode"`
@@ -114,6 +115,10 @@ func CreateClientConfig(ctx client.Context, customClientTemplate string, customC
 		WithKeyringDir(ctx.HomeDir).
 		WithKeyringDefaultKeyName(conf.KeyringDefaultKeyName)
 
+	if conf.KeyringRemoteSigner != "" {
+		ctx = ctx.WithKeyringRemoteSigner(conf.KeyringRemoteSigner)
+	}
+
 	keyring, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get keyring: %w", err)
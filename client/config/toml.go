@@ -18,10 +18,12 @@ const (
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|remote)
 keyring-backend = "{{ .KeyringBackend }}"
 # Default key name, if set, defines the default key to use for signing transaction when the --from flag is not specified
 keyring-default-keyname = "{{ .KeyringDefaultKeyName }}"
+# Remote signer address used by the remote keyring backend (<host>:<port> or unix:///path/to/socket)
+keyring-remote-signer = "{{ .KeyringRemoteSigner }}"
 # CLI output format (text|json)
 output = "{{ .Output }}"
 # <host>:<port> to CometBFT RPC interface for this chain
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -104,6 +105,15 @@ func (ctx Context) WithKeyringOptions(opts ...keyring.Option) Context {
 	return ctx
 }
 
+// WithKeyringRemoteSigner returns a copy of the context with the address of the
+// remote signer used by the remote keyring backend.
+func (ctx Context) WithKeyringRemoteSigner(address string) Context {
+	ctx.KeyringOptions = append(slices.Clone(ctx.KeyringOptions), func(options *keyring.Options) {
+		options.RemoteSignerAddress = address
+	})
+	return ctx
+}
+
 // WithInput returns a copy of the context with an updated input.
 func (ctx Context) WithInput(r io.Reader) Context {
 	// convert to a bufio.Reader to have a shared buffer between the keyring and the
@@ -0,0 +1,65 @@
+package debug
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GasProfileCmd creates and returns a new cmd used for querying the gas profile
+// of a node, or for profiling a block by replaying it.
+func GasProfileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-profile [height]",
+		Short: "Query the gas profile of a node or profile a block by replaying it",
+		Long: `Query the gas and latency profile of the messages executed by a node, per message
+type and per store key, aggregated over the blocks finalized since the node started.
+
+If a height is given, the transactions of the block at that height are instead re-executed
+by the node on the state of the previous height, and their profile is returned. The node
+must have gas profiling and block replay enabled in the gas-profile section of its app.toml,
+and the state of the previous height must not be pruned.`,
+		Example: fmt.Sprintf(`$ %[1]s debug gas-profile
+$ %[1]s debug gas-profile 1000 --node tcp://localhost:26657`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := gasprofile.NewServiceClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.GasProfile(cmd.Context(), &gasprofile.GasProfileRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res.Profile)
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[0], err)
+			}
+
+			res, err := queryClient.ReplayBlock(cmd.Context(), &gasprofile.ReplayBlockRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Profile)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
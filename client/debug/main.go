@@ -41,6 +41,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
 	cmd.AddCommand(PrefixesCmd())
+	cmd.AddCommand(GasProfileCmd())
 
 	return cmd
 }
@@ -86,6 +86,8 @@ const (
 	// FlagOutput is the flag to set the output format.
 	// This differs from FlagOutputDocument that is used to set the output file.
 	FlagOutput = "output"
+	// FlagKeyringRemoteSigner is the flag to set the remote signer address used by the remote keyring backend.
+	FlagKeyringRemoteSigner = "keyring-remote-signer"
 	// FlagLogLevel defines the flag for setting the log level
 	FlagLogLevel   = "log_level"
 	FlagLogFormat  = "log_format"
@@ -153,7 +155,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 // AddKeyringFlags sets common keyring flags
 func AddKeyringFlags(flags *pflag.FlagSet) {
 	flags.String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
+	flags.String(FlagKeyringRemoteSigner, "", "The remote signer address used by the remote keyring backend (<host>:<port> or unix:///path/to/socket)")
 }
 
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
@@ -0,0 +1,154 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagOverwrite    = "overwrite"
+	flagSkipExisting = "skip-existing"
+	flagDryRun       = "dry-run"
+)
+
+// BackupKeysCommand backs up the keys of the key store to an encrypted file.
+func BackupKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "backup <file> [name...]",
+		Short: "Back up keys to an encrypted file",
+		Long: fmt.Sprintf(`Back up the keys of the keyring to a passphrase-encrypted file, all of them or the
+ones with the given names. Local, ledger, offline, multisig and threshold keys are backed up.
+
+The backup is encrypted with a key derived from the passphrase with argon2id and authenticated,
+so that an altered backup cannot be restored. The file must not exist.
+
+Example:
+$ %s keys backup keys.backup
+$ %s keys backup keys.backup alice bob
+`, version.AppName, version.AppName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			passphrase, err := input.GetPassword("Enter passphrase to encrypt the backup:", buf)
+			if err != nil {
+				return err
+			}
+			repeated, err := input.GetPassword("Repeat the passphrase:", buf)
+			if err != nil {
+				return err
+			}
+			if passphrase != repeated {
+				return errors.New("passphrases don't match")
+			}
+
+			armor, err := clientCtx.Keyring.ExportBackup(args[1:], passphrase)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return err
+			}
+			if _, err := f.WriteString(armor); err != nil {
+				f.Close()
+				return err
+			}
+
+			return f.Close()
+		},
+	}
+}
+
+// RestoreKeysCommand restores the keys of an encrypted backup file to the key store.
+func RestoreKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <file> [name...]",
+		Short: "Restore keys from an encrypted backup file",
+		Long: fmt.Sprintf(`Restore the keys of a backup file created with the backup command, all of them or the
+ones with the given names, and print the restored keys.
+
+The restore fails without restoring any key if a key of the keyring has the same name or address
+as a restored key, unless the --%s flag is set to keep the keys of the keyring, or the --%s
+flag is set to replace them with the restored keys.
+
+With the --%s flag the backup is decrypted and verified, and its keys are printed, without
+restoring them.
+
+Example:
+$ %s keys restore keys.backup
+$ %s keys restore keys.backup alice --%s
+`, flagSkipExisting, flagOverwrite, flagDryRun, version.AppName, version.AppName, flagOverwrite),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+			skipExisting, _ := cmd.Flags().GetBool(flagSkipExisting)
+			dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+
+			policy := keyring.ConflictPolicyFail
+			switch {
+			case overwrite && skipExisting:
+				return fmt.Errorf("the flags %s and %s are mutually exclusive", flagOverwrite, flagSkipExisting)
+			case overwrite:
+				policy = keyring.ConflictPolicyOverwrite
+			case skipExisting:
+				policy = keyring.ConflictPolicySkip
+			}
+
+			armor, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt the backup:", buf)
+			if err != nil {
+				return err
+			}
+
+			var records []*keyring.Record
+			if dryRun {
+				backup, err := keyring.DecryptBackup(clientCtx.Codec, string(armor), passphrase)
+				if err != nil {
+					return err
+				}
+				records, err = backup.Select(args[1:])
+				if err != nil {
+					return err
+				}
+			} else {
+				records, err = clientCtx.Keyring.ImportBackup(string(armor), passphrase, args[1:], policy)
+				if err != nil {
+					return err
+				}
+			}
+
+			return printKeyringRecords(clientCtx, cmd.OutOrStdout(), records, clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().Bool(flagOverwrite, false, "Replace the keys of the keyring with the same name or address as a restored key")
+	cmd.Flags().Bool(flagSkipExisting, false, "Skip the restored keys with the same name or address as a key of the keyring")
+	cmd.Flags().Bool(flagDryRun, false, "Decrypt and verify the backup without restoring its keys")
+
+	return cmd
+}
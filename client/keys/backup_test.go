@@ -0,0 +1,101 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func Test_runBackupRestoreCmds(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	backupFile := filepath.Join(t.TempDir(), "keys.backup")
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.SaveOfflineKey("bob", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	restoreKb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	run := func(kb keyring.Keyring, cmd *cobra.Command, input string, args ...string) (string, error) {
+		t.Helper()
+
+		clientCtx := client.Context{}.
+			WithKeyring(kb).
+			WithCodec(cdc).
+			WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+			WithInput(bytes.NewBufferString(input))
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON)))
+		err := cmd.ExecuteContext(ctx)
+
+		return out.String(), err
+	}
+
+	_, err = run(kb, BackupKeysCommand(), "12345678\n87654321\n", backupFile)
+	require.ErrorContains(t, err, "passphrases don't match")
+	_, err = run(kb, BackupKeysCommand(), "12345678\n12345678\n", backupFile)
+	require.NoError(t, err)
+	info, err := os.Stat(backupFile)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// the backup file is not overwritten
+	_, err = run(kb, BackupKeysCommand(), "12345678\n12345678\n", backupFile)
+	require.Error(t, err)
+
+	_, err = run(restoreKb, RestoreKeysCommand(), "87654321\n", backupFile)
+	require.Error(t, err)
+
+	out, err := run(restoreKb, RestoreKeysCommand(), "12345678\n", backupFile, "--dry-run")
+	require.NoError(t, err)
+	require.Contains(t, out, `"name":"alice"`)
+	require.Contains(t, out, `"name":"bob"`)
+	records, err := restoreKb.List()
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	out, err = run(restoreKb, RestoreKeysCommand(), "12345678\n", backupFile, "alice")
+	require.NoError(t, err)
+	require.Contains(t, out, `"name":"alice"`)
+	require.NotContains(t, out, `"name":"bob"`)
+
+	_, err = run(restoreKb, RestoreKeysCommand(), "12345678\n", backupFile)
+	require.ErrorIs(t, err, keyring.ErrKeyAlreadyExists)
+
+	_, err = run(restoreKb, RestoreKeysCommand(), "12345678\n", backupFile, "--overwrite", "--skip-existing")
+	require.Error(t, err)
+
+	out, err = run(restoreKb, RestoreKeysCommand(), "12345678\n", backupFile, "--skip-existing")
+	require.NoError(t, err)
+	require.Contains(t, out, `"name":"bob"`)
+	require.NotContains(t, out, `"name":"alice"`)
+
+	records, err = restoreKb.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+}
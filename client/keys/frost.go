@@ -0,0 +1,427 @@
+package keys
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagCiphersuite = "ciphersuite"
+	flagIdentifier  = "identifier"
+	flagMinSigners  = "min-signers"
+	flagMaxSigners  = "max-signers"
+	flagRound1      = "round1"
+	flagRound2      = "round2"
+	flagOutputDir   = "output-dir"
+	flagCommitments = "commitments"
+	flagShares      = "shares"
+)
+
+var frostCiphersuites = map[string]string{
+	"ed25519":   frost.Ed25519,
+	"secp256k1": frost.Secp256k1,
+}
+
+// FrostCommand returns the commands to generate and sign with FROST threshold keys.
+func FrostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frost",
+		Short: "Generate and sign with FROST threshold keys",
+		Long: `Generate threshold keys with a distributed key generation, and sign with them, following the
+FROST protocol. A threshold key is shared between max-signers participants, any min-signers of them
+being able to produce a single signature of the group public key: an Ed25519 signature for the
+ed25519 ciphersuite, or a Schnorr signature for the secp256k1 ciphersuite.
+
+The participants exchange the packages of each round as JSON files. Public packages are printed
+and must be sent to all the other participants (or to the coordinator when signing), while the
+round 2 packages of the key generation must be sent privately to their recipient. Secret packages
+and signing nonces are written to files only readable by their owner, and removed once used.
+
+Key generation, for each participant:
+
+    $ %[1]s keys frost dkg-round1 secret.json --identifier 1 --min-signers 2 --max-signers 3 > round1-1.json
+    $ %[1]s keys frost dkg-round2 secret.json round1-*.json
+    $ %[1]s keys frost dkg-finalize mykey secret.json \
+        --round1 round1-1.json,round1-2.json,round1-3.json --round2 round2-2-1.json,round2-3-1.json
+
+Signing, for each signer, the coordinator then aggregating the signature shares:
+
+    $ %[1]s keys frost commit mykey nonces.json > commitment-1.json
+    $ %[1]s keys frost sign mykey nonces.json message.bin commitment-*.json > share-1.json
+    $ %[1]s keys frost aggregate mykey message.bin \
+        --commitments commitment-1.json,commitment-2.json --shares share-1.json,share-2.json
+`,
+	}
+	cmd.Long = fmt.Sprintf(cmd.Long, version.AppName)
+
+	cmd.AddCommand(
+		frostDKGRound1Command(),
+		frostDKGRound2Command(),
+		frostDKGFinalizeCommand(),
+		frostCommitCommand(),
+		frostSignCommand(),
+		frostAggregateCommand(),
+	)
+
+	return cmd
+}
+
+func frostDKGRound1Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dkg-round1 [secret-file]",
+		Short: "Start the key generation, writing the secret package and printing the round 1 package",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(flagCiphersuite)
+			ciphersuite, ok := frostCiphersuites[name]
+			if !ok {
+				return fmt.Errorf("invalid ciphersuite %s, expected ed25519 or secp256k1", name)
+			}
+
+			identifier, _ := cmd.Flags().GetUint32(flagIdentifier)
+			minSigners, _ := cmd.Flags().GetUint32(flagMinSigners)
+			maxSigners, _ := cmd.Flags().GetUint32(flagMaxSigners)
+
+			secret, round1, err := frost.DKGRound1(ciphersuite, identifier, minSigners, maxSigners, rand.Reader)
+			if err != nil {
+				return err
+			}
+
+			if err := writeFrostSecret(clientCtx.Codec, args[0], secret); err != nil {
+				return err
+			}
+
+			return printFrostPackage(cmd, clientCtx.Codec, round1)
+		},
+	}
+
+	cmd.Flags().String(flagCiphersuite, "ed25519", "Ciphersuite of the key (ed25519|secp256k1)")
+	cmd.Flags().Uint32(flagIdentifier, 0, "Identifier of the participant, between 1 and max-signers")
+	cmd.Flags().Uint32(flagMinSigners, 0, "Number of participants required to sign")
+	cmd.Flags().Uint32(flagMaxSigners, 0, "Number of participants")
+	_ = cmd.MarkFlagRequired(flagIdentifier)
+	_ = cmd.MarkFlagRequired(flagMinSigners)
+	_ = cmd.MarkFlagRequired(flagMaxSigners)
+
+	return cmd
+}
+
+func frostDKGRound2Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dkg-round2 [secret-file] [round1-file...]",
+		Short: "Verify the round 1 packages and write the round 2 packages to send to each participant",
+		Long: `Verify the round 1 packages of all the participants, and write the round 2 package to send
+privately to each participant to the output directory, as round2-<sender>-<recipient>.json.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			secret := &frost.DKGSecretPackage{}
+			if err := readFrostFile(clientCtx.Codec, args[0], secret); err != nil {
+				return err
+			}
+
+			round1, err := readFrostFiles[frost.DKGRound1Package](clientCtx.Codec, args[1:])
+			if err != nil {
+				return err
+			}
+
+			round2, err := frost.DKGRound2(secret, round1)
+			if err != nil {
+				return err
+			}
+
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+			for _, pkg := range round2 {
+				path := filepath.Join(outputDir, fmt.Sprintf("round2-%d-%d.json", pkg.Sender, pkg.Recipient))
+				if err := writeFrostSecret(clientCtx.Codec, path, pkg); err != nil {
+					return err
+				}
+
+				if _, err := fmt.Fprintln(cmd.OutOrStdout(), path); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagOutputDir, ".", "Directory of the round 2 packages")
+
+	return cmd
+}
+
+func frostDKGFinalizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dkg-finalize [name] [secret-file]",
+		Short: "Finish the key generation, and save the key share to the keyring",
+		Long: `Verify the round 2 packages received by the participant, and save the resulting key share to
+the keyring under the given name. The secret file is removed once the key share is saved.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			secret := &frost.DKGSecretPackage{}
+			if err := readFrostFile(clientCtx.Codec, args[1], secret); err != nil {
+				return err
+			}
+
+			round1Files, _ := cmd.Flags().GetStringSlice(flagRound1)
+			round1, err := readFrostFiles[frost.DKGRound1Package](clientCtx.Codec, round1Files)
+			if err != nil {
+				return err
+			}
+
+			round2Files, _ := cmd.Flags().GetStringSlice(flagRound2)
+			round2, err := readFrostFiles[frost.DKGRound2Package](clientCtx.Codec, round2Files)
+			if err != nil {
+				return err
+			}
+
+			share, err := frost.DKGFinalize(secret, round1, round2)
+			if err != nil {
+				return err
+			}
+
+			k, err := clientCtx.Keyring.SaveThresholdKey(args[0], share)
+			if err != nil {
+				return err
+			}
+
+			if err := os.Remove(args[1]); err != nil {
+				return err
+			}
+
+			return printCreate(clientCtx, cmd, k, false, false, "", clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagRound1, nil, "Round 1 package files of all the participants")
+	cmd.Flags().StringSlice(flagRound2, nil, "Round 2 package files received by the participant")
+	_ = cmd.MarkFlagRequired(flagRound1)
+	_ = cmd.MarkFlagRequired(flagRound2)
+
+	return cmd
+}
+
+func frostCommitCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit [name] [nonces-file]",
+		Short: "Generate signing nonces, writing them and printing their commitment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := thresholdKeyShare(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			nonces, err := frost.Commit(share, rand.Reader)
+			if err != nil {
+				return err
+			}
+
+			if err := writeFrostSecret(clientCtx.Codec, args[1], nonces); err != nil {
+				return err
+			}
+
+			return printFrostPackage(cmd, clientCtx.Codec, nonces.Commitment)
+		},
+	}
+}
+
+func frostSignCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign [name] [nonces-file] [message-file] [commitment-file...]",
+		Short: "Sign a message with the key share, printing the signature share",
+		Long: `Sign the message with the key share given the commitments of all the signers, and print the
+signature share to send to the coordinator. The nonces file is removed, as nonces must never be reused.`,
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := thresholdKeyShare(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			nonces := &frost.SigningNonces{}
+			if err := readFrostFile(clientCtx.Codec, args[1], nonces); err != nil {
+				return err
+			}
+
+			msg, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			commitments, err := readFrostFiles[frost.SigningCommitment](clientCtx.Codec, args[3:])
+			if err != nil {
+				return err
+			}
+
+			sigShare, err := frost.Sign(share, nonces, msg, commitments)
+			if err != nil {
+				return err
+			}
+
+			if err := os.Remove(args[1]); err != nil {
+				return err
+			}
+
+			return printFrostPackage(cmd, clientCtx.Codec, sigShare)
+		},
+	}
+}
+
+func frostAggregateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate [name] [message-file]",
+		Short: "Aggregate the signature shares, printing the hex encoded signature",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			share, err := thresholdKeyShare(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			commitmentFiles, _ := cmd.Flags().GetStringSlice(flagCommitments)
+			commitments, err := readFrostFiles[frost.SigningCommitment](clientCtx.Codec, commitmentFiles)
+			if err != nil {
+				return err
+			}
+
+			shareFiles, _ := cmd.Flags().GetStringSlice(flagShares)
+			sigShares, err := readFrostFiles[frost.SignatureShare](clientCtx.Codec, shareFiles)
+			if err != nil {
+				return err
+			}
+
+			sig, err := frost.Aggregate(share, msg, commitments, sigShares)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(sig))
+			return err
+		},
+	}
+
+	cmd.Flags().StringSlice(flagCommitments, nil, "Commitment files of all the signers")
+	cmd.Flags().StringSlice(flagShares, nil, "Signature share files of all the signers")
+	_ = cmd.MarkFlagRequired(flagCommitments)
+	_ = cmd.MarkFlagRequired(flagShares)
+
+	return cmd
+}
+
+// thresholdKeyShare returns the FROST key share of a threshold key of the keyring.
+func thresholdKeyShare(kr keyring.Keyring, name string) (*frost.KeyShare, error) {
+	k, err := kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := k.GetThreshold()
+	if threshold == nil || threshold.Share == nil {
+		return nil, fmt.Errorf("%s is not a threshold key", name)
+	}
+
+	return threshold.Share, nil
+}
+
+func printFrostPackage(cmd *cobra.Command, cdc codec.Codec, pkg gogoproto.Message) error {
+	bz, err := cdc.MarshalJSON(pkg)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
+
+// writeFrostSecret writes a package to a new file only readable by its owner.
+func writeFrostSecret(cdc codec.Codec, path string, pkg gogoproto.Message) error {
+	bz, err := cdc.MarshalJSON(pkg)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(bz)
+	return errors.Join(err, f.Close())
+}
+
+func readFrostFile(cdc codec.Codec, path string, pkg gogoproto.Message) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err := cdc.UnmarshalJSON(bz, pkg); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func readFrostFiles[T any, PT interface {
+	*T
+	gogoproto.Message
+}](cdc codec.Codec, paths []string) ([]PT, error) {
+	pkgs := make([]PT, len(paths))
+	for i, path := range paths {
+		pkgs[i] = new(T)
+		if err := readFrostFile(cdc, path, pkgs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return pkgs, nil
+}
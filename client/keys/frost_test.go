@@ -0,0 +1,129 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	stded25519 "crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func Test_runFrostCmds(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	dir := t.TempDir()
+	kbHomes := []string{t.TempDir(), t.TempDir(), t.TempDir()}
+
+	run := func(participant int, args ...string) string {
+		t.Helper()
+
+		clientCtx := client.Context{}.
+			WithKeyringDir(kbHomes[participant]).
+			WithCodec(cdc).
+			WithAddressCodec(addresscodec.NewBech32Codec("cosmos"))
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+		cmd := FrostCommand()
+		cmd.PersistentFlags().AddFlagSet(Commands().PersistentFlags())
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHomes[participant]),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+			fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON),
+		))
+		require.NoError(t, cmd.ExecuteContext(ctx))
+
+		return out.String()
+	}
+	path := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+	write := func(name, content string) string {
+		require.NoError(t, os.WriteFile(path(name), []byte(content), 0o600))
+		return path(name)
+	}
+
+	// key generation
+	var round1Files []string
+	for i := range kbHomes {
+		out := run(i, "dkg-round1", path(fmt.Sprintf("secret-%d.json", i+1)),
+			fmt.Sprintf("--%s=%d", flagIdentifier, i+1), "--min-signers=2", "--max-signers=3")
+		round1Files = append(round1Files, write(fmt.Sprintf("round1-%d.json", i+1), out))
+
+		info, err := os.Stat(path(fmt.Sprintf("secret-%d.json", i+1)))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	for i := range kbHomes {
+		out := run(i, append([]string{"dkg-round2", path(fmt.Sprintf("secret-%d.json", i+1)), fmt.Sprintf("--%s=%s", flagOutputDir, dir)}, round1Files...)...)
+		require.Len(t, strings.Fields(out), 2)
+	}
+
+	var address string
+	for i := range kbHomes {
+		var round2Files []string
+		for sender := range kbHomes {
+			if sender != i {
+				round2Files = append(round2Files, fmt.Sprintf("--%s=%s", flagRound2, path(fmt.Sprintf("round2-%d-%d.json", sender+1, i+1))))
+			}
+		}
+
+		out := run(i, append([]string{
+			"dkg-finalize", "mykey", path(fmt.Sprintf("secret-%d.json", i+1)),
+			fmt.Sprintf("--%s=%s", flagRound1, strings.Join(round1Files, ",")),
+		}, round2Files...)...)
+		require.Contains(t, out, `"type":"threshold"`)
+		if address == "" {
+			address = out
+		}
+		require.Equal(t, address, out)
+
+		_, err := os.Stat(path(fmt.Sprintf("secret-%d.json", i+1)))
+		require.True(t, os.IsNotExist(err))
+	}
+
+	// signing with the first and last participants
+	msg := write("message.bin", "hello")
+	signers := []int{0, 2}
+	var commitmentFiles []string
+	for _, i := range signers {
+		out := run(i, "commit", "mykey", path(fmt.Sprintf("nonces-%d.json", i+1)))
+		commitmentFiles = append(commitmentFiles, write(fmt.Sprintf("commitment-%d.json", i+1), out))
+	}
+
+	var shareFiles []string
+	for _, i := range signers {
+		out := run(i, append([]string{"sign", "mykey", path(fmt.Sprintf("nonces-%d.json", i+1)), msg}, commitmentFiles...)...)
+		shareFiles = append(shareFiles, write(fmt.Sprintf("share-%d.json", i+1), out))
+
+		_, err := os.Stat(path(fmt.Sprintf("nonces-%d.json", i+1)))
+		require.True(t, os.IsNotExist(err))
+	}
+
+	out := run(1, "aggregate", "mykey", msg,
+		fmt.Sprintf("--%s=%s", flagCommitments, strings.Join(commitmentFiles, ",")),
+		fmt.Sprintf("--%s=%s", flagShares, strings.Join(shareFiles, ",")))
+	sig, err := hex.DecodeString(strings.TrimSpace(out))
+	require.NoError(t, err)
+
+	kb, err := keyring.New(t.Name(), keyring.BackendTest, kbHomes[1], nil, cdc)
+	require.NoError(t, err)
+	k, err := kb.Key("mykey")
+	require.NoError(t, err)
+	require.True(t, stded25519.Verify(k.GetThreshold().Share.GroupPublicKey, []byte("hello"), sig))
+}
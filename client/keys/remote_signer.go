@@ -0,0 +1,171 @@
+package keys
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagPolicy = "policy"
+
+// RemoteSignerCommand serves the keys of the keyring over the remote signer gRPC service.
+func RemoteSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-remote-signer [address]",
+		Short: "Serve the keyring keys as a remote signer",
+		Long: `Serve the local and ledger keys of the keyring over the RemoteSigner gRPC service,
+used by the remote keyring backend (--keyring-backend remote --keyring-remote-signer <address>).
+The address is either a <host>:<port> address or a unix:///path/to/socket path. The Unix socket
+is only accessible by its owner, and TCP connections are not encrypted, so this command is a
+reference signer meant for testing and local setups.
+
+All the keys are exposed and allowed to sign with any sign mode, unless a policy file is given.
+The policy file is a JSON object associating the exposed keys to the sign modes they are
+allowed to sign with (any if empty):
+
+{
+  "validator": {"sign_modes": ["SIGN_MODE_DIRECT", "SIGN_MODE_TEXTUAL"]},
+  "operator": {}
+}
+`,
+		Example: fmt.Sprintf("%s keys serve-remote-signer unix:///tmp/signer.sock --policy policy.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runRemoteSignerCmd,
+	}
+
+	cmd.Flags().String(flagPolicy, "", "Path to the JSON file of the key policies")
+
+	return cmd
+}
+
+func runRemoteSignerCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.Keyring.Backend() == keyring.BackendRemote {
+		return errors.New("cannot serve the keys of a remote keyring")
+	}
+
+	var policies map[string]keyring.KeyPolicy
+	if policyFile, _ := cmd.Flags().GetString(flagPolicy); policyFile != "" {
+		if policies, err = readKeyPolicies(policyFile); err != nil {
+			return err
+		}
+	}
+
+	lis, err := listenRemoteSigner(args[0])
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer()
+	keyring.RegisterRemoteSignerServer(server, keyring.NewRemoteSignerServer(clientCtx.Keyring, policies))
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+
+	cmd.Printf("Remote signer listening on %s\n", args[0])
+	return server.Serve(lis)
+}
+
+// listenRemoteSigner listens on a <host>:<port> address or a unix:// socket path.
+func listenRemoteSigner(address string) (net.Listener, error) {
+	path, ok := strings.CutPrefix(address, "unix://")
+	if !ok {
+		return net.Listen("tcp", address)
+	}
+
+	// only the owner of the socket can sign with the keys: the socket is created
+	// in a directory only accessible by its owner, restricted, and then moved to
+	// its path, so that it is never accessible by other users
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".remote-signer-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, "signer.sock")
+	lis, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmpPath, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	// the socket is removed from its final path on close
+	lis.SetUnlinkOnClose(false)
+
+	if err := os.Chmod(tmpPath, 0o600); err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+
+	return unixSocketListener{UnixListener: lis, path: path}, nil
+}
+
+// unixSocketListener is a Unix socket listener removing its socket on close.
+type unixSocketListener struct {
+	*net.UnixListener
+	path string
+}
+
+func (l unixSocketListener) Close() error {
+	if err := l.UnixListener.Close(); err != nil {
+		return err
+	}
+	return os.Remove(l.path)
+}
+
+// readKeyPolicies reads the key policies of a JSON policy file.
+func readKeyPolicies(path string) (map[string]keyring.KeyPolicy, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file map[string]struct {
+		SignModes []string `json:"sign_modes"`
+	}
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %w", path, err)
+	}
+
+	policies := make(map[string]keyring.KeyPolicy, len(file))
+	for name, policy := range file {
+		signModes := make([]signing.SignMode, 0, len(policy.SignModes))
+		for _, signMode := range policy.SignModes {
+			value, ok := signing.SignMode_value[signMode]
+			if !ok {
+				return nil, fmt.Errorf("invalid sign mode %s for key %s", signMode, name)
+			}
+
+			signModes = append(signModes, signing.SignMode(value))
+		}
+
+		policies[name] = keyring.KeyPolicy{SignModes: signModes}
+	}
+
+	return policies, nil
+}
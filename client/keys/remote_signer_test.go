@@ -0,0 +1,66 @@
+package keys
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func Test_readKeyPolicies(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		path := filepath.Join(dir, "policy.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	policies, err := readKeyPolicies(write(`{"validator": {"sign_modes": ["SIGN_MODE_DIRECT", "SIGN_MODE_TEXTUAL"]}, "operator": {}}`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]keyring.KeyPolicy{
+		"validator": {SignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_TEXTUAL}},
+		"operator":  {SignModes: []signing.SignMode{}},
+	}, policies)
+	require.True(t, policies["operator"].Allows(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))
+	require.False(t, policies["validator"].Allows(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))
+
+	_, err = readKeyPolicies(write(`{"validator": {"sign_modes": ["DIRECT"]}}`))
+	require.ErrorContains(t, err, "invalid sign mode DIRECT for key validator")
+
+	_, err = readKeyPolicies(write(`[]`))
+	require.ErrorContains(t, err, "failed to parse policy file")
+}
+
+func Test_listenRemoteSigner(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix socket permissions are not supported on windows")
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "signer.sock")
+	lis, err := listenRemoteSigner("unix://" + path)
+	require.NoError(t, err)
+
+	// the socket is only accessible by its owner, and no temporary file is left
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.ModeSocket, info.Mode().Type())
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	// the socket is removed on close
+	require.NoError(t, lis.Close())
+	require.NoFileExists(t, path)
+}
@@ -26,6 +26,8 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Delegates signing to a remote signer, set with --keyring-remote-signer. Keys are
+                managed by the remote signer and cannot be added, imported, exported or deleted.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -42,6 +44,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		ExportKeyCommand(),
 		ImportKeyCommand(),
 		ImportKeyHexCommand(),
+		BackupKeysCommand(),
+		RestoreKeysCommand(),
 		ListKeysCmd(),
 		ListKeyTypesCmd(),
 		ShowKeysCmd(),
@@ -49,6 +53,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		RenameKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		RemoteSignerCommand(),
+		FrostCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagOutput, "text", "Output format (text|json)")
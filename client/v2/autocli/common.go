@@ -313,14 +313,15 @@ func (b *Builder) setFlagsFromConfig(cmd *cobra.Command) error {
 	}
 
 	flagsToSet := map[string]string{
-		flags.FlagChainID:        conf.ChainID,
-		flags.FlagKeyringBackend: conf.KeyringBackend,
-		flags.FlagFrom:           conf.KeyringDefaultKeyName,
-		flags.FlagOutput:         conf.Output,
-		flags.FlagNode:           conf.Node,
-		flags.FlagBroadcastMode:  conf.BroadcastMode,
-		flags.FlagGrpcAddress:    conf.GRPC.Address,
-		flags.FlagGrpcInsecure:   strconv.FormatBool(conf.GRPC.Insecure),
+		flags.FlagChainID:             conf.ChainID,
+		flags.FlagKeyringBackend:      conf.KeyringBackend,
+		flags.FlagKeyringRemoteSigner: conf.KeyringRemoteSigner,
+		flags.FlagFrom:                conf.KeyringDefaultKeyName,
+		flags.FlagOutput:              conf.Output,
+		flags.FlagNode:                conf.Node,
+		flags.FlagBroadcastMode:       conf.BroadcastMode,
+		flags.FlagGrpcAddress:         conf.GRPC.Address,
+		flags.FlagGrpcInsecure:        strconv.FormatBool(conf.GRPC.Insecure),
 	}
 
 	for flagName, value := range flagsToSet {
@@ -17,8 +17,9 @@ import (
 
 type Config struct {
 	ChainID               string     `mapstructure:"chain-id" toml:"chain-id" comment:"The chain ID of the blockchain network"`
-	KeyringBackend        string     `mapstructure:"keyring-backend" toml:"keyring-backend" comment:"The keyring backend to use (os|file|kwallet|pass|test|memory)"`
+	KeyringBackend        string     `mapstructure:"keyring-backend" toml:"keyring-backend" comment:"The keyring backend to use (os|file|kwallet|pass|test|memory|remote)"`
 	KeyringDefaultKeyName string     `mapstructure:"keyring-default-keyname" toml:"keyring-default-keyname" comment:"The default key name to use for signing transactions"`
+	KeyringRemoteSigner   string     `mapstructure:"keyring-remote-signer" toml:"keyring-remote-signer" comment:"The remote signer address used by the remote keyring backend (<host>:<port> or unix:///path/to/socket)"`
 	Output                string     `mapstructure:"output" toml:"output" comment:"The output format for queries (text|json)"`
 	Node                  string     `mapstructure:"node" toml:"node" comment:"The RPC endpoint URL for the node to connect to"`
 	BroadcastMode         string     `mapstructure:"broadcast-mode" toml:"broadcast-mode" comment:"How transactions are broadcast to the network (sync|async|block)"`
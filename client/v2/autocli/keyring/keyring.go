@@ -45,6 +45,19 @@ func NewKeyringFromFlags(flagSet *pflag.FlagSet, ac address.Codec, input io.Read
 		}
 	}
 
+	if flagSet.Lookup("keyring-remote-signer") != nil {
+		remoteSigner, err := flagSet.GetString("keyring-remote-signer")
+		if err != nil {
+			return nil, err
+		}
+
+		if remoteSigner != "" {
+			opts = append(opts, func(options *keyring.Options) {
+				options.RemoteSignerAddress = remoteSigner
+			})
+		}
+	}
+
 	k, err := keyring.New("autoclikeyring", backEnd, keyringDir, input, cdc, opts...)
 	if err != nil {
 		return nil, err
@@ -24,6 +24,8 @@ const (
 	FlagKeyringDir = "keyring-dir"
 	// FlagKeyringBackend is the flag to specify which backend to use for the keyring (e.g. os, file, test).
 	FlagKeyringBackend = "keyring-backend"
+	// FlagKeyringRemoteSigner is the flag to specify the remote signer address used by the remote keyring backend.
+	FlagKeyringRemoteSigner = "keyring-remote-signer"
 
 	// FlagNoProposal is the flag convert a gov proposal command into a normal command.
 	// This is used to allow user of chains with custom authority to not use gov submit proposals for usual proposal commands.
@@ -69,12 +69,17 @@ func CollValue[T any, PT protoMessage[T]](cdc interface {
 	Unmarshal([]byte, proto.Message) error
 },
 ) protoCollValueCodec[T] {
-	return &collValue[T, PT]{cdc.(Codec), proto.MessageName(PT(new(T)))}
+	return &collValue[T, PT]{
+		cdc:           cdc.(Codec),
+		messageName:   proto.MessageName(PT(new(T))),
+		protoClonable: isProtoClonable(PT(new(T))),
+	}
 }
 
 type collValue[T any, PT protoMessage[T]] struct {
-	cdc         Codec
-	messageName string
+	cdc           Codec
+	messageName   string
+	protoClonable bool
 }
 
 func (c collValue[T, PT]) Encode(value T) ([]byte, error) {
@@ -99,10 +104,61 @@ func (c collValue[T, PT]) Stringify(value T) string {
 	return PT(&value).String()
 }
 
+// Clone returns a deep copy of the value, allowing the value to be cached with
+// collections.WithMapCache.
+func (c collValue[T, PT]) Clone(value T) T {
+	if c.protoClonable {
+		return *proto.Clone(PT(&value)).(PT)
+	}
+	b, err := c.cdc.Marshal(PT(&value))
+	if err != nil {
+		panic(fmt.Errorf("cannot clone %s: %w", c.messageName, err))
+	}
+	var cloned T
+	if err := c.cdc.Unmarshal(b, PT(&cloned)); err != nil {
+		panic(fmt.Errorf("cannot clone %s: %w", c.messageName, err))
+	}
+	return cloned
+}
+
 func (c collValue[T, PT]) ValueType() string {
 	return "github.com/cosmos/gogoproto/" + c.messageName
 }
 
+// isProtoClonable reports whether proto.Clone supports the message type, which
+// it does not for messages with custom types such as math.LegacyDec: proto.Clone
+// panics on the fields of such types. Such messages are cloned by encoding and
+// decoding them instead.
+func isProtoClonable(msg proto.Message) bool {
+	return isProtoClonableType(reflect.TypeOf(msg).Elem(), map[reflect.Type]bool{})
+}
+
+func isProtoClonableType(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[typ] {
+		return true
+	}
+	visited[typ] = true
+	for i := 0; i < typ.NumField(); i++ {
+		fieldType := typ.Field(i).Type
+		if fieldType.Kind() == reflect.Map {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Slice {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Struct {
+			continue
+		}
+		if !reflect.PointerTo(fieldType).Implements(protoMessageType) || !isProtoClonableType(fieldType, visited) {
+			return false
+		}
+	}
+	return true
+}
+
 func (c collValue[T, PT]) SchemaCodec() (collcodec.SchemaCodec[T], error) {
 	var (
 		t  T
@@ -236,6 +292,8 @@ func (c collValue[T, PT]) SchemaCodec() (collcodec.SchemaCodec[T], error) {
 	}
 }
 
+var protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
 type protoMessageV2[T any] interface {
 	*T
 	protov2.Message
@@ -277,6 +335,12 @@ func (c collValue2[T, PT]) Stringify(value PT) string {
 	return fmt.Sprintf("%v", value)
 }
 
+// Clone returns a deep copy of the value, allowing the value to be cached with
+// collections.WithMapCache.
+func (c collValue2[T, PT]) Clone(value PT) PT {
+	return protov2.Clone(value).(PT)
+}
+
 func (c collValue2[T, PT]) ValueType() string {
 	return "google.golang.org/protobuf/" + c.messageName
 }
@@ -320,6 +384,20 @@ func (c collInterfaceValue[T]) Stringify(value T) string {
 	return value.String()
 }
 
+// Clone returns a deep copy of the value, allowing the value to be cached with
+// collections.WithMapCache.
+func (c collInterfaceValue[T]) Clone(value T) T {
+	b, err := c.codec.MarshalInterface(value)
+	if err != nil {
+		panic(fmt.Errorf("cannot clone %T: %w", value, err))
+	}
+	var cloned T
+	if err := c.codec.UnmarshalInterface(b, &cloned); err != nil {
+		panic(fmt.Errorf("cannot clone %T: %w", value, err))
+	}
+	return cloned
+}
+
 func (c collInterfaceValue[T]) ValueType() string {
 	var t T
 	return fmt.Sprintf("%T", t)
@@ -9,11 +9,18 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func TestCollectionsCorrectness(t *testing.T) {
@@ -77,3 +84,49 @@ func TestCollectionsCorrectness(t *testing.T) {
 		})
 	})
 }
+
+func TestCollValueCache(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	params := collections.NewItem(sb, collections.NewPrefix(0), "params", codec.CollValue[authtypes.Params](cdc), collections.WithMapCache(1))
+	bytesItem := collections.NewItem(sb, collections.NewPrefix(1), "bytes", codec.CollValue[gogotypes.BytesValue](cdc), collections.WithMapCache(1))
+	decCoin := collections.NewItem(sb, collections.NewPrefix(2), "dec_coin", codec.CollValue[sdk.DecCoin](cdc), collections.WithMapCache(1))
+	wrapper := collections.NewItem(sb, collections.NewPrefix(3), "wrapper", codec.CollValueV2[wrapperspb.BytesValue](), collections.WithMapCache(1))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, params.Set(ctx, authtypes.DefaultParams()))
+	gotParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.DefaultParams(), gotParams)
+	require.Equal(t, collections.CacheStats{Hits: 1}, params.CacheStats())
+
+	// mutating a returned value does not change the cached value, whether the
+	// message is cloned with proto.Clone or through its encoding
+	require.NoError(t, bytesItem.Set(ctx, gogotypes.BytesValue{Value: []byte("value")}))
+	gotBytes, err := bytesItem.Get(ctx)
+	require.NoError(t, err)
+	gotBytes.Value[0] = 'V'
+	gotBytes, err = bytesItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), gotBytes.Value)
+
+	require.NoError(t, decCoin.Set(ctx, sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(1))))
+	gotDecCoin, err := decCoin.Get(ctx)
+	require.NoError(t, err)
+	gotDecCoin.Amount.MulInt64Mut(2)
+	gotDecCoin, err = decCoin.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(1), gotDecCoin.Amount)
+	require.Equal(t, collections.CacheStats{Hits: 2}, decCoin.CacheStats())
+
+	require.NoError(t, wrapper.Set(ctx, wrapperspb.Bytes([]byte("value"))))
+	gotWrapper, err := wrapper.Get(ctx)
+	require.NoError(t, err)
+	gotWrapper.Value[0] = 'V'
+	gotWrapper, err = wrapper.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), gotWrapper.Value)
+}
@@ -0,0 +1,166 @@
+package collections
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// WithMapCache enables a cache of at most size decoded values of the Map or
+// Item, avoiding to decode a value again as long as its bytes in the store are
+// unchanged.
+//
+// The cache never bypasses the store: every access still reads the value bytes
+// from the store, which retains gas consumption and keeps the cache correct
+// across blocks and across discarded store branches, as a cached value is only
+// returned when the bytes read from the store equal the cached bytes. Values are
+// cached on Get and written through on Set.
+//
+// Values containing pointers, slices, maps or interfaces are copied on the way
+// in and out of the cache, so that callers never share a cached value. Such
+// values require a value codec implementing codec.CloneableValueCodec, as the
+// protobuf value codecs of the SDK codec package do, otherwise building the
+// schema fails.
+//
+// Cache hits and misses are reported by CacheStats and emitted as the
+// collections_cache_hit and collections_cache_miss telemetry counters, labelled
+// with the collection name.
+func WithMapCache(size int) func(opt *mapOptions) {
+	return func(opt *mapOptions) {
+		opt.cacheSize = size
+	}
+}
+
+// CacheStats reports the hits and misses of a Map or Item cache.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// valueCache is a bounded cache of decoded values, keyed by the store key and
+// validated against the value bytes. It is safe for concurrent use.
+type valueCache[V any] struct {
+	mu      sync.RWMutex
+	size    int
+	clone   func(V) V
+	entries map[string]cachedValue[V]
+
+	hits, misses atomic.Uint64
+	labels       []metrics.Label
+}
+
+type cachedValue[V any] struct {
+	raw   []byte
+	value V
+}
+
+var (
+	cacheHitKey  = []string{"collections", "cache", "hit"}
+	cacheMissKey = []string{"collections", "cache", "miss"}
+)
+
+func newValueCache[V any](name string, size int, vc codec.ValueCodec[V]) (*valueCache[V], error) {
+	if size <= 0 {
+		return nil, nil
+	}
+
+	clone := func(v V) V { return v }
+	if !isImmutable(reflect.TypeOf((*V)(nil)).Elem()) {
+		cloner, ok := vc.(codec.CloneableValueCodec[V])
+		if !ok {
+			return nil, fmt.Errorf("cannot cache values of type %s: the value codec %T does not implement codec.CloneableValueCodec", vc.ValueType(), vc)
+		}
+		clone = cloner.Clone
+	}
+	return &valueCache[V]{
+		size:    size,
+		clone:   clone,
+		entries: make(map[string]cachedValue[V], size),
+		labels:  []metrics.Label{{Name: "collection", Value: name}},
+	}, nil
+}
+
+// isImmutable reports whether the values of the type share no memory once
+// copied, i.e. contain no pointers, slices, maps, interfaces, channels or
+// functions.
+func isImmutable(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Array:
+		return isImmutable(typ.Elem())
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if !isImmutable(typ.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Slice, reflect.Map, reflect.Interface, reflect.Chan, reflect.Func:
+		return false
+	default:
+		return true
+	}
+}
+
+// get returns the cached value of the key if the value bytes in the store are
+// the cached bytes.
+func (c *valueCache[V]) get(key, raw []byte) (v V, ok bool) {
+	if c == nil {
+		return v, false
+	}
+
+	c.mu.RLock()
+	entry, found := c.entries[string(key)]
+	c.mu.RUnlock()
+
+	if !found || !bytes.Equal(entry.raw, raw) {
+		c.misses.Add(1)
+		metrics.IncrCounterWithLabels(cacheMissKey, 1, c.labels)
+		return v, false
+	}
+	c.hits.Add(1)
+	metrics.IncrCounterWithLabels(cacheHitKey, 1, c.labels)
+	return c.clone(entry.value), true
+}
+
+// set caches the value of the key, evicting an arbitrary entry if full.
+func (c *valueCache[V]) set(key, raw []byte, value V) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, found := c.entries[string(key)]; !found && len(c.entries) >= c.size {
+		for evicted := range c.entries {
+			delete(c.entries, evicted)
+			break
+		}
+	}
+	c.entries[string(key)] = cachedValue[V]{raw: bytes.Clone(raw), value: c.clone(value)}
+}
+
+// remove removes the key from the cache.
+func (c *valueCache[V]) remove(key []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, string(key))
+}
+
+func (c *valueCache[V]) stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
@@ -0,0 +1,147 @@
+package collections
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// countingValueCodec counts the decoded values.
+type countingValueCodec[V any] struct {
+	codec.ValueCodec[V]
+	decoded *int
+}
+
+func (c countingValueCodec[V]) Decode(b []byte) (V, error) {
+	*c.decoded++
+	return c.ValueCodec.Decode(b)
+}
+
+func TestMapCache(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	decoded := 0
+	vc := countingValueCodec[uint64]{ValueCodec: Uint64Value, decoded: &decoded}
+	m := NewMap(schemaBuilder, NewPrefix(0), "m", StringKey, vc, WithMapCache(2))
+	uncached := NewMap(schemaBuilder, NewPrefix(1), "uncached", StringKey, Uint64Value)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// values are written through
+	require.NoError(t, m.Set(ctx, "a", 1))
+	v, err := m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+	require.Equal(t, 0, decoded)
+	require.Equal(t, CacheStats{Hits: 1}, m.CacheStats())
+
+	// a value cached from a discarded branch, whose bytes differ from the store
+	// bytes, is not returned
+	m.cache.set([]byte{0, 'a'}, []byte{0, 0, 0, 0, 0, 0, 0, 2}, 2)
+	v, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+	require.Equal(t, 1, decoded)
+	require.Equal(t, CacheStats{Hits: 1, Misses: 1}, m.CacheStats())
+
+	v, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+	require.Equal(t, 1, decoded)
+
+	// the cache is bounded
+	require.NoError(t, m.Set(ctx, "b", 2))
+	require.NoError(t, m.Set(ctx, "c", 3))
+	require.Len(t, m.cache.entries, 2)
+
+	// removed keys are not found
+	require.NoError(t, m.Remove(ctx, "c"))
+	_, err = m.Get(ctx, "c")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.Equal(t, CacheStats{}, uncached.CacheStats())
+}
+
+func TestItemCache(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	item := NewItem(schemaBuilder, NewPrefix(0), "item", StringValue, WithMapCache(1))
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, item.Set(ctx, "params"))
+	for i := 0; i < 3; i++ {
+		v, err := item.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "params", v)
+	}
+	require.Equal(t, CacheStats{Hits: 3}, item.CacheStats())
+}
+
+// cloningValueCodec clones the byte slices.
+type cloningValueCodec struct {
+	codec.ValueCodec[[]byte]
+}
+
+func (cloningValueCodec) Clone(value []byte) []byte { return bytes.Clone(value) }
+
+func TestMapCacheMutableValues(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(0), "m", StringKey, cloningValueCodec{BytesValue}, WithMapCache(1))
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// mutating the set or the returned values does not change the cached value
+	value := []byte("value")
+	require.NoError(t, m.Set(ctx, "a", value))
+	value[0] = 'V'
+	got, err := m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), got)
+	got[0] = 'V'
+	got, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), got)
+	require.Equal(t, CacheStats{Hits: 2}, m.CacheStats())
+
+	// mutable values which cannot be cloned are not cached
+	schemaBuilder = NewSchemaBuilder(sk)
+	NewMap(schemaBuilder, NewPrefix(0), "m", StringKey, BytesValue, WithMapCache(1))
+	_, err = schemaBuilder.Build()
+	require.ErrorContains(t, err, "collection m: cannot cache values of type")
+}
+
+func TestCacheTelemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{})
+	})
+
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	item := NewItem(schemaBuilder, NewPrefix(0), "item", Uint64Value, WithMapCache(1))
+	_, err = schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, item.Set(ctx, 1))
+	item.cache.remove([]byte{0})
+	for i := 0; i < 3; i++ {
+		_, err := item.Get(ctx)
+		require.NoError(t, err)
+	}
+
+	counters := sink.Data()[0].Counters
+	require.Equal(t, 2, counters["test.collections.cache.hit;collection=item"].Count)
+	require.Equal(t, 1, counters["test.collections.cache.miss;collection=item"].Count)
+}
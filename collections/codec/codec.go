@@ -74,6 +74,15 @@ type ValueCodec[T any] interface {
 	ValueType() string
 }
 
+// CloneableValueCodec is a ValueCodec which can deep copy the values, required
+// to cache values which are not immutable, e.g. protobuf messages.
+type CloneableValueCodec[T any] interface {
+	ValueCodec[T]
+
+	// Clone returns a deep copy of the value, sharing no memory with it.
+	Clone(value T) T
+}
+
 // NewUntypedValueCodec returns an UntypedValueCodec for the provided ValueCodec.
 func NewUntypedValueCodec[V any](v ValueCodec[V]) UntypedValueCodec {
 	typeName := fmt.Sprintf("%T", *new(V))
@@ -0,0 +1,154 @@
+package collections
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections/codec"
+)
+
+const (
+	ExpiringMapEntriesNameSuffix    = "_entries"
+	ExpiringMapExpiriesNameSuffix   = "_expiries"
+	ExpiringMapQueueNameSuffix      = "_queue"
+	ExpiringMapEntriesPrefixSuffix  = 0x0
+	ExpiringMapExpiriesPrefixSuffix = 0x1
+	ExpiringMapQueuePrefixSuffix    = 0x2
+)
+
+// NewExpiringMap creates a new ExpiringMap instance. Since ExpiringMap relies on three
+// collections, it will register three state objects on the schema builder, whose prefixes
+// are the provided prefix suffixed with respectively ExpiringMapEntriesPrefixSuffix,
+// ExpiringMapExpiriesPrefixSuffix and ExpiringMapQueuePrefixSuffix, and whose names are the
+// provided name suffixed with respectively ExpiringMapEntriesNameSuffix,
+// ExpiringMapExpiriesNameSuffix and ExpiringMapQueueNameSuffix.
+// The queue is registered as a secondary index, hence it is excluded from the indexing schema.
+// The expiry codec must retain ordering, e.g. a time or a height key codec.
+func NewExpiringMap[E, K, V any](
+	sb *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	expiryCodec codec.KeyCodec[E],
+	keyCodec codec.KeyCodec[K],
+	valueCodec codec.ValueCodec[V],
+) ExpiringMap[E, K, V] {
+	withSuffix := func(suffix byte) Prefix {
+		return append(prefix[:len(prefix):len(prefix)], suffix)
+	}
+
+	return ExpiringMap[E, K, V]{
+		entries: NewMap(sb, withSuffix(ExpiringMapEntriesPrefixSuffix), name+ExpiringMapEntriesNameSuffix, keyCodec, valueCodec),
+		expiries: NewMap(
+			sb, withSuffix(ExpiringMapExpiriesPrefixSuffix), name+ExpiringMapExpiriesNameSuffix,
+			keyCodec, codec.KeyToValueCodec(expiryCodec),
+		),
+		queue: NewKeySet(
+			sb, withSuffix(ExpiringMapQueuePrefixSuffix), name+ExpiringMapQueueNameSuffix,
+			PairKeyCodec(expiryCodec, keyCodec), WithKeySetSecondaryIndex(),
+		),
+	}
+}
+
+// ExpiringMap works like a Map whose entries expire at a given point, e.g. a time
+// or a block height, and can be removed in expiry order with PopExpired.
+// It relies on three collections: the entries which is a Map[K, V], the expiries
+// which is a Map[K, E] allowing to remove an entry by its key, and the queue which
+// is a KeySet[Pair[E, K]] ordering the entries by expiry.
+type ExpiringMap[E, K, V any] struct {
+	entries  Map[K, V]
+	expiries Map[K, E]
+	queue    KeySet[Pair[E, K]]
+}
+
+// Set sets the value of the key, expiring at the given expiry. If the key is
+// already present, both its value and its expiry are replaced.
+func (m ExpiringMap[E, K, V]) Set(ctx context.Context, key K, expiry E, value V) error {
+	if err := m.removeFromQueue(ctx, key); err != nil {
+		return err
+	}
+	if err := m.entries.Set(ctx, key, value); err != nil {
+		return err
+	}
+	if err := m.expiries.Set(ctx, key, expiry); err != nil {
+		return err
+	}
+	return m.queue.Set(ctx, Join(expiry, key))
+}
+
+// Get returns the value of the key, or ErrNotFound if the key is not present.
+// Expired entries are returned until they are popped.
+func (m ExpiringMap[E, K, V]) Get(ctx context.Context, key K) (V, error) {
+	return m.entries.Get(ctx, key)
+}
+
+// Expiry returns the expiry of the key, or ErrNotFound if the key is not present.
+func (m ExpiringMap[E, K, V]) Expiry(ctx context.Context, key K) (E, error) {
+	return m.expiries.Get(ctx, key)
+}
+
+// Has reports whether the key is present.
+func (m ExpiringMap[E, K, V]) Has(ctx context.Context, key K) (bool, error) {
+	return m.entries.Has(ctx, key)
+}
+
+// Remove removes the key. It does not report whether the key was present.
+func (m ExpiringMap[E, K, V]) Remove(ctx context.Context, key K) error {
+	if err := m.removeFromQueue(ctx, key); err != nil {
+		return err
+	}
+	if err := m.expiries.Remove(ctx, key); err != nil {
+		return err
+	}
+	return m.entries.Remove(ctx, key)
+}
+
+// PopExpired removes at most limit entries whose expiry is lower than or equal to
+// now, in expiry order, and returns them. A zero limit removes all the expired
+// entries, which should be avoided in the state machine as their number is
+// unbounded.
+func (m ExpiringMap[E, K, V]) PopExpired(ctx context.Context, now E, limit uint64) ([]KeyValue[K, V], error) {
+	var keys []K
+	err := m.queue.Walk(ctx, NewPrefixUntilPairRange[E, K](now), func(key Pair[E, K]) (stop bool, err error) {
+		keys = append(keys, key.K2())
+		return limit != 0 && uint64(len(keys)) == limit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expired := make([]KeyValue[K, V], 0, len(keys))
+	for _, key := range keys {
+		value, err := m.entries.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+		expired = append(expired, KeyValue[K, V]{Key: key, Value: value})
+	}
+	return expired, nil
+}
+
+// Iterate iterates over the entries in key order, expired or not.
+func (m ExpiringMap[E, K, V]) Iterate(ctx context.Context, ranger Ranger[K]) (Iterator[K, V], error) {
+	return m.entries.Iterate(ctx, ranger)
+}
+
+// Walk walks over the entries in key order, expired or not.
+func (m ExpiringMap[E, K, V]) Walk(ctx context.Context, ranger Ranger[K], walkFn func(key K, value V) (stop bool, err error)) error {
+	return m.entries.Walk(ctx, ranger, walkFn)
+}
+
+// removeFromQueue removes the key from the queue, if present.
+func (m ExpiringMap[E, K, V]) removeFromQueue(ctx context.Context, key K) error {
+	expiry, err := m.expiries.Get(ctx, key)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	default:
+		return m.queue.Remove(ctx, Join(expiry, key))
+	}
+}
@@ -0,0 +1,110 @@
+package collections
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpiringMap(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewExpiringMap(schemaBuilder, NewPrefix(0), "grants", Uint64Key, StringKey, StringValue)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// nothing to pop when empty
+	expired, err := m.PopExpired(ctx, 100, 0)
+	require.NoError(t, err)
+	require.Empty(t, expired)
+
+	require.NoError(t, m.Set(ctx, "a", 10, "va"))
+	require.NoError(t, m.Set(ctx, "b", 5, "vb"))
+	require.NoError(t, m.Set(ctx, "c", 20, "vc"))
+	require.NoError(t, m.Set(ctx, "d", 10, "vd"))
+
+	v, err := m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, "va", v)
+
+	expiry, err := m.Expiry(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), expiry)
+
+	// replacing an entry replaces its expiry
+	require.NoError(t, m.Set(ctx, "c", 1, "vc2"))
+	expiry, err = m.Expiry(ctx, "c")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), expiry)
+
+	// removing by key removes the entry from the queue
+	require.NoError(t, m.Remove(ctx, "b"))
+	has, err := m.Has(ctx, "b")
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = m.Expiry(ctx, "b")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// removing a missing key is a no-op
+	require.NoError(t, m.Remove(ctx, "missing"))
+
+	// pop is bounded and in expiry order
+	expired, err = m.PopExpired(ctx, 10, 2)
+	require.NoError(t, err)
+	require.Equal(t, []KeyValue[string, string]{{Key: "c", Value: "vc2"}, {Key: "a", Value: "va"}}, expired)
+
+	has, err = m.Has(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the expiry is inclusive
+	expired, err = m.PopExpired(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyValue[string, string]{{Key: "d", Value: "vd"}}, expired)
+
+	expired, err = m.PopExpired(ctx, 100, 0)
+	require.NoError(t, err)
+	require.Empty(t, expired)
+
+	it, err := m.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := it.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestExpiringMap_Genesis(t *testing.T) {
+	newSchema := func() (Schema, ExpiringMap[uint64, string, string], context.Context) {
+		sk, ctx := deps()
+		schemaBuilder := NewSchemaBuilder(sk)
+		m := NewExpiringMap(schemaBuilder, NewPrefix("em"), "grants", Uint64Key, StringKey, StringValue)
+		schema, err := schemaBuilder.Build()
+		require.NoError(t, err)
+		return schema, m, ctx
+	}
+
+	schema, m, ctx := newSchema()
+	require.NoError(t, m.Set(ctx, "a", 10, "va"))
+	require.NoError(t, m.Set(ctx, "b", 5, "vb"))
+
+	exported := make(map[string]*bufCloser)
+	require.NoError(t, schema.ExportGenesis(ctx, func(field string) (io.WriteCloser, error) {
+		w := newBufCloser(t, "")
+		exported[field] = w
+		return w, nil
+	}))
+	require.Equal(t, `[{"key":"a","value":"va"},{"key":"b","value":"vb"}]`, exported["grants_entries"].String())
+	require.Equal(t, `[{"key":"a","value":"10"},{"key":"b","value":"5"}]`, exported["grants_expiries"].String())
+
+	// import into an empty store
+	schema, m, ctx = newSchema()
+	require.NoError(t, schema.InitGenesis(ctx, func(field string) (io.ReadCloser, error) {
+		return newBufCloser(t, exported[field].String()), nil
+	}))
+
+	expired, err := m.PopExpired(ctx, 5, 0)
+	require.NoError(t, err)
+	require.Equal(t, []KeyValue[string, string]{{Key: "b", Value: "vb"}}, expired)
+}
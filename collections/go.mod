@@ -7,6 +7,7 @@ require (
 	cosmossdk.io/core/testing v0.0.1
 	cosmossdk.io/schema v1.0.0
 	github.com/cosmos/gogoproto v1.7.0
+	github.com/hashicorp/go-metrics v0.5.3
 	github.com/stretchr/testify v1.10.0
 	github.com/tidwall/btree v1.7.0
 	google.golang.org/protobuf v1.36.1
@@ -17,6 +18,8 @@ require (
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
 	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
+	github.com/hashicorp/go-immutable-radix v1.3.1 // indirect
+	github.com/hashicorp/golang-lru v1.0.2 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,113 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// Counter defines an index which counts, for each reference key, the number of
+// primary keys whose value references it. Counts can be queried in O(1), for
+// example the number of delegations of a validator.
+type Counter[ReferenceKey, PrimaryKey, Value any] struct {
+	getRefKey func(pk PrimaryKey, value Value) (ReferenceKey, error)
+	counts    collections.Map[ReferenceKey, uint64]
+}
+
+// NewCounter instantiates a new Counter instance given a schema, a Prefix, the
+// humanized name for the index and the reference key key codec. The
+// getRefKeyFunc is a function that given the primary key and value returns the
+// referencing key.
+func NewCounter[ReferenceKey, PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	getRefKeyFunc func(pk PrimaryKey, value Value) (ReferenceKey, error),
+) *Counter[ReferenceKey, PrimaryKey, Value] {
+	return &Counter[ReferenceKey, PrimaryKey, Value]{
+		getRefKey: getRefKeyFunc,
+		counts:    collections.NewMap(schema, prefix, name, refCodec, collections.Uint64Value),
+	}
+}
+
+func (c *Counter[ReferenceKey, PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	// if no error it means the value existed, and we need to remove the old reference
+	case err == nil:
+		err = c.unreference(ctx, pk, oldValue)
+		if err != nil {
+			return err
+		}
+	// if error is ErrNotFound, it means that the object does not exist, so we're counting it for the first time.
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+
+	refKey, err := c.getRefKey(pk, newValue)
+	if err != nil {
+		return err
+	}
+	count, err := c.Count(ctx, refKey)
+	if err != nil {
+		return err
+	}
+	return c.counts.Set(ctx, refKey, count+1)
+}
+
+func (c *Counter[ReferenceKey, PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return c.unreference(ctx, pk, value)
+}
+
+func (c *Counter[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKey, err := c.getRefKey(pk, value)
+	if err != nil {
+		return err
+	}
+	count, err := c.Count(ctx, refKey)
+	if err != nil {
+		return err
+	}
+	switch count {
+	case 0:
+		return fmt.Errorf("%w: index counter underflow: %s", collections.ErrConflict, c.counts.KeyCodec().Stringify(refKey))
+	case 1:
+		// remove the counter instead of storing a zero count
+		return c.counts.Remove(ctx, refKey)
+	default:
+		return c.counts.Set(ctx, refKey, count-1)
+	}
+}
+
+// Count returns the number of primary keys referencing the provided reference
+// key, zero if none.
+func (c *Counter[ReferenceKey, PrimaryKey, Value]) Count(ctx context.Context, ref ReferenceKey) (uint64, error) {
+	count, err := c.counts.Get(ctx, ref)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// Iterate iterates over the counts of the reference keys referenced at least once.
+func (c *Counter[ReferenceKey, PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[ReferenceKey]) (collections.Iterator[ReferenceKey, uint64], error) {
+	return c.counts.Iterate(ctx, ranger)
+}
+
+// Walk walks over the counts of the reference keys referenced at least once.
+func (c *Counter[ReferenceKey, PrimaryKey, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[ReferenceKey],
+	walkFunc func(indexingKey ReferenceKey, count uint64) (stop bool, err error),
+) error {
+	return c.counts.Walk(ctx, ranger, walkFunc)
+}
@@ -0,0 +1,63 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+type companyIndexes struct {
+	City *Counter[string, string, company]
+}
+
+func (i companyIndexes) IndexesList() []collections.Index[string, company] {
+	return []collections.Index[string, company]{i.City}
+}
+
+func TestCounterIndex(t *testing.T) {
+	sk, ctx := deps()
+	schema := collections.NewSchemaBuilder(sk)
+	im := collections.NewIndexedMap(schema, collections.NewPrefix(0), "companies", collections.StringKey, colltest.MockValueCodec[company](), companyIndexes{
+		City: NewCounter(schema, collections.NewPrefix(1), "companies_by_city", collections.StringKey, func(_ string, v company) (string, error) {
+			return v.City, nil
+		}),
+	})
+	_, err := schema.Build()
+	require.NoError(t, err)
+
+	requireCount := func(city string, expected uint64) {
+		t.Helper()
+		count, err := im.Indexes.City.Count(ctx, city)
+		require.NoError(t, err)
+		require.Equal(t, expected, count)
+	}
+
+	require.NoError(t, im.Set(ctx, "1", company{City: "milan"}))
+	require.NoError(t, im.Set(ctx, "2", company{City: "milan"}))
+	require.NoError(t, im.Set(ctx, "3", company{City: "rome"}))
+	requireCount("milan", 2)
+	requireCount("rome", 1)
+	requireCount("turin", 0)
+
+	// updating a value without changing its reference does not change the count
+	require.NoError(t, im.Set(ctx, "1", company{City: "milan", Vat: 1}))
+	requireCount("milan", 2)
+
+	// moving a value moves its count
+	require.NoError(t, im.Set(ctx, "2", company{City: "rome"}))
+	requireCount("milan", 1)
+	requireCount("rome", 2)
+
+	// removing the last reference removes the counter
+	require.NoError(t, im.Remove(ctx, "1"))
+	requireCount("milan", 0)
+
+	it, err := im.Indexes.City.Iterate(ctx, nil)
+	require.NoError(t, err)
+	kvs, err := it.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []collections.KeyValue[string, uint64]{{Key: "rome", Value: 2}}, kvs)
+}
@@ -0,0 +1,201 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// Summable is implemented by the amounts which can be summed by a Sum index,
+// for example math.Int.
+type Summable[Amount any] interface {
+	Add(Amount) Amount
+	Sub(Amount) Amount
+	IsZero() bool
+}
+
+// Sum defines an index which sums, for each reference key, an amount extracted
+// from the values referencing it. Sums can be queried in O(1), for example the
+// total escrowed amount of a denom.
+type Sum[ReferenceKey, PrimaryKey, Value, Amount any] struct {
+	getRefKey func(pk PrimaryKey, value Value) (ReferenceKey, error)
+	getAmount func(pk PrimaryKey, value Value) (Amount, error)
+	add       func(a, b Amount) (Amount, error)
+	sub       func(a, b Amount) (Amount, error)
+	neg       func(a Amount) (Amount, error)
+	isZero    func(a Amount) bool
+	zero      Amount
+	sums      collections.Map[ReferenceKey, Amount]
+}
+
+// NewSum instantiates a new Sum instance of Summable amounts given a schema, a
+// Prefix, the humanized name for the index, the reference key key codec, the
+// amount value codec and the zero amount, returned for the reference keys which
+// are not referenced, e.g. math.ZeroInt(). The getRefKeyFunc is a function that given the primary key
+// and value returns the referencing key, and the getAmountFunc is a function that
+// given the primary key and value returns the amount to sum.
+func NewSum[ReferenceKey, PrimaryKey, Value any, Amount Summable[Amount]](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	amountCodec codec.ValueCodec[Amount],
+	zero Amount,
+	getRefKeyFunc func(pk PrimaryKey, value Value) (ReferenceKey, error),
+	getAmountFunc func(pk PrimaryKey, value Value) (Amount, error),
+) *Sum[ReferenceKey, PrimaryKey, Value, Amount] {
+	return &Sum[ReferenceKey, PrimaryKey, Value, Amount]{
+		getRefKey: getRefKeyFunc,
+		getAmount: getAmountFunc,
+		add:       func(a, b Amount) (Amount, error) { return a.Add(b), nil },
+		sub:       func(a, b Amount) (Amount, error) { return a.Sub(b), nil },
+		neg:       func(a Amount) (Amount, error) { return a.Sub(a).Sub(a), nil },
+		isZero:    func(a Amount) bool { return a.IsZero() },
+		zero:      zero,
+		sums:      collections.NewMap(schema, prefix, name, refCodec, amountCodec),
+	}
+}
+
+// NewUint64Sum behaves like NewSum but sums uint64 amounts. An overflow of a sum
+// makes Reference fail.
+func NewUint64Sum[ReferenceKey, PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	getRefKeyFunc func(pk PrimaryKey, value Value) (ReferenceKey, error),
+	getAmountFunc func(pk PrimaryKey, value Value) (uint64, error),
+) *Sum[ReferenceKey, PrimaryKey, Value, uint64] {
+	return &Sum[ReferenceKey, PrimaryKey, Value, uint64]{
+		getRefKey: getRefKeyFunc,
+		getAmount: getAmountFunc,
+		add: func(a, b uint64) (uint64, error) {
+			if a+b < a {
+				return 0, errors.New("index sum overflow")
+			}
+			return a + b, nil
+		},
+		sub: func(a, b uint64) (uint64, error) {
+			if b > a {
+				return 0, errors.New("index sum underflow")
+			}
+			return a - b, nil
+		},
+		neg: func(a uint64) (uint64, error) {
+			if a != 0 {
+				return 0, errors.New("index sum underflow")
+			}
+			return 0, nil
+		},
+		isZero: func(a uint64) bool { return a == 0 },
+		sums:   collections.NewMap(schema, prefix, name, refCodec, collections.Uint64Value),
+	}
+}
+
+func (s *Sum[ReferenceKey, PrimaryKey, Value, Amount]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	// if no error it means the value existed, and we need to subtract its amount
+	case err == nil:
+		err = s.unreference(ctx, pk, oldValue)
+		if err != nil {
+			return err
+		}
+	// if error is ErrNotFound, it means that the object does not exist, so we're summing it for the first time.
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+
+	refKey, err := s.getRefKey(pk, newValue)
+	if err != nil {
+		return err
+	}
+	amount, err := s.getAmount(pk, newValue)
+	if err != nil {
+		return err
+	}
+
+	sum, err := s.sums.Get(ctx, refKey)
+	switch {
+	case err == nil:
+		sum, err = s.add(sum, amount)
+		if err != nil {
+			return fmt.Errorf("%w: %s", err, s.sums.KeyCodec().Stringify(refKey))
+		}
+	case errors.Is(err, collections.ErrNotFound):
+		sum = amount
+	default:
+		return err
+	}
+	return s.set(ctx, refKey, sum)
+}
+
+func (s *Sum[ReferenceKey, PrimaryKey, Value, Amount]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return s.unreference(ctx, pk, value)
+}
+
+func (s *Sum[ReferenceKey, PrimaryKey, Value, Amount]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKey, err := s.getRefKey(pk, value)
+	if err != nil {
+		return err
+	}
+	amount, err := s.getAmount(pk, value)
+	if err != nil {
+		return err
+	}
+
+	sum, err := s.sums.Get(ctx, refKey)
+	switch {
+	case err == nil:
+		sum, err = s.sub(sum, amount)
+	// zero sums are not stored
+	case errors.Is(err, collections.ErrNotFound):
+		sum, err = s.neg(amount)
+	default:
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w: %s", collections.ErrConflict, err, s.sums.KeyCodec().Stringify(refKey))
+	}
+	return s.set(ctx, refKey, sum)
+}
+
+// set stores the sum, or removes it if zero.
+func (s *Sum[ReferenceKey, PrimaryKey, Value, Amount]) set(ctx context.Context, refKey ReferenceKey, sum Amount) error {
+	if s.isZero(sum) {
+		return s.sums.Remove(ctx, refKey)
+	}
+	return s.sums.Set(ctx, refKey, sum)
+}
+
+// Get returns the sum of the amounts of the values referencing the provided
+// reference key, or the zero amount if the key is not referenced.
+func (s *Sum[ReferenceKey, PrimaryKey, Value, Amount]) Get(ctx context.Context, ref ReferenceKey) (Amount, error) {
+	sum, err := s.sums.Get(ctx, ref)
+	if errors.Is(err, collections.ErrNotFound) {
+		return s.zero, nil
+	}
+	return sum, err
+}
+
+// Iterate iterates over the non-zero sums.
+func (s *Sum[ReferenceKey, PrimaryKey, Value, Amount]) Iterate(ctx context.Context, ranger collections.Ranger[ReferenceKey]) (collections.Iterator[ReferenceKey, Amount], error) {
+	return s.sums.Iterate(ctx, ranger)
+}
+
+// Walk walks over the non-zero sums.
+func (s *Sum[ReferenceKey, PrimaryKey, Value, Amount]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[ReferenceKey],
+	walkFunc func(indexingKey ReferenceKey, sum Amount) (stop bool, err error),
+) error {
+	return s.sums.Walk(ctx, ranger, walkFunc)
+}
@@ -0,0 +1,86 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+func TestUint64SumIndex(t *testing.T) {
+	sk, ctx := deps()
+	schema := collections.NewSchemaBuilder(sk)
+	si := NewUint64Sum(schema, collections.NewPrefix("vat_by_city"), "vat_by_city", collections.StringKey,
+		func(_ uint64, v company) (string, error) { return v.City, nil },
+		func(_ uint64, v company) (uint64, error) { return v.Vat, nil },
+	)
+
+	notFound := func() (company, error) { return company{}, collections.ErrNotFound }
+	require.NoError(t, si.Reference(ctx, 1, company{City: "milan", Vat: 10}, notFound))
+	require.NoError(t, si.Reference(ctx, 2, company{City: "milan", Vat: 5}, notFound))
+	require.NoError(t, si.Reference(ctx, 3, company{City: "rome", Vat: 1}, notFound))
+
+	sum, err := si.Get(ctx, "milan")
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), sum)
+
+	// updates replace the amount of the old value
+	require.NoError(t, si.Reference(ctx, 1, company{City: "milan", Vat: 20}, func() (company, error) { return company{City: "milan", Vat: 10}, nil }))
+	sum, err = si.Get(ctx, "milan")
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), sum)
+
+	// zero sums are removed
+	require.NoError(t, si.Unreference(ctx, 3, func() (company, error) { return company{City: "rome", Vat: 1}, nil }))
+	sum, err = si.Get(ctx, "rome")
+	require.NoError(t, err)
+	require.Zero(t, sum)
+
+	// inconsistent unreferences and overflows fail
+	err = si.Unreference(ctx, 3, func() (company, error) { return company{City: "rome", Vat: 1}, nil })
+	require.ErrorIs(t, err, collections.ErrConflict)
+	err = si.Reference(ctx, 4, company{City: "milan", Vat: ^uint64(0)}, notFound)
+	require.ErrorContains(t, err, "overflow")
+}
+
+// signed is a Summable amount which can be negative, as math.Int.
+type signed int64
+
+func (s signed) Add(o signed) signed { return s + o }
+func (s signed) Sub(o signed) signed { return s - o }
+func (s signed) IsZero() bool        { return s == 0 }
+
+func TestSumIndex(t *testing.T) {
+	type entry struct {
+		Denom  string
+		Amount signed
+	}
+
+	sk, ctx := deps()
+	schema := collections.NewSchemaBuilder(sk)
+	si := NewSum(schema, collections.NewPrefix("escrow_by_denom"), "escrow_by_denom", collections.StringKey, codec.KeyToValueCodec(codec.NewInt64Key[signed]()), 0,
+		func(_ uint64, v entry) (string, error) { return v.Denom, nil },
+		func(_ uint64, v entry) (signed, error) { return v.Amount, nil },
+	)
+
+	notFound := func() (entry, error) { return entry{}, collections.ErrNotFound }
+	require.NoError(t, si.Reference(ctx, 1, entry{Denom: "atom", Amount: 5}, notFound))
+	require.NoError(t, si.Reference(ctx, 2, entry{Denom: "atom", Amount: -5}, notFound))
+
+	// the sum is zero, hence not stored
+	sum, err := si.Get(ctx, "atom")
+	require.NoError(t, err)
+	require.Equal(t, signed(0), sum)
+	iter, err := si.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	// a zero sum is still consistent with the referencing values
+	require.NoError(t, si.Unreference(ctx, 1, func() (entry, error) { return entry{Denom: "atom", Amount: 5}, nil }))
+	sum, err = si.Get(ctx, "atom")
+	require.NoError(t, err)
+	require.Equal(t, signed(-5), sum)
+}
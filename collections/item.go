@@ -20,8 +20,9 @@ func NewItem[V any](
 	prefix Prefix,
 	name string,
 	valueCodec codec.ValueCodec[V],
+	options ...func(opt *mapOptions),
 ) Item[V] {
-	item := (Item[V])(NewMap[noKey](schema, prefix, name, noKey{}, valueCodec))
+	item := (Item[V])(NewMap[noKey](schema, prefix, name, noKey{}, valueCodec, options...))
 	return item
 }
 
@@ -47,6 +48,12 @@ func (i Item[V]) Remove(ctx context.Context) error {
 	return (Map[noKey, V])(i).Remove(ctx, noKey{})
 }
 
+// CacheStats returns the hits and misses of the cache of the item, zero if the
+// cache is not enabled with WithMapCache.
+func (i Item[V]) CacheStats() CacheStats {
+	return (Map[noKey, V])(i).CacheStats()
+}
+
 // noKey defines a KeyCodec which decodes nothing.
 type noKey struct{}
 
@@ -25,6 +25,9 @@ type Map[K, V any] struct {
 	// on another collection and that it should be skipped when generating
 	// a user facing schema
 	isSecondaryIndex bool
+
+	// cache of the decoded values, nil unless enabled with WithMapCache
+	cache *valueCache[V]
 }
 
 // withMapSecondaryIndex changes the behavior of the Map to be a secondary index.
@@ -36,6 +39,7 @@ func withMapSecondaryIndex(isSecondaryIndex bool) func(opt *mapOptions) {
 
 type mapOptions struct {
 	isSecondaryIndex bool
+	cacheSize        int
 }
 
 // NewMap returns a Map given a StoreKey, a Prefix, human-readable name and the relative value and key encoders.
@@ -61,6 +65,11 @@ func NewMap[K, V any](
 		name:             name,
 		isSecondaryIndex: o.isSecondaryIndex,
 	}
+	cache, err := newValueCache(name, o.cacheSize, valueCodec)
+	if err != nil {
+		schemaBuilder.appendError(fmt.Errorf("collection %s: %w", name, err))
+	}
+	m.cache = cache
 	schemaBuilder.addCollection(collectionImpl[K, V]{m})
 	return m
 }
@@ -87,7 +96,11 @@ func (m Map[K, V]) Set(ctx context.Context, key K, value V) error {
 	}
 
 	kvStore := m.sa(ctx)
-	return kvStore.Set(bytesKey, valueBytes)
+	if err := kvStore.Set(bytesKey, valueBytes); err != nil {
+		return err
+	}
+	m.cache.set(bytesKey, valueBytes, value)
+	return nil
 }
 
 // Get returns the value associated with the provided key,
@@ -108,10 +121,15 @@ func (m Map[K, V]) Get(ctx context.Context, key K) (v V, err error) {
 		return v, fmt.Errorf("%w: key '%s' of type %s", ErrNotFound, m.kc.Stringify(key), m.vc.ValueType())
 	}
 
+	if cached, ok := m.cache.get(bytesKey, valueBytes); ok {
+		return cached, nil
+	}
+
 	v, err = m.vc.Decode(valueBytes)
 	if err != nil {
 		return v, fmt.Errorf("%w: value decode: %w", ErrEncoding, err)
 	}
+	m.cache.set(bytesKey, valueBytes, v)
 	return v, nil
 }
 
@@ -135,7 +153,11 @@ func (m Map[K, V]) Remove(ctx context.Context, key K) error {
 		return err
 	}
 	kvStore := m.sa(ctx)
-	return kvStore.Delete(bytesKey)
+	if err := kvStore.Delete(bytesKey); err != nil {
+		return err
+	}
+	m.cache.remove(bytesKey)
+	return nil
 }
 
 // Iterate provides an Iterator over K and V. It accepts a Ranger interface.
@@ -270,6 +292,10 @@ func (m Map[K, V]) IterateRaw(ctx context.Context, start, end []byte, order Orde
 // KeyCodec returns the Map's KeyCodec.
 func (m Map[K, V]) KeyCodec() codec.KeyCodec[K] { return m.kc }
 
+// CacheStats returns the hits and misses of the cache of the Map, zero if the
+// cache is not enabled with WithMapCache.
+func (m Map[K, V]) CacheStats() CacheStats { return m.cache.stats() }
+
 // ValueCodec returns the Map's ValueCodec.
 func (m Map[K, V]) ValueCodec() codec.ValueCodec[V] { return m.vc }
 
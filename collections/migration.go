@@ -0,0 +1,248 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrMigration is returned when a collection migration cannot be registered,
+// fails or produces an unexpected result.
+var ErrMigration = errors.New("collections: migration failed")
+
+const defaultMigrationBatchSize = 10000
+
+// Migration migrates the collections of an old Schema to a new Schema sitting
+// on top of the same storage, for example from an appmodule migration handler:
+//
+//	m := collections.NewMigration(oldSchema, newSchema)
+//	err := collections.AddMapMigration(m, "balances", "balances_v2", func(k OldKey, v OldValue) (NewKey, NewValue, error) { ... })
+//	...
+//	return m.Run(ctx)
+//
+// Entries are migrated in batches: each batch is read and its iterator closed
+// before writing, so a migration never writes to the store while iterating it.
+// Once a collection is migrated, the migration verifies that the old collection
+// is empty, unless migrated in place, and that the new collection decodes and
+// contains exactly the migrated entries.
+// NOTE: Unstable.
+type Migration struct {
+	from, to   Schema
+	steps      []migrationStep
+	batchSize  int
+	onProgress func(collection string, migrated uint64)
+}
+
+type migrationStep struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// NewMigration returns a Migration from the old schema to the new schema.
+func NewMigration(from, to Schema) *Migration {
+	return &Migration{from: from, to: to, batchSize: defaultMigrationBatchSize}
+}
+
+// WithBatchSize sets the number of entries migrated per batch.
+func (m *Migration) WithBatchSize(size int) *Migration {
+	if size > 0 {
+		m.batchSize = size
+	}
+	return m
+}
+
+// WithProgress sets a function called after each migrated batch with the name
+// of the new collection and the number of entries migrated so far.
+func (m *Migration) WithProgress(onProgress func(collection string, migrated uint64)) *Migration {
+	m.onProgress = onProgress
+	return m
+}
+
+// AddMapMigration registers the migration of the Map (or KeySet) named fromName
+// in the old schema to the Map named toName in the new schema, rekeying and
+// transforming each entry with migrateFn.
+// If the two collections share the same prefix the entries are migrated in place,
+// in which case migrateFn must not change the encoded keys. Otherwise the new
+// collection must be empty before the migration and the old collection is
+// cleared.
+func AddMapMigration[OldK, OldV, NewK, NewV any](
+	m *Migration,
+	fromName, toName string,
+	migrateFn func(key OldK, value OldV) (NewK, NewV, error),
+) error {
+	from, err := getMap[OldK, OldV](m.from, fromName)
+	if err != nil {
+		return err
+	}
+	to, err := getMap[NewK, NewV](m.to, toName)
+	if err != nil {
+		return err
+	}
+
+	inPlace := bytes.Equal(from.prefix, to.prefix)
+	if !inPlace && (bytes.HasPrefix(from.prefix, to.prefix) || bytes.HasPrefix(to.prefix, from.prefix)) {
+		return fmt.Errorf("%w: %s and %s have overlapping prefixes 0x%x and 0x%x", ErrMigration, fromName, toName, from.prefix, to.prefix)
+	}
+
+	m.steps = append(m.steps, migrationStep{
+		name: toName,
+		run: func(ctx context.Context) error {
+			return migrateMap(ctx, m, from, to, inPlace, migrateFn)
+		},
+	})
+	return nil
+}
+
+// Run runs the registered migrations in registration order.
+func (m *Migration) Run(ctx context.Context) error {
+	for _, step := range m.steps {
+		if err := step.run(ctx); err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrMigration, step.name, err)
+		}
+	}
+	return nil
+}
+
+// getMap returns the Map of the given name and types within the schema.
+func getMap[K, V any](s Schema, name string) (Map[K, V], error) {
+	coll, err := s.getCollection(name)
+	if err != nil {
+		return Map[K, V]{}, fmt.Errorf("%w: %w", ErrMigration, err)
+	}
+	impl, ok := coll.(collectionImpl[K, V])
+	if !ok {
+		return Map[K, V]{}, fmt.Errorf("%w: collection %s is a %T, not a Map[%T, %T]", ErrMigration, name, coll, *new(K), *new(V))
+	}
+	return impl.m, nil
+}
+
+func migrateMap[OldK, OldV, NewK, NewV any](
+	ctx context.Context,
+	m *Migration,
+	from Map[OldK, OldV],
+	to Map[NewK, NewV],
+	inPlace bool,
+	migrateFn func(key OldK, value OldV) (NewK, NewV, error),
+) error {
+	if !inPlace {
+		empty, err := isEmpty(ctx, to)
+		if err != nil {
+			return err
+		}
+		if !empty {
+			return fmt.Errorf("new collection %s is not empty", to.name)
+		}
+	}
+
+	var (
+		migrated uint64
+		start    = from.prefix
+		end      = nextBytesPrefixKey(from.prefix)
+	)
+	for {
+		keys, values, err := readBatch(ctx, from, start, end, m.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range keys {
+			oldKey, oldValue, err := decodeEntry(from, keys[i], values[i])
+			if err != nil {
+				return err
+			}
+			newKey, newValue, err := migrateFn(oldKey, oldValue)
+			if err != nil {
+				return fmt.Errorf("migrating key %s: %w", from.kc.Stringify(oldKey), err)
+			}
+
+			if inPlace {
+				newKeyBytes, err := EncodeKeyWithPrefix(to.prefix, to.kc, newKey)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(newKeyBytes, keys[i]) {
+					return fmt.Errorf("in place migration changed key %s", from.kc.Stringify(oldKey))
+				}
+			} else if err := from.sa(ctx).Delete(keys[i]); err != nil {
+				return err
+			}
+
+			if err := to.Set(ctx, newKey, newValue); err != nil {
+				return err
+			}
+			migrated++
+		}
+
+		if m.onProgress != nil && len(keys) > 0 {
+			m.onProgress(to.name, migrated)
+		}
+
+		// if we've retrieved less than the batch size, we're done
+		if len(keys) < m.batchSize {
+			break
+		}
+		start = append(bytes.Clone(keys[len(keys)-1]), 0)
+	}
+
+	return verifyMigration(ctx, from, to, inPlace, migrated)
+}
+
+// readBatch reads at most size raw entries within the range, closing the
+// iterator before returning.
+func readBatch[K, V any](ctx context.Context, m Map[K, V], start, end []byte, size int) (keys, values [][]byte, err error) {
+	iter, err := m.sa(ctx).Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	for ; iter.Valid() && len(keys) < size; iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	return keys, values, iter.Close()
+}
+
+func decodeEntry[K, V any](m Map[K, V], key, value []byte) (k K, v V, err error) {
+	_, k, err = m.kc.Decode(key[len(m.prefix):])
+	if err != nil {
+		return k, v, fmt.Errorf("%w: key decode: %w", ErrEncoding, err)
+	}
+	v, err = m.vc.Decode(value)
+	if err != nil {
+		return k, v, fmt.Errorf("%w: value decode: %w", ErrEncoding, err)
+	}
+	return k, v, nil
+}
+
+func isEmpty[K, V any](ctx context.Context, m Map[K, V]) (bool, error) {
+	keys, _, err := readBatch(ctx, m, m.prefix, nextBytesPrefixKey(m.prefix), 1)
+	return len(keys) == 0, err
+}
+
+// verifyMigration verifies that the old collection was cleared, unless migrated
+// in place, and that the new collection decodes and holds the migrated entries.
+func verifyMigration[OldK, OldV, NewK, NewV any](ctx context.Context, from Map[OldK, OldV], to Map[NewK, NewV], inPlace bool, migrated uint64) error {
+	if !inPlace {
+		empty, err := isEmpty(ctx, from)
+		if err != nil {
+			return err
+		}
+		if !empty {
+			return fmt.Errorf("old collection %s is not empty after migration", from.name)
+		}
+	}
+
+	var count uint64
+	err := to.Walk(ctx, nil, func(NewK, NewV) (bool, error) {
+		count++
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("new collection %s: %w", to.name, err)
+	}
+	if count != migrated {
+		// distinct old keys were migrated to the same new key
+		return fmt.Errorf("new collection %s has %d entries, %d were migrated", to.name, count, migrated)
+	}
+	return nil
+}
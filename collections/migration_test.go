@@ -0,0 +1,114 @@
+package collections
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigration(t *testing.T) {
+	sk, ctx := deps()
+
+	// old schema: balances keyed by "address/denom" strings with string amounts
+	oldSB := NewSchemaBuilder(sk)
+	oldBalances := NewMap(oldSB, NewPrefix(0), "balances", StringKey, StringValue)
+	oldParams := NewMap(oldSB, NewPrefix(1), "params", StringKey, StringValue)
+	oldSchema, err := oldSB.Build()
+	require.NoError(t, err)
+
+	// new schema: balances keyed by (address, denom) pairs with uint64 amounts,
+	// params values migrated in place
+	newSB := NewSchemaBuilder(sk)
+	newBalances := NewMap(newSB, NewPrefix(2), "balances", PairKeyCodec(StringKey, StringKey), Uint64Value)
+	newParams := NewMap(newSB, NewPrefix(1), "params", StringKey, StringValue)
+	newSchema, err := newSB.Build()
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, oldBalances.Set(ctx, fmt.Sprintf("addr%d/atom", i), strconv.Itoa(i*100)))
+	}
+	require.NoError(t, oldParams.Set(ctx, "fee", "1"))
+
+	var progress []uint64
+	m := NewMigration(oldSchema, newSchema).
+		WithBatchSize(2).
+		WithProgress(func(collection string, migrated uint64) {
+			if collection == "balances" {
+				progress = append(progress, migrated)
+			}
+		})
+
+	require.NoError(t, AddMapMigration(m, "balances", "balances", func(key, value string) (Pair[string, string], uint64, error) {
+		addr, denom, _ := strings.Cut(key, "/")
+		amount, err := strconv.ParseUint(value, 10, 64)
+		return Join(addr, denom), amount, err
+	}))
+	require.NoError(t, AddMapMigration(m, "params", "params", func(key, value string) (string, string, error) {
+		return key, value + "0", nil
+	}))
+	require.NoError(t, m.Run(ctx))
+	require.Equal(t, []uint64{2, 4, 5}, progress)
+
+	amount, err := newBalances.Get(ctx, Join("addr3", "atom"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), amount)
+
+	it, err := oldBalances.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := it.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	fee, err := newParams.Get(ctx, "fee")
+	require.NoError(t, err)
+	require.Equal(t, "10", fee)
+}
+
+func TestMigration_Errors(t *testing.T) {
+	sk, ctx := deps()
+
+	oldSB := NewSchemaBuilder(sk)
+	oldMap := NewMap(oldSB, NewPrefix(0), "m", StringKey, StringValue)
+	oldSchema, err := oldSB.Build()
+	require.NoError(t, err)
+
+	newSB := NewSchemaBuilder(sk)
+	newMap := NewMap(newSB, NewPrefix(1), "m", StringKey, StringValue)
+	NewMap(newSB, NewPrefix(0), "in_place", StringKey, StringValue)
+	newSchema, err := newSB.Build()
+	require.NoError(t, err)
+
+	overlappingSB := NewSchemaBuilder(sk)
+	NewMap(overlappingSB, []byte{0, 1}, "m", StringKey, StringValue)
+	overlappingSchema, err := overlappingSB.Build()
+	require.NoError(t, err)
+
+	identity := func(k, v string) (string, string, error) { return k, v, nil }
+
+	// unknown collections, wrong types and overlapping prefixes are rejected
+	m := NewMigration(oldSchema, newSchema)
+	require.ErrorIs(t, AddMapMigration(m, "unknown", "m", identity), ErrMigration)
+	require.ErrorIs(t, AddMapMigration(m, "m", "m", func(k string, v uint64) (string, string, error) { return k, "", nil }), ErrMigration)
+	require.ErrorIs(t, AddMapMigration(NewMigration(oldSchema, overlappingSchema), "m", "m", identity), ErrMigration)
+
+	// in place migrations cannot rekey
+	require.NoError(t, oldMap.Set(ctx, "a", "1"))
+	m = NewMigration(oldSchema, newSchema)
+	require.NoError(t, AddMapMigration(m, "m", "in_place", func(k, v string) (string, string, error) { return k + "x", v, nil }))
+	require.ErrorContains(t, m.Run(ctx), "in place migration changed key")
+
+	// migrating distinct keys to the same key fails verification
+	require.NoError(t, oldMap.Set(ctx, "b", "2"))
+	m = NewMigration(oldSchema, newSchema)
+	require.NoError(t, AddMapMigration(m, "m", "m", func(_, v string) (string, string, error) { return "same", v, nil }))
+	require.ErrorContains(t, m.Run(ctx), "has 1 entries, 2 were migrated")
+
+	// the new collection must be empty
+	require.NoError(t, newMap.Set(ctx, "c", "3"))
+	m = NewMigration(oldSchema, newSchema)
+	require.NoError(t, AddMapMigration(m, "m", "m", identity))
+	require.ErrorContains(t, m.Run(ctx), "is not empty")
+}
@@ -2,6 +2,7 @@ package protocodec
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/cosmos/gogoproto/proto"
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -57,12 +58,17 @@ func CollValue[T any, PT protoMessage[T]](cdc interface {
 	Unmarshal([]byte, proto.Message) error
 },
 ) collcodec.ValueCodec[T] {
-	return &collValue[T, PT]{cdc.(corecodec.Codec), proto.MessageName(PT(new(T)))}
+	return &collValue[T, PT]{
+		cdc:           cdc.(corecodec.Codec),
+		messageName:   proto.MessageName(PT(new(T))),
+		protoClonable: isProtoClonable(PT(new(T))),
+	}
 }
 
 type collValue[T any, PT protoMessage[T]] struct {
-	cdc         corecodec.Codec
-	messageName string
+	cdc           corecodec.Codec
+	messageName   string
+	protoClonable bool
 }
 
 func (c collValue[T, PT]) Encode(value T) ([]byte, error) {
@@ -87,10 +93,61 @@ func (c collValue[T, PT]) Stringify(value T) string {
 	return PT(&value).String()
 }
 
+func (c collValue[T, PT]) Clone(value T) T {
+	if c.protoClonable {
+		return *proto.Clone(PT(&value)).(PT)
+	}
+	b, err := c.cdc.Marshal(PT(&value))
+	if err != nil {
+		panic(fmt.Errorf("cannot clone %s: %w", c.messageName, err))
+	}
+	var cloned T
+	if err := c.cdc.Unmarshal(b, PT(&cloned)); err != nil {
+		panic(fmt.Errorf("cannot clone %s: %w", c.messageName, err))
+	}
+	return cloned
+}
+
 func (c collValue[T, PT]) ValueType() string {
 	return "github.com/cosmos/gogoproto/" + c.messageName
 }
 
+// isProtoClonable reports whether proto.Clone supports the message type, which
+// it does not for messages with custom types such as math.LegacyDec: proto.Clone
+// panics on the fields of such types. Such messages are cloned by encoding and
+// decoding them instead.
+func isProtoClonable(msg proto.Message) bool {
+	return isProtoClonableType(reflect.TypeOf(msg).Elem(), map[reflect.Type]bool{})
+}
+
+func isProtoClonableType(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[typ] {
+		return true
+	}
+	visited[typ] = true
+	for i := 0; i < typ.NumField(); i++ {
+		fieldType := typ.Field(i).Type
+		if fieldType.Kind() == reflect.Map {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Slice {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Struct {
+			continue
+		}
+		if !reflect.PointerTo(fieldType).Implements(protoMessageType) || !isProtoClonableType(fieldType, visited) {
+			return false
+		}
+	}
+	return true
+}
+
+var protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
 type protoMessageV2[T any] interface {
 	*T
 	protov2.Message
@@ -132,6 +189,10 @@ func (c collValue2[T, PT]) Stringify(value PT) string {
 	return fmt.Sprintf("%v", value)
 }
 
+func (c collValue2[T, PT]) Clone(value PT) PT {
+	return protov2.Clone(value).(PT)
+}
+
 func (c collValue2[T, PT]) ValueType() string {
 	return "google.golang.org/protobuf/" + c.messageName
 }
@@ -34,6 +34,16 @@ func TestCollectionsCorrectness(t *testing.T) {
 		require.NotEmpty(t, encoder.ValueType())
 
 		_ = encoder.Stringify(value)
+
+		// values are deep copied, as required to cache them
+		cloner, ok := encoder.(interface {
+			Clone(*wrapperspb.UInt64Value) *wrapperspb.UInt64Value
+		})
+		require.True(t, ok)
+		cloned := cloner.Clone(value)
+		require.True(t, cmp.Equal(value, cloned, protocmp.Transform()))
+		cloned.Value = 1
+		require.Equal(t, uint64(500), value.Value)
 	})
 
 	t.Run("BoolValue", func(t *testing.T) {
@@ -26,6 +26,8 @@ const (
 	blockTypeKeyInfo = "TENDERMINT KEY INFO"
 	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
 
+	blockTypeKeyringBackup = "COSMOS KEYRING BACKUP"
+
 	defaultAlgo = "secp256k1"
 
 	headerVersion = "version"
@@ -255,6 +257,64 @@ func decryptPrivKey(saltBytes, encBytes []byte, passphrase, kdf string) (privKey
 	return legacy.PrivKeyFromBytes(privKeyBytes)
 }
 
+// EncryptArmorKeyringBackup encrypts a keyring backup with a key derived from
+// the passphrase by argon2id, and armors it.
+func EncryptArmorKeyringBackup(bz []byte, passphrase string) string {
+	saltBytes := crypto.CRandBytes(16)
+
+	aead, err := chacha20poly1305.New(argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize))
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error generating cypher from key"))
+	}
+
+	header := map[string]string{
+		headerVersion: "0.0.1",
+		kdfHeader:     kdfArgon2,
+		"salt":        fmt.Sprintf("%X", saltBytes),
+	}
+
+	// the nonce is fixed as a new key is derived from a random salt at every encryption
+	nonce := make([]byte, aead.NonceSize())
+
+	return EncodeArmor(blockTypeKeyringBackup, header, aead.Seal(nil, nonce, bz, nil))
+}
+
+// UnarmorDecryptKeyringBackup returns the keyring backup encrypted with the
+// passphrase. As the encryption is authenticated, it fails if the backup was
+// altered.
+func UnarmorDecryptKeyringBackup(armorStr, passphrase string) ([]byte, error) {
+	encBytes, header, err := unarmorBytes(armorStr, blockTypeKeyringBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	if header[headerVersion] != "0.0.1" {
+		return nil, fmt.Errorf("unrecognized version: %v", header[headerVersion])
+	}
+
+	if header[kdfHeader] != kdfArgon2 {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header[kdfHeader])
+	}
+
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil || len(saltBytes) == 0 {
+		return nil, errors.New("missing or invalid salt bytes")
+	}
+
+	aead, err := chacha20poly1305.New(argon2.IDKey([]byte(passphrase), saltBytes, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error generating aead cypher for key")
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	bz, err := aead.Open(nil, nonce, encBytes, nil)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrWrongPassword, "wrong passphrase or corrupted backup")
+	}
+
+	return bz, nil
+}
+
 //-----------------------------------------------------------------
 // encode/decode with armor
 
@@ -28,6 +28,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
 	"github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestArmorUnarmorPrivKey(t *testing.T) {
@@ -76,6 +77,26 @@ func TestArmorUnarmorPrivKey(t *testing.T) {
 	require.Equal(t, "unrecognized KDF type: wrong", err.Error())
 }
 
+func TestArmorUnarmorKeyringBackup(t *testing.T) {
+	armored := crypto.EncryptArmorKeyringBackup([]byte("backup"), "passphrase")
+	_, err := crypto.UnarmorDecryptKeyringBackup(armored, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+	bz, err := crypto.UnarmorDecryptKeyringBackup(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, []byte("backup"), bz)
+
+	// altered backup
+	blockType, header, encBytes, err := crypto.DecodeArmor(armored)
+	require.NoError(t, err)
+	encBytes[0] ^= 1
+	_, err = crypto.UnarmorDecryptKeyringBackup(crypto.EncodeArmor(blockType, header, encBytes), "passphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	// wrong armor type
+	_, err = crypto.UnarmorDecryptKeyringBackup(crypto.EncryptArmorPrivKey(secp256k1.GenPrivKey(), "passphrase", ""), "passphrase")
+	require.ErrorContains(t, err, "unrecognized armor type")
+}
+
 func TestArmorUnarmorPubKey(t *testing.T) {
 	// Select the encryption and storage for your cryptostore
 	var cdc codec.Codec
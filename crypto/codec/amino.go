@@ -21,6 +21,7 @@ func RegisterCrypto(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(&secp256k1.PubKey{},
 		secp256k1.PubKeyName)
 	registrar.RegisterConcrete(&bls12_381.PubKey{}, bls12381.PubKeyName)
+	registrar.RegisterConcrete(&bls12_381.MultiPubKey{}, bls12_381.MultiPubKeyName)
 	registrar.RegisterConcrete(&kmultisig.LegacyAminoPubKey{},
 		kmultisig.PubKeyAminoRoute)
 	registrar.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
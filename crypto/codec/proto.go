@@ -3,11 +3,13 @@ package codec
 import (
 	"cosmossdk.io/core/registry"
 
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -18,6 +20,7 @@ func RegisterInterfaces(registry registry.InterfaceRegistrar) {
 	registry.RegisterImplementations(pk, &ed25519.PubKey{})
 	registry.RegisterImplementations(pk, &secp256k1.PubKey{})
 	registry.RegisterImplementations(pk, &bls12_381.PubKey{})
+	registry.RegisterImplementations(pk, &bls12_381.MultiPubKey{})
 	registry.RegisterImplementations(pk, &multisig.LegacyAminoPubKey{})
 
 	var priv *cryptotypes.PrivKey
@@ -26,4 +29,6 @@ func RegisterInterfaces(registry registry.InterfaceRegistrar) {
 	registry.RegisterImplementations(priv, &ed25519.PrivKey{})
 	registry.RegisterImplementations(priv, &bls12_381.PrivKey{})
 	secp256r1.RegisterInterfaces(registry)
+	webauthn.RegisterInterfaces(registry)
+	frost.RegisterInterfaces(registry)
 }
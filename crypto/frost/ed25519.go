@@ -0,0 +1,168 @@
+package frost
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"filippo.io/edwards25519"
+)
+
+// Ed25519 is the FROST(Ed25519, SHA-512) ciphersuite. Its signatures are
+// standard Ed25519 signatures.
+const Ed25519 = "FROST-ED25519-SHA512-v1"
+
+type ed25519Suite struct{}
+
+type ed25519Scalar struct{ s *edwards25519.Scalar }
+
+type ed25519Element struct{ p *edwards25519.Point }
+
+func (ed25519Suite) ID() string { return Ed25519 }
+
+func (ed25519Suite) Scalar(v uint32) scalar {
+	var bz [32]byte
+	binary.LittleEndian.PutUint32(bz[:], v)
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(bz[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return ed25519Scalar{s}
+}
+
+func (ed25519Suite) RandomScalar(rand io.Reader) (scalar, error) {
+	var bz [64]byte
+	for {
+		if _, err := io.ReadFull(rand, bz[:]); err != nil {
+			return nil, err
+		}
+
+		s, err := edwards25519.NewScalar().SetUniformBytes(bz[:])
+		if err != nil {
+			return nil, err
+		}
+
+		if s.Equal(edwards25519.NewScalar()) == 0 {
+			return ed25519Scalar{s}, nil
+		}
+	}
+}
+
+func (ed25519Suite) DecodeScalar(bz []byte) (scalar, error) {
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(bz)
+	if err != nil {
+		return nil, errors.New("invalid ed25519 scalar")
+	}
+
+	return ed25519Scalar{s}, nil
+}
+
+func (ed25519Suite) Identity() element {
+	return ed25519Element{edwards25519.NewIdentityPoint()}
+}
+
+func (ed25519Suite) BaseMult(s scalar) element {
+	return ed25519Element{new(edwards25519.Point).ScalarBaseMult(s.(ed25519Scalar).s)}
+}
+
+func (ed25519Suite) DecodeElement(bz []byte) (element, error) {
+	p, err := new(edwards25519.Point).SetBytes(bz)
+	if err != nil {
+		return nil, errors.New("invalid ed25519 element")
+	}
+
+	e := ed25519Element{p}
+	if e.IsIdentity() {
+		return nil, errors.New("invalid ed25519 element: identity")
+	}
+
+	// [L]P is the identity only if P is in the prime-order subgroup, computed as [L-1]P + P.
+	minusOne := edwards25519.NewScalar().Negate(ed25519Suite{}.Scalar(1).(ed25519Scalar).s)
+	lp := new(edwards25519.Point).ScalarMult(minusOne, p)
+	if lp.Add(lp, p).Equal(edwards25519.NewIdentityPoint()) != 1 {
+		return nil, errors.New("invalid ed25519 element: not in the prime-order subgroup")
+	}
+
+	return e, nil
+}
+
+func (ed25519Suite) hashToScalar(tag string, m []byte) scalar {
+	h := sha512.New()
+	if tag != "" {
+		h.Write([]byte(Ed25519 + tag))
+	}
+	h.Write(m)
+
+	s, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		panic(err)
+	}
+
+	return ed25519Scalar{s}
+}
+
+func (ed25519Suite) hash(tag string, m []byte) []byte {
+	h := sha512.New()
+	h.Write([]byte(Ed25519 + tag))
+	h.Write(m)
+	return h.Sum(nil)
+}
+
+func (g ed25519Suite) H1(m []byte) scalar { return g.hashToScalar("rho", m) }
+
+// H2 is not domain separated, so that the signatures are Ed25519 signatures.
+func (g ed25519Suite) H2(m []byte) scalar { return g.hashToScalar("", m) }
+
+func (g ed25519Suite) H3(m []byte) scalar { return g.hashToScalar("nonce", m) }
+
+func (g ed25519Suite) H4(m []byte) []byte { return g.hash("msg", m) }
+
+func (g ed25519Suite) H5(m []byte) []byte { return g.hash("com", m) }
+
+func (g ed25519Suite) HDKG(m []byte) scalar { return g.hashToScalar("dkg", m) }
+
+func (s ed25519Scalar) Add(t scalar) scalar {
+	return ed25519Scalar{edwards25519.NewScalar().Add(s.s, t.(ed25519Scalar).s)}
+}
+
+func (s ed25519Scalar) Sub(t scalar) scalar {
+	return ed25519Scalar{edwards25519.NewScalar().Subtract(s.s, t.(ed25519Scalar).s)}
+}
+
+func (s ed25519Scalar) Mul(t scalar) scalar {
+	return ed25519Scalar{edwards25519.NewScalar().Multiply(s.s, t.(ed25519Scalar).s)}
+}
+
+func (s ed25519Scalar) Invert() scalar {
+	return ed25519Scalar{edwards25519.NewScalar().Invert(s.s)}
+}
+
+func (s ed25519Scalar) IsZero() bool {
+	return s.s.Equal(edwards25519.NewScalar()) == 1
+}
+
+func (s ed25519Scalar) Equal(t scalar) bool {
+	return s.s.Equal(t.(ed25519Scalar).s) == 1
+}
+
+func (s ed25519Scalar) Bytes() []byte { return s.s.Bytes() }
+
+func (e ed25519Element) Add(f element) element {
+	return ed25519Element{new(edwards25519.Point).Add(e.p, f.(ed25519Element).p)}
+}
+
+func (e ed25519Element) ScalarMult(s scalar) element {
+	return ed25519Element{new(edwards25519.Point).ScalarMult(s.(ed25519Scalar).s, e.p)}
+}
+
+func (e ed25519Element) Equal(f element) bool {
+	return e.p.Equal(f.(ed25519Element).p) == 1
+}
+
+func (e ed25519Element) IsIdentity() bool {
+	return e.p.Equal(edwards25519.NewIdentityPoint()) == 1
+}
+
+func (e ed25519Element) Bytes() []byte { return e.p.Bytes() }
@@ -0,0 +1,428 @@
+// Package frost implements FROST threshold signatures as specified in RFC 9591,
+// for the FROST(Ed25519, SHA-512) and FROST(secp256k1, SHA-256) ciphersuites.
+//
+// A threshold key is shared between max_signers participants, any min_signers
+// of them being able to produce a single signature verifiable with the group
+// public key. The key shares are generated either with a distributed key
+// generation (DKGRound1, DKGRound2 and DKGFinalize), where no party ever knows
+// the secret key, or by a trusted dealer (GenerateKeyShares).
+//
+// Signing takes two rounds: each signer generates single-use nonces and sends
+// their commitment to a coordinator (Commit), then signs the message given the
+// commitments of all the signers (Sign). The coordinator finally aggregates the
+// signature shares into the signature (Aggregate).
+package frost
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	// ErrUnknownCiphersuite is returned for an unsupported ciphersuite.
+	ErrUnknownCiphersuite = errors.New("unknown FROST ciphersuite")
+	// ErrInvalidParticipants is returned for invalid numbers of participants.
+	ErrInvalidParticipants = errors.New("invalid FROST participants")
+	// ErrInvalidCommitments is returned for an invalid list of signing commitments.
+	ErrInvalidCommitments = errors.New("invalid FROST signing commitments")
+	// ErrInvalidSignatureShare is returned when a signature share does not verify.
+	ErrInvalidSignatureShare = errors.New("invalid FROST signature share")
+)
+
+func getCiphersuite(id string) (ciphersuite, error) {
+	switch id {
+	case Ed25519:
+		return ed25519Suite{}, nil
+	case Secp256k1:
+		return secp256k1Suite{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCiphersuite, id)
+	}
+}
+
+func validateParticipants(minSigners, maxSigners uint32) error {
+	if minSigners < 2 || minSigners > maxSigners {
+		return fmt.Errorf("%w: expected 2 <= min signers (%d) <= max signers (%d)", ErrInvalidParticipants, minSigners, maxSigners)
+	}
+
+	return nil
+}
+
+// PubKey returns the group public key of the key share: an ed25519 public key
+// for the ed25519 ciphersuite, or a SchnorrPubKey for the Schnorr signatures
+// of the secp256k1 ciphersuite.
+func (ks *KeyShare) PubKey() (cryptotypes.PubKey, error) {
+	suite, err := getCiphersuite(ks.Ciphersuite)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := suite.DecodeElement(ks.GroupPublicKey); err != nil {
+		return nil, err
+	}
+
+	switch ks.Ciphersuite {
+	case Ed25519:
+		return &ed25519.PubKey{Key: ks.GroupPublicKey}, nil
+	default:
+		return &SchnorrPubKey{Key: ks.GroupPublicKey}, nil
+	}
+}
+
+// Validate checks that the key share is well formed, and that its signing share
+// matches its verifying share.
+func (ks *KeyShare) Validate() error {
+	suite, err := getCiphersuite(ks.Ciphersuite)
+	if err != nil {
+		return err
+	}
+
+	if err := validateParticipants(ks.MinSigners, uint32(len(ks.VerifyingShares))); err != nil {
+		return err
+	}
+
+	if _, err := suite.DecodeElement(ks.GroupPublicKey); err != nil {
+		return fmt.Errorf("invalid group public key: %w", err)
+	}
+
+	sk, err := suite.DecodeScalar(ks.SigningShare)
+	if err != nil {
+		return fmt.Errorf("invalid signing share: %w", err)
+	}
+
+	for i, vs := range ks.VerifyingShares {
+		if vs.Identifier != uint32(i+1) {
+			return fmt.Errorf("%w: unexpected verifying share identifier %d", ErrInvalidParticipants, vs.Identifier)
+		}
+
+		pk, err := suite.DecodeElement(vs.VerifyingShare)
+		if err != nil {
+			return fmt.Errorf("invalid verifying share %d: %w", vs.Identifier, err)
+		}
+
+		if vs.Identifier == ks.Identifier && !pk.Equal(suite.BaseMult(sk)) {
+			return errors.New("signing share does not match its verifying share")
+		}
+	}
+
+	if ks.Identifier == 0 || ks.Identifier > uint32(len(ks.VerifyingShares)) {
+		return fmt.Errorf("%w: invalid identifier %d", ErrInvalidParticipants, ks.Identifier)
+	}
+
+	return nil
+}
+
+// Commit generates the signing nonces of the participant for a single signing
+// operation. Their commitment must be sent to the coordinator, while the nonces
+// must be kept secret and used only once.
+func Commit(share *KeyShare, rand io.Reader) (*SigningNonces, error) {
+	suite, err := getCiphersuite(share.Ciphersuite)
+	if err != nil {
+		return nil, err
+	}
+
+	sk, err := suite.DecodeScalar(share.SigningShare)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing share: %w", err)
+	}
+
+	hiding, err := generateNonce(suite, sk, rand)
+	if err != nil {
+		return nil, err
+	}
+
+	binding, err := generateNonce(suite, sk, rand)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningNonces{
+		Identifier: share.Identifier,
+		Hiding:     hiding.Bytes(),
+		Binding:    binding.Bytes(),
+		Commitment: &SigningCommitment{
+			Identifier: share.Identifier,
+			Hiding:     suite.BaseMult(hiding).Bytes(),
+			Binding:    suite.BaseMult(binding).Bytes(),
+		},
+	}, nil
+}
+
+func generateNonce(suite ciphersuite, secret scalar, rand io.Reader) (scalar, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := io.ReadFull(rand, randomBytes); err != nil {
+		return nil, err
+	}
+
+	return suite.H3(append(randomBytes, secret.Bytes()...)), nil
+}
+
+// Sign produces the signature share of the participant over msg, given its
+// signing nonces and the commitments of all the signers.
+func Sign(share *KeyShare, nonces *SigningNonces, msg []byte, commitments []*SigningCommitment) (*SignatureShare, error) {
+	pkg, err := newSigningPackage(share, msg, commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	suite := pkg.suite
+	commitment, ok := pkg.commitment(share.Identifier)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing the commitment of participant %d", ErrInvalidCommitments, share.Identifier)
+	}
+
+	sk, err := suite.DecodeScalar(share.SigningShare)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing share: %w", err)
+	}
+
+	hiding, err := suite.DecodeScalar(nonces.Hiding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hiding nonce: %w", err)
+	}
+
+	binding, err := suite.DecodeScalar(nonces.Binding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid binding nonce: %w", err)
+	}
+
+	if nonces.Identifier != share.Identifier || !suite.BaseMult(hiding).Equal(commitment.hiding) || !suite.BaseMult(binding).Equal(commitment.binding) {
+		return nil, fmt.Errorf("%w: the commitment of participant %d does not match its nonces", ErrInvalidCommitments, share.Identifier)
+	}
+
+	lambda := pkg.lagrangeCoefficient(share.Identifier)
+	z := hiding.Add(binding.Mul(pkg.bindingFactors[share.Identifier])).Add(lambda.Mul(sk).Mul(pkg.challenge))
+
+	return &SignatureShare{
+		Identifier: share.Identifier,
+		Share:      z.Bytes(),
+	}, nil
+}
+
+// Aggregate verifies the signature shares of all the signers and aggregates them
+// into the signature over msg. Only the public parts of the key share are used,
+// so that any participant can act as the coordinator.
+func Aggregate(share *KeyShare, msg []byte, commitments []*SigningCommitment, shares []*SignatureShare) ([]byte, error) {
+	pkg, err := newSigningPackage(share, msg, commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	suite := pkg.suite
+	if len(shares) != len(pkg.commitments) {
+		return nil, fmt.Errorf("%w: expected %d signature shares, got %d", ErrInvalidSignatureShare, len(pkg.commitments), len(shares))
+	}
+
+	z := suite.Scalar(0)
+	seen := make(map[uint32]bool, len(shares))
+	for _, sigShare := range shares {
+		commitment, ok := pkg.commitment(sigShare.Identifier)
+		if !ok || seen[sigShare.Identifier] {
+			return nil, fmt.Errorf("%w: unexpected signature share of participant %d", ErrInvalidSignatureShare, sigShare.Identifier)
+		}
+		seen[sigShare.Identifier] = true
+
+		zi, err := suite.DecodeScalar(sigShare.Share)
+		if err != nil {
+			return nil, fmt.Errorf("%w: participant %d: %w", ErrInvalidSignatureShare, sigShare.Identifier, err)
+		}
+
+		pk, err := verifyingShare(suite, share, sigShare.Identifier)
+		if err != nil {
+			return nil, err
+		}
+
+		// z_i * G == D_i + rho_i * E_i + (c * lambda_i) * PK_i
+		commShare := commitment.hiding.Add(commitment.binding.ScalarMult(pkg.bindingFactors[sigShare.Identifier]))
+		expected := commShare.Add(pk.ScalarMult(pkg.challenge.Mul(pkg.lagrangeCoefficient(sigShare.Identifier))))
+		if !suite.BaseMult(zi).Equal(expected) {
+			return nil, fmt.Errorf("%w: participant %d", ErrInvalidSignatureShare, sigShare.Identifier)
+		}
+
+		z = z.Add(zi)
+	}
+
+	sig := append(pkg.groupCommitment.Bytes(), z.Bytes()...)
+	if !Verify(share.Ciphersuite, share.GroupPublicKey, msg, sig) {
+		return nil, errors.New("aggregated FROST signature does not verify")
+	}
+
+	return sig, nil
+}
+
+// Verify verifies a signature of the given ciphersuite over msg.
+func Verify(ciphersuiteID string, groupPublicKey, msg, sig []byte) bool {
+	suite, err := getCiphersuite(ciphersuiteID)
+	if err != nil {
+		return false
+	}
+
+	pk, err := suite.DecodeElement(groupPublicKey)
+	if err != nil {
+		return false
+	}
+
+	if len(sig) != len(groupPublicKey)+32 {
+		return false
+	}
+
+	r, err := suite.DecodeElement(sig[:len(groupPublicKey)])
+	if err != nil {
+		return false
+	}
+
+	z, err := suite.DecodeScalar(sig[len(groupPublicKey):])
+	if err != nil {
+		return false
+	}
+
+	c := computeChallenge(suite, r, pk, msg)
+	return suite.BaseMult(z).Equal(r.Add(pk.ScalarMult(c)))
+}
+
+func computeChallenge(suite ciphersuite, groupCommitment, groupPublicKey element, msg []byte) scalar {
+	input := append(groupCommitment.Bytes(), groupPublicKey.Bytes()...)
+	return suite.H2(append(input, msg...))
+}
+
+func verifyingShare(suite ciphersuite, share *KeyShare, identifier uint32) (element, error) {
+	for _, vs := range share.VerifyingShares {
+		if vs.Identifier == identifier {
+			return suite.DecodeElement(vs.VerifyingShare)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unknown participant %d", ErrInvalidParticipants, identifier)
+}
+
+type decodedCommitment struct {
+	identifier uint32
+	hiding     element
+	binding    element
+}
+
+// signingPackage holds the values shared by the signers and the coordinator
+// for a signing operation.
+type signingPackage struct {
+	suite           ciphersuite
+	commitments     []decodedCommitment
+	bindingFactors  map[uint32]scalar
+	groupCommitment element
+	challenge       scalar
+}
+
+func newSigningPackage(share *KeyShare, msg []byte, commitments []*SigningCommitment) (*signingPackage, error) {
+	suite, err := getCiphersuite(share.Ciphersuite)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPublicKey, err := suite.DecodeElement(share.GroupPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group public key: %w", err)
+	}
+
+	if len(commitments) < int(share.MinSigners) || len(commitments) > len(share.VerifyingShares) {
+		return nil, fmt.Errorf("%w: expected between %d and %d commitments, got %d", ErrInvalidCommitments, share.MinSigners, len(share.VerifyingShares), len(commitments))
+	}
+
+	sorted := slices.Clone(commitments)
+	slices.SortFunc(sorted, func(a, b *SigningCommitment) int {
+		return int(a.Identifier) - int(b.Identifier)
+	})
+
+	pkg := &signingPackage{
+		suite:          suite,
+		commitments:    make([]decodedCommitment, len(sorted)),
+		bindingFactors: make(map[uint32]scalar, len(sorted)),
+	}
+
+	// encode_group_commitment_list
+	var encodedCommitments []byte
+	for i, c := range sorted {
+		if c.Identifier == 0 || c.Identifier > uint32(len(share.VerifyingShares)) || (i > 0 && c.Identifier == sorted[i-1].Identifier) {
+			return nil, fmt.Errorf("%w: invalid or duplicated participant %d", ErrInvalidCommitments, c.Identifier)
+		}
+
+		hiding, err := suite.DecodeElement(c.Hiding)
+		if err != nil {
+			return nil, fmt.Errorf("%w: participant %d: %w", ErrInvalidCommitments, c.Identifier, err)
+		}
+
+		binding, err := suite.DecodeElement(c.Binding)
+		if err != nil {
+			return nil, fmt.Errorf("%w: participant %d: %w", ErrInvalidCommitments, c.Identifier, err)
+		}
+
+		pkg.commitments[i] = decodedCommitment{c.Identifier, hiding, binding}
+		encodedCommitments = append(encodedCommitments, suite.Scalar(c.Identifier).Bytes()...)
+		encodedCommitments = append(encodedCommitments, hiding.Bytes()...)
+		encodedCommitments = append(encodedCommitments, binding.Bytes()...)
+	}
+
+	// compute_binding_factors
+	prefix := append(groupPublicKey.Bytes(), suite.H4(msg)...)
+	prefix = append(prefix, suite.H5(encodedCommitments)...)
+	for _, c := range pkg.commitments {
+		rhoInput := append(slices.Clone(prefix), suite.Scalar(c.identifier).Bytes()...)
+		pkg.bindingFactors[c.identifier] = suite.H1(rhoInput)
+	}
+
+	// compute_group_commitment
+	groupCommitment := suite.Identity()
+	for _, c := range pkg.commitments {
+		groupCommitment = groupCommitment.Add(c.hiding).Add(c.binding.ScalarMult(pkg.bindingFactors[c.identifier]))
+	}
+
+	if groupCommitment.IsIdentity() {
+		return nil, fmt.Errorf("%w: group commitment is the identity", ErrInvalidCommitments)
+	}
+
+	pkg.groupCommitment = groupCommitment
+	pkg.challenge = computeChallenge(suite, groupCommitment, groupPublicKey, msg)
+
+	return pkg, nil
+}
+
+func (p *signingPackage) commitment(identifier uint32) (decodedCommitment, bool) {
+	for _, c := range p.commitments {
+		if c.identifier == identifier {
+			return c, true
+		}
+	}
+
+	return decodedCommitment{}, false
+}
+
+// lagrangeCoefficient returns the Lagrange coefficient of the participant in the
+// set of signers.
+func (p *signingPackage) lagrangeCoefficient(identifier uint32) scalar {
+	identifiers := make([]uint32, len(p.commitments))
+	for i, c := range p.commitments {
+		identifiers[i] = c.identifier
+	}
+
+	return lagrangeCoefficient(p.suite, identifiers, identifier)
+}
+
+// lagrangeCoefficient implements derive_interpolating_value.
+func lagrangeCoefficient(suite ciphersuite, identifiers []uint32, identifier uint32) scalar {
+	x := suite.Scalar(identifier)
+	num, den := suite.Scalar(1), suite.Scalar(1)
+	for _, id := range identifiers {
+		if id == identifier {
+			continue
+		}
+
+		xj := suite.Scalar(id)
+		num = num.Mul(xj)
+		den = den.Mul(xj.Sub(x))
+	}
+
+	return num.Mul(den.Invert())
+}
@@ -0,0 +1,2469 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crypto/frost/v1/frost.proto
+
+package frost
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// KeyShare is the key share of a participant of a FROST threshold key.
+type KeyShare struct {
+	// ciphersuite is the FROST ciphersuite of the key.
+	Ciphersuite string `protobuf:"bytes,1,opt,name=ciphersuite,proto3" json:"ciphersuite,omitempty"`
+	// identifier is the identifier of the participant, from 1 to the number of participants.
+	Identifier uint32 `protobuf:"varint,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// min_signers is the number of participants required to sign.
+	MinSigners uint32 `protobuf:"varint,3,opt,name=min_signers,json=minSigners,proto3" json:"min_signers,omitempty"`
+	// signing_share is the secret signing share of the participant.
+	SigningShare []byte `protobuf:"bytes,4,opt,name=signing_share,json=signingShare,proto3" json:"signing_share,omitempty"`
+	// group_public_key is the public key of the threshold key.
+	GroupPublicKey []byte `protobuf:"bytes,5,opt,name=group_public_key,json=groupPublicKey,proto3" json:"group_public_key,omitempty"`
+	// verifying_shares are the public verifying shares of all the participants.
+	VerifyingShares []VerifyingShare `protobuf:"bytes,6,rep,name=verifying_shares,json=verifyingShares,proto3" json:"verifying_shares"`
+}
+
+func (m *KeyShare) Reset()         { *m = KeyShare{} }
+func (m *KeyShare) String() string { return proto.CompactTextString(m) }
+func (*KeyShare) ProtoMessage()    {}
+func (*KeyShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{0}
+}
+func (m *KeyShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyShare.Merge(m, src)
+}
+func (m *KeyShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyShare proto.InternalMessageInfo
+
+// VerifyingShare is the public verifying share of a participant.
+type VerifyingShare struct {
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// verifying_share is the public key of the participant signing share.
+	VerifyingShare []byte `protobuf:"bytes,2,opt,name=verifying_share,json=verifyingShare,proto3" json:"verifying_share,omitempty"`
+}
+
+func (m *VerifyingShare) Reset()         { *m = VerifyingShare{} }
+func (m *VerifyingShare) String() string { return proto.CompactTextString(m) }
+func (*VerifyingShare) ProtoMessage()    {}
+func (*VerifyingShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{1}
+}
+func (m *VerifyingShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyingShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyingShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyingShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyingShare.Merge(m, src)
+}
+func (m *VerifyingShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyingShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyingShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyingShare proto.InternalMessageInfo
+
+// DKGSecretPackage is the secret state kept by a participant between the rounds
+// of the distributed key generation.
+type DKGSecretPackage struct {
+	// ciphersuite is the FROST ciphersuite of the key.
+	Ciphersuite string `protobuf:"bytes,1,opt,name=ciphersuite,proto3" json:"ciphersuite,omitempty"`
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// min_signers is the number of participants required to sign.
+	MinSigners uint32 `protobuf:"varint,3,opt,name=min_signers,json=minSigners,proto3" json:"min_signers,omitempty"`
+	// max_signers is the number of participants.
+	MaxSigners uint32 `protobuf:"varint,4,opt,name=max_signers,json=maxSigners,proto3" json:"max_signers,omitempty"`
+	// coefficients are the coefficients of the secret polynomial of the participant.
+	Coefficients [][]byte `protobuf:"bytes,5,rep,name=coefficients,proto3" json:"coefficients,omitempty"`
+}
+
+func (m *DKGSecretPackage) Reset()         { *m = DKGSecretPackage{} }
+func (m *DKGSecretPackage) String() string { return proto.CompactTextString(m) }
+func (*DKGSecretPackage) ProtoMessage()    {}
+func (*DKGSecretPackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{2}
+}
+func (m *DKGSecretPackage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGSecretPackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGSecretPackage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKGSecretPackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGSecretPackage.Merge(m, src)
+}
+func (m *DKGSecretPackage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGSecretPackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGSecretPackage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGSecretPackage proto.InternalMessageInfo
+
+// DKGRound1Package is the package broadcast by a participant to all the other
+// participants in the first round of the distributed key generation.
+type DKGRound1Package struct {
+	// ciphersuite is the FROST ciphersuite of the key.
+	Ciphersuite string `protobuf:"bytes,1,opt,name=ciphersuite,proto3" json:"ciphersuite,omitempty"`
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// commitment is the commitment to the coefficients of the secret polynomial of the participant.
+	Commitment [][]byte `protobuf:"bytes,3,rep,name=commitment,proto3" json:"commitment,omitempty"`
+	// proof_of_knowledge is the proof of knowledge of the secret of the participant.
+	ProofOfKnowledge []byte `protobuf:"bytes,4,opt,name=proof_of_knowledge,json=proofOfKnowledge,proto3" json:"proof_of_knowledge,omitempty"`
+}
+
+func (m *DKGRound1Package) Reset()         { *m = DKGRound1Package{} }
+func (m *DKGRound1Package) String() string { return proto.CompactTextString(m) }
+func (*DKGRound1Package) ProtoMessage()    {}
+func (*DKGRound1Package) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{3}
+}
+func (m *DKGRound1Package) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGRound1Package) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGRound1Package.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKGRound1Package) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGRound1Package.Merge(m, src)
+}
+func (m *DKGRound1Package) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGRound1Package) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGRound1Package.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGRound1Package proto.InternalMessageInfo
+
+// DKGRound2Package is the package sent by a participant to another participant
+// in the second round of the distributed key generation. It contains a secret
+// share, and must be sent over a confidential channel.
+type DKGRound2Package struct {
+	// sender is the identifier of the sending participant.
+	Sender uint32 `protobuf:"varint,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the identifier of the receiving participant.
+	Recipient uint32 `protobuf:"varint,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// signing_share is the secret share of the recipient computed by the sender.
+	SigningShare []byte `protobuf:"bytes,3,opt,name=signing_share,json=signingShare,proto3" json:"signing_share,omitempty"`
+}
+
+func (m *DKGRound2Package) Reset()         { *m = DKGRound2Package{} }
+func (m *DKGRound2Package) String() string { return proto.CompactTextString(m) }
+func (*DKGRound2Package) ProtoMessage()    {}
+func (*DKGRound2Package) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{4}
+}
+func (m *DKGRound2Package) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DKGRound2Package) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DKGRound2Package.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DKGRound2Package) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DKGRound2Package.Merge(m, src)
+}
+func (m *DKGRound2Package) XXX_Size() int {
+	return m.Size()
+}
+func (m *DKGRound2Package) XXX_DiscardUnknown() {
+	xxx_messageInfo_DKGRound2Package.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DKGRound2Package proto.InternalMessageInfo
+
+// SigningNonces are the secret nonces generated by a participant for a single
+// signing operation. They must never be reused.
+type SigningNonces struct {
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// hiding is the hiding nonce.
+	Hiding []byte `protobuf:"bytes,2,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	// binding is the binding nonce.
+	Binding []byte `protobuf:"bytes,3,opt,name=binding,proto3" json:"binding,omitempty"`
+	// commitment is the public commitment to the nonces.
+	Commitment *SigningCommitment `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *SigningNonces) Reset()         { *m = SigningNonces{} }
+func (m *SigningNonces) String() string { return proto.CompactTextString(m) }
+func (*SigningNonces) ProtoMessage()    {}
+func (*SigningNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{5}
+}
+func (m *SigningNonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningNonces.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningNonces.Merge(m, src)
+}
+func (m *SigningNonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningNonces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningNonces proto.InternalMessageInfo
+
+// SigningCommitment is the public commitment of a participant to its signing nonces.
+type SigningCommitment struct {
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// hiding is the commitment to the hiding nonce.
+	Hiding []byte `protobuf:"bytes,2,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	// binding is the commitment to the binding nonce.
+	Binding []byte `protobuf:"bytes,3,opt,name=binding,proto3" json:"binding,omitempty"`
+}
+
+func (m *SigningCommitment) Reset()         { *m = SigningCommitment{} }
+func (m *SigningCommitment) String() string { return proto.CompactTextString(m) }
+func (*SigningCommitment) ProtoMessage()    {}
+func (*SigningCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{6}
+}
+func (m *SigningCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningCommitment.Merge(m, src)
+}
+func (m *SigningCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningCommitment proto.InternalMessageInfo
+
+// SignatureShare is the signature share produced by a participant.
+type SignatureShare struct {
+	// identifier is the identifier of the participant.
+	Identifier uint32 `protobuf:"varint,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// share is the signature share.
+	Share []byte `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (m *SignatureShare) Reset()         { *m = SignatureShare{} }
+func (m *SignatureShare) String() string { return proto.CompactTextString(m) }
+func (*SignatureShare) ProtoMessage()    {}
+func (*SignatureShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{7}
+}
+func (m *SignatureShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignatureShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignatureShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignatureShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureShare.Merge(m, src)
+}
+func (m *SignatureShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignatureShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureShare proto.InternalMessageInfo
+
+// SchnorrPubKey is the group public key of a FROST(secp256k1, SHA-256) threshold
+// key. Its signatures are FROST Schnorr signatures, which are not verifiable by a
+// secp256k1 public key, hence it has its own type and address.
+type SchnorrPubKey struct {
+	// key is the compressed group public key.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *SchnorrPubKey) Reset()      { *m = SchnorrPubKey{} }
+func (*SchnorrPubKey) ProtoMessage() {}
+func (*SchnorrPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39f7c36b7b4abbf6, []int{8}
+}
+func (m *SchnorrPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchnorrPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchnorrPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchnorrPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchnorrPubKey.Merge(m, src)
+}
+func (m *SchnorrPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchnorrPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchnorrPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchnorrPubKey proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*KeyShare)(nil), "cosmos.crypto.frost.v1.KeyShare")
+	proto.RegisterType((*VerifyingShare)(nil), "cosmos.crypto.frost.v1.VerifyingShare")
+	proto.RegisterType((*DKGSecretPackage)(nil), "cosmos.crypto.frost.v1.DKGSecretPackage")
+	proto.RegisterType((*DKGRound1Package)(nil), "cosmos.crypto.frost.v1.DKGRound1Package")
+	proto.RegisterType((*DKGRound2Package)(nil), "cosmos.crypto.frost.v1.DKGRound2Package")
+	proto.RegisterType((*SigningNonces)(nil), "cosmos.crypto.frost.v1.SigningNonces")
+	proto.RegisterType((*SigningCommitment)(nil), "cosmos.crypto.frost.v1.SigningCommitment")
+	proto.RegisterType((*SignatureShare)(nil), "cosmos.crypto.frost.v1.SignatureShare")
+	proto.RegisterType((*SchnorrPubKey)(nil), "cosmos.crypto.frost.v1.SchnorrPubKey")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/crypto/frost/v1/frost.proto", fileDescriptor_39f7c36b7b4abbf6)
+}
+
+var fileDescriptor_39f7c36b7b4abbf6 = []byte{
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x9b, 0x34, 0xbf, 0x5f, 0x27, 0x7f, 0x1a, 0xac, 0xaa, 0xb2, 0x10, 0x72, 0x2d, 0x23,
+	0xd1, 0x54, 0x02, 0x47, 0x2d, 0x37, 0x8e, 0x05, 0x51, 0xa1, 0x48, 0x50, 0x39, 0x12, 0x08, 0x2e,
+	0x96, 0xb3, 0x5e, 0x3b, 0xab, 0xd4, 0xbb, 0xd6, 0xae, 0x1d, 0x9a, 0xb7, 0xe0, 0x82, 0xc4, 0x11,
+	0x71, 0xe6, 0x09, 0x78, 0x82, 0x1e, 0x7b, 0xe4, 0x84, 0xa0, 0x7d, 0x11, 0xe4, 0x5d, 0x3b, 0x75,
+	0x1a, 0x10, 0x1c, 0x2a, 0x4e, 0xde, 0xf9, 0xe6, 0xf3, 0xce, 0x37, 0xb3, 0x33, 0x03, 0x36, 0x62,
+	0x22, 0x66, 0x62, 0x80, 0xf8, 0x3c, 0x49, 0xd9, 0x20, 0xe4, 0x4c, 0xa4, 0x83, 0xd9, 0xbe, 0x3a,
+	0x38, 0x09, 0x67, 0x29, 0xd3, 0xb7, 0x15, 0xc7, 0x51, 0x1c, 0x47, 0xb9, 0x66, 0xfb, 0xb7, 0xb7,
+	0x22, 0x16, 0x31, 0x49, 0x19, 0xe4, 0x27, 0xc5, 0xb6, 0xdf, 0xaf, 0xc1, 0xff, 0x43, 0x3c, 0x1f,
+	0x4d, 0x7c, 0x8e, 0x75, 0x0b, 0x5a, 0x88, 0x24, 0x13, 0xcc, 0x45, 0x46, 0x52, 0x6c, 0x68, 0x96,
+	0xd6, 0xdf, 0x70, 0xab, 0x90, 0x6e, 0x02, 0x90, 0x00, 0xd3, 0x94, 0x84, 0x04, 0x73, 0x63, 0xcd,
+	0xd2, 0xfa, 0x1d, 0xb7, 0x82, 0xe8, 0x3b, 0xd0, 0x8a, 0x09, 0xf5, 0x04, 0x89, 0x28, 0xe6, 0xc2,
+	0xa8, 0x2b, 0x42, 0x4c, 0xe8, 0x48, 0x21, 0xfa, 0x5d, 0xe8, 0xe4, 0x4e, 0x42, 0x23, 0x4f, 0xe4,
+	0x31, 0x8d, 0x86, 0xa5, 0xf5, 0xdb, 0x6e, 0xbb, 0x00, 0x95, 0x8e, 0x3e, 0xf4, 0x22, 0xce, 0xb2,
+	0xc4, 0x4b, 0xb2, 0xf1, 0x09, 0x41, 0xde, 0x14, 0xcf, 0x8d, 0x75, 0xc9, 0xeb, 0x4a, 0xfc, 0x58,
+	0xc2, 0x43, 0x3c, 0xd7, 0x5f, 0x41, 0x6f, 0x86, 0x39, 0x09, 0xe7, 0x8b, 0x0b, 0x85, 0xd1, 0xb4,
+	0xea, 0xfd, 0xd6, 0xc1, 0x3d, 0xe7, 0xd7, 0x75, 0x70, 0x5e, 0x96, 0x7c, 0x19, 0xeb, 0xb0, 0x71,
+	0xf6, 0x6d, 0xa7, 0xe6, 0x6e, 0xce, 0x96, 0x50, 0x61, 0xbf, 0x86, 0xee, 0x32, 0xf1, 0x5a, 0xea,
+	0xda, 0x4a, 0xea, 0xbb, 0xb0, 0x79, 0x4d, 0x8a, 0xac, 0x4f, 0xdb, 0xed, 0x2e, 0xdf, 0x6d, 0x7f,
+	0xd1, 0xa0, 0xf7, 0x64, 0x78, 0x34, 0xc2, 0x88, 0xe3, 0xf4, 0xd8, 0x47, 0x53, 0x3f, 0xfa, 0x27,
+	0xa5, 0xcf, 0x09, 0xfe, 0xe9, 0x82, 0xd0, 0x28, 0x08, 0xfe, 0x69, 0x49, 0xb0, 0xa1, 0x8d, 0x18,
+	0x0e, 0x43, 0x82, 0x08, 0xa6, 0xa9, 0x30, 0xd6, 0xad, 0x7a, 0xfe, 0x34, 0x55, 0xcc, 0xfe, 0xa4,
+	0xc4, 0xbb, 0x2c, 0xa3, 0xc1, 0xfe, 0xcd, 0x89, 0x37, 0x01, 0x10, 0x8b, 0x63, 0x92, 0xc6, 0x98,
+	0xa6, 0x46, 0x5d, 0x06, 0xae, 0x20, 0xfa, 0x7d, 0xd0, 0x13, 0xce, 0x58, 0xe8, 0xb1, 0xd0, 0x9b,
+	0x52, 0xf6, 0xf6, 0x04, 0x07, 0x51, 0xd9, 0x3b, 0x3d, 0xe9, 0x79, 0x11, 0x0e, 0x4b, 0xdc, 0x8e,
+	0xaf, 0x34, 0x1e, 0x94, 0x1a, 0xb7, 0xa1, 0x29, 0x30, 0x0d, 0x16, 0x4f, 0x57, 0x58, 0xfa, 0x1d,
+	0xd8, 0xe0, 0x18, 0x91, 0x24, 0x4f, 0xaf, 0x10, 0x76, 0x05, 0xac, 0xb6, 0x6b, 0x7d, 0xb5, 0x5d,
+	0xed, 0xcf, 0x1a, 0x74, 0x46, 0x0a, 0x78, 0xce, 0x28, 0xc2, 0xe2, 0x8f, 0xbd, 0xb2, 0x0d, 0xcd,
+	0x09, 0x09, 0x08, 0x8d, 0x8a, 0x16, 0x29, 0x2c, 0xdd, 0x80, 0xff, 0xc6, 0x84, 0x4a, 0x87, 0x0a,
+	0x54, 0x9a, 0xfa, 0xb3, 0xa5, 0x02, 0xe5, 0x89, 0xb7, 0x0e, 0xf6, 0x7e, 0xd7, 0xe2, 0x85, 0x98,
+	0xc7, 0x8b, 0x1f, 0xaa, 0xb5, 0xb4, 0x31, 0xdc, 0x5a, 0x21, 0xdc, 0xbc, 0x62, 0xfb, 0x29, 0x74,
+	0xf3, 0x30, 0x7e, 0x9a, 0x71, 0xfc, 0x77, 0x13, 0xb4, 0x05, 0xeb, 0xd5, 0xb9, 0x51, 0x86, 0xbd,
+	0x0b, 0x9d, 0x11, 0x9a, 0x50, 0xc6, 0xf9, 0x71, 0x36, 0xce, 0x67, 0xbe, 0x07, 0xf5, 0x7c, 0x21,
+	0x68, 0x92, 0x94, 0x1f, 0x1f, 0x35, 0x3e, 0x7c, 0xdc, 0xa9, 0x1d, 0x1e, 0x9d, 0xfd, 0x30, 0x6b,
+	0x67, 0x17, 0xa6, 0x76, 0x7e, 0x61, 0x6a, 0xdf, 0x2f, 0x4c, 0xed, 0xdd, 0xa5, 0x59, 0x3b, 0xbf,
+	0x34, 0x6b, 0x5f, 0x2f, 0xcd, 0xda, 0x9b, 0xbd, 0x88, 0xa4, 0x93, 0x6c, 0xec, 0x20, 0x16, 0x0f,
+	0xca, 0x2d, 0x2a, 0x3f, 0x0f, 0x44, 0x30, 0x5d, 0x5a, 0xa8, 0xe3, 0xa6, 0x5c, 0x8d, 0x0f, 0x7f,
+	0x0e, 0x00, 0xb3, 0x01, 0x35, 0xd0, 0x6e, 0x05, 0x00, 0x00,
+}
+
+func (m *KeyShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerifyingShares) > 0 {
+		for iNdEx := len(m.VerifyingShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifyingShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFrost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GroupPublicKey) > 0 {
+		i -= len(m.GroupPublicKey)
+		copy(dAtA[i:], m.GroupPublicKey)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.GroupPublicKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SigningShare) > 0 {
+		i -= len(m.SigningShare)
+		copy(dAtA[i:], m.SigningShare)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.SigningShare)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinSigners != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.MinSigners))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Identifier != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Identifier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ciphersuite) > 0 {
+		i -= len(m.Ciphersuite)
+		copy(dAtA[i:], m.Ciphersuite)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Ciphersuite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyingShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyingShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyingShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerifyingShare) > 0 {
+		i -= len(m.VerifyingShare)
+		copy(dAtA[i:], m.VerifyingShare)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.VerifyingShare)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Identifier != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Identifier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGSecretPackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGSecretPackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGSecretPackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coefficients) > 0 {
+		for iNdEx := len(m.Coefficients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Coefficients[iNdEx])
+			copy(dAtA[i:], m.Coefficients[iNdEx])
+			i = encodeVarintFrost(dAtA, i, uint64(len(m.Coefficients[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxSigners != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.MaxSigners))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinSigners != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.MinSigners))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Identifier != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Identifier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ciphersuite) > 0 {
+		i -= len(m.Ciphersuite)
+		copy(dAtA[i:], m.Ciphersuite)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Ciphersuite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGRound1Package) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGRound1Package) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGRound1Package) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofOfKnowledge) > 0 {
+		i -= len(m.ProofOfKnowledge)
+		copy(dAtA[i:], m.ProofOfKnowledge)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.ProofOfKnowledge)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commitment) > 0 {
+		for iNdEx := len(m.Commitment) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitment[iNdEx])
+			copy(dAtA[i:], m.Commitment[iNdEx])
+			i = encodeVarintFrost(dAtA, i, uint64(len(m.Commitment[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Identifier != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Identifier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ciphersuite) > 0 {
+		i -= len(m.Ciphersuite)
+		copy(dAtA[i:], m.Ciphersuite)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Ciphersuite)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DKGRound2Package) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DKGRound2Package) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DKGRound2Package) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigningShare) > 0 {
+		i -= len(m.SigningShare)
+		copy(dAtA[i:], m.SigningShare)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.SigningShare)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Recipient != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Recipient))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sender != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Sender))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commitment != nil {
+		{
+			size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFrost(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Binding) > 0 {
+		i -= len(m.Binding)
+		copy(dAtA[i:], m.Binding)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Binding)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hiding) > 0 {
+		i -= len(m.Hiding)
+		copy(dAtA[i:], m.Hiding)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Hiding)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Identifier != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Identifier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Binding) > 0 {
+		i -= len(m.Binding)
+		copy(dAtA[i:], m.Binding)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Binding)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hiding) > 0 {
+		i -= len(m.Hiding)
+		copy(dAtA[i:], m.Hiding)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Hiding)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Identifier != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Identifier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignatureShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Identifier != 0 {
+		i = encodeVarintFrost(dAtA, i, uint64(m.Identifier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchnorrPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchnorrPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchnorrPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintFrost(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFrost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFrost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KeyShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ciphersuite)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	if m.Identifier != 0 {
+		n += 1 + sovFrost(uint64(m.Identifier))
+	}
+	if m.MinSigners != 0 {
+		n += 1 + sovFrost(uint64(m.MinSigners))
+	}
+	l = len(m.SigningShare)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	l = len(m.GroupPublicKey)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	if len(m.VerifyingShares) > 0 {
+		for _, e := range m.VerifyingShares {
+			l = e.Size()
+			n += 1 + l + sovFrost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerifyingShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Identifier != 0 {
+		n += 1 + sovFrost(uint64(m.Identifier))
+	}
+	l = len(m.VerifyingShare)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	return n
+}
+
+func (m *DKGSecretPackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ciphersuite)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	if m.Identifier != 0 {
+		n += 1 + sovFrost(uint64(m.Identifier))
+	}
+	if m.MinSigners != 0 {
+		n += 1 + sovFrost(uint64(m.MinSigners))
+	}
+	if m.MaxSigners != 0 {
+		n += 1 + sovFrost(uint64(m.MaxSigners))
+	}
+	if len(m.Coefficients) > 0 {
+		for _, b := range m.Coefficients {
+			l = len(b)
+			n += 1 + l + sovFrost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DKGRound1Package) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ciphersuite)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	if m.Identifier != 0 {
+		n += 1 + sovFrost(uint64(m.Identifier))
+	}
+	if len(m.Commitment) > 0 {
+		for _, b := range m.Commitment {
+			l = len(b)
+			n += 1 + l + sovFrost(uint64(l))
+		}
+	}
+	l = len(m.ProofOfKnowledge)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	return n
+}
+
+func (m *DKGRound2Package) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sender != 0 {
+		n += 1 + sovFrost(uint64(m.Sender))
+	}
+	if m.Recipient != 0 {
+		n += 1 + sovFrost(uint64(m.Recipient))
+	}
+	l = len(m.SigningShare)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	return n
+}
+
+func (m *SigningNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Identifier != 0 {
+		n += 1 + sovFrost(uint64(m.Identifier))
+	}
+	l = len(m.Hiding)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	l = len(m.Binding)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	if m.Commitment != nil {
+		l = m.Commitment.Size()
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	return n
+}
+
+func (m *SigningCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Identifier != 0 {
+		n += 1 + sovFrost(uint64(m.Identifier))
+	}
+	l = len(m.Hiding)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	l = len(m.Binding)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	return n
+}
+
+func (m *SignatureShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Identifier != 0 {
+		n += 1 + sovFrost(uint64(m.Identifier))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	return n
+}
+
+func (m *SchnorrPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovFrost(uint64(l))
+	}
+	return n
+}
+
+func sovFrost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFrost(x uint64) (n int) {
+	return sovFrost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeyShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphersuite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ciphersuite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			m.Identifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Identifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSigners", wireType)
+			}
+			m.MinSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSigners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningShare = append(m.SigningShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningShare == nil {
+				m.SigningShare = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPublicKey = append(m.GroupPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPublicKey == nil {
+				m.GroupPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingShares = append(m.VerifyingShares, VerifyingShare{})
+			if err := m.VerifyingShares[len(m.VerifyingShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyingShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyingShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyingShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			m.Identifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Identifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingShare = append(m.VerifyingShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerifyingShare == nil {
+				m.VerifyingShare = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGSecretPackage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGSecretPackage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGSecretPackage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphersuite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ciphersuite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			m.Identifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Identifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSigners", wireType)
+			}
+			m.MinSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSigners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigners", wireType)
+			}
+			m.MaxSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficients", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coefficients = append(m.Coefficients, make([]byte, postIndex-iNdEx))
+			copy(m.Coefficients[len(m.Coefficients)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGRound1Package) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGRound1Package: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGRound1Package: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphersuite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ciphersuite = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			m.Identifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Identifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment, make([]byte, postIndex-iNdEx))
+			copy(m.Commitment[len(m.Commitment)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfKnowledge", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofOfKnowledge = append(m.ProofOfKnowledge[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofOfKnowledge == nil {
+				m.ProofOfKnowledge = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DKGRound2Package) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DKGRound2Package: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DKGRound2Package: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			m.Sender = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sender |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			m.Recipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipient |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningShare = append(m.SigningShare[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningShare == nil {
+				m.SigningShare = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningNonces) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningNonces: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			m.Identifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Identifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hiding = append(m.Hiding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hiding == nil {
+				m.Hiding = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binding = append(m.Binding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Binding == nil {
+				m.Binding = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commitment == nil {
+				m.Commitment = &SigningCommitment{}
+			}
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			m.Identifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Identifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hiding = append(m.Hiding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hiding == nil {
+				m.Hiding = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binding = append(m.Binding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Binding == nil {
+				m.Binding = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			m.Identifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Identifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchnorrPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchnorrPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchnorrPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFrost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFrost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFrost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFrost(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFrost
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFrost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFrost
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFrost
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFrost
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFrost        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFrost          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFrost = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,245 @@
+package frost_test
+
+import (
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+var ciphersuites = []string{frost.Ed25519, frost.Secp256k1}
+
+func runDKG(t *testing.T, ciphersuite string, minSigners, maxSigners uint32) []*frost.KeyShare {
+	t.Helper()
+
+	secrets := make([]*frost.DKGSecretPackage, maxSigners)
+	round1 := make([]*frost.DKGRound1Package, maxSigners)
+	for i := range secrets {
+		var err error
+		secrets[i], round1[i], err = frost.DKGRound1(ciphersuite, uint32(i+1), minSigners, maxSigners, rand.Reader)
+		require.NoError(t, err)
+	}
+
+	received := make([][]*frost.DKGRound2Package, maxSigners)
+	for _, secret := range secrets {
+		round2, err := frost.DKGRound2(secret, round1)
+		require.NoError(t, err)
+		require.Len(t, round2, int(maxSigners-1))
+
+		for _, pkg := range round2 {
+			received[pkg.Recipient-1] = append(received[pkg.Recipient-1], pkg)
+		}
+	}
+
+	shares := make([]*frost.KeyShare, maxSigners)
+	for i, secret := range secrets {
+		var err error
+		shares[i], err = frost.DKGFinalize(secret, round1, received[i])
+		require.NoError(t, err)
+	}
+
+	return shares
+}
+
+func sign(t *testing.T, signers []*frost.KeyShare, msg []byte) []byte {
+	t.Helper()
+
+	nonces := make([]*frost.SigningNonces, len(signers))
+	commitments := make([]*frost.SigningCommitment, len(signers))
+	for i, share := range signers {
+		var err error
+		nonces[i], err = frost.Commit(share, rand.Reader)
+		require.NoError(t, err)
+		commitments[i] = nonces[i].Commitment
+	}
+
+	sigShares := make([]*frost.SignatureShare, len(signers))
+	for i, share := range signers {
+		var err error
+		sigShares[i], err = frost.Sign(share, nonces[i], msg, commitments)
+		require.NoError(t, err)
+	}
+
+	sig, err := frost.Aggregate(signers[0], msg, commitments, sigShares)
+	require.NoError(t, err)
+
+	return sig
+}
+
+func TestDKG(t *testing.T) {
+	for _, ciphersuite := range ciphersuites {
+		t.Run(ciphersuite, func(t *testing.T) {
+			shares := runDKG(t, ciphersuite, 2, 3)
+			for _, share := range shares {
+				require.NoError(t, share.Validate())
+				require.Equal(t, shares[0].GroupPublicKey, share.GroupPublicKey)
+				require.Equal(t, shares[0].VerifyingShares, share.VerifyingShares)
+			}
+
+			msg := []byte("hello")
+			for _, signers := range [][]*frost.KeyShare{shares[:2], shares[1:], {shares[0], shares[2]}, shares} {
+				sig := sign(t, signers, msg)
+				require.True(t, frost.Verify(ciphersuite, shares[0].GroupPublicKey, msg, sig))
+				require.False(t, frost.Verify(ciphersuite, shares[0].GroupPublicKey, []byte("world"), sig))
+			}
+		})
+	}
+}
+
+func TestGenerateKeyShares(t *testing.T) {
+	for _, ciphersuite := range ciphersuites {
+		t.Run(ciphersuite, func(t *testing.T) {
+			shares, err := frost.GenerateKeyShares(ciphersuite, 3, 5, rand.Reader)
+			require.NoError(t, err)
+			require.Len(t, shares, 5)
+			for _, share := range shares {
+				require.NoError(t, share.Validate())
+			}
+
+			msg := []byte("hello")
+			sig := sign(t, []*frost.KeyShare{shares[4], shares[0], shares[2]}, msg)
+			require.True(t, frost.Verify(ciphersuite, shares[0].GroupPublicKey, msg, sig))
+
+			_, err = frost.GenerateKeyShares(ciphersuite, 1, 5, rand.Reader)
+			require.ErrorIs(t, err, frost.ErrInvalidParticipants)
+			_, err = frost.GenerateKeyShares(ciphersuite, 4, 3, rand.Reader)
+			require.ErrorIs(t, err, frost.ErrInvalidParticipants)
+		})
+	}
+
+	_, err := frost.GenerateKeyShares("unknown", 2, 3, rand.Reader)
+	require.ErrorIs(t, err, frost.ErrUnknownCiphersuite)
+}
+
+func TestEd25519Compatibility(t *testing.T) {
+	shares := runDKG(t, frost.Ed25519, 2, 3)
+	msg := []byte("hello")
+	sig := sign(t, shares[:2], msg)
+
+	require.True(t, stded25519.Verify(shares[0].GroupPublicKey, msg, sig))
+
+	pubKey, err := shares[0].PubKey()
+	require.NoError(t, err)
+	require.IsType(t, &ed25519.PubKey{}, pubKey)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestSchnorrPubKey(t *testing.T) {
+	shares := runDKG(t, frost.Secp256k1, 2, 3)
+	msg := []byte("hello")
+	sig := sign(t, shares[:2], msg)
+
+	pubKey, err := shares[0].PubKey()
+	require.NoError(t, err)
+	require.Equal(t, &frost.SchnorrPubKey{Key: shares[0].GroupPublicKey}, pubKey)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("world"), sig))
+
+	// the Schnorr signatures are not secp256k1 signatures, hence the group key
+	// has its own address
+	secp256k1PubKey := &secp256k1.PubKey{Key: shares[0].GroupPublicKey}
+	require.False(t, secp256k1PubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.Equals(secp256k1PubKey))
+	require.NotEqual(t, secp256k1PubKey.Address(), pubKey.Address())
+}
+
+func TestSignInvalid(t *testing.T) {
+	for _, ciphersuite := range ciphersuites {
+		t.Run(ciphersuite, func(t *testing.T) {
+			shares, err := frost.GenerateKeyShares(ciphersuite, 2, 3, rand.Reader)
+			require.NoError(t, err)
+
+			msg := []byte("hello")
+			nonces0, err := frost.Commit(shares[0], rand.Reader)
+			require.NoError(t, err)
+			nonces1, err := frost.Commit(shares[1], rand.Reader)
+			require.NoError(t, err)
+			commitments := []*frost.SigningCommitment{nonces0.Commitment, nonces1.Commitment}
+
+			// not enough signers
+			_, err = frost.Sign(shares[0], nonces0, msg, commitments[:1])
+			require.ErrorIs(t, err, frost.ErrInvalidCommitments)
+
+			// duplicated signer
+			_, err = frost.Sign(shares[0], nonces0, msg, []*frost.SigningCommitment{nonces0.Commitment, nonces0.Commitment})
+			require.ErrorIs(t, err, frost.ErrInvalidCommitments)
+
+			// missing commitment of the signer
+			_, err = frost.Sign(shares[2], nonces0, msg, commitments)
+			require.ErrorIs(t, err, frost.ErrInvalidCommitments)
+
+			// nonces not matching the commitment
+			_, err = frost.Sign(shares[0], nonces1, msg, commitments)
+			require.ErrorIs(t, err, frost.ErrInvalidCommitments)
+
+			share0, err := frost.Sign(shares[0], nonces0, msg, commitments)
+			require.NoError(t, err)
+			share1, err := frost.Sign(shares[1], nonces1, msg, commitments)
+			require.NoError(t, err)
+
+			// signature share over another message
+			badShare, err := frost.Sign(shares[1], nonces1, []byte("world"), commitments)
+			require.NoError(t, err)
+			_, err = frost.Aggregate(shares[2], msg, commitments, []*frost.SignatureShare{share0, badShare})
+			require.ErrorIs(t, err, frost.ErrInvalidSignatureShare)
+
+			// missing signature share
+			_, err = frost.Aggregate(shares[2], msg, commitments, []*frost.SignatureShare{share0})
+			require.ErrorIs(t, err, frost.ErrInvalidSignatureShare)
+
+			sig, err := frost.Aggregate(shares[2], msg, commitments, []*frost.SignatureShare{share1, share0})
+			require.NoError(t, err)
+			require.True(t, frost.Verify(ciphersuite, shares[2].GroupPublicKey, msg, sig))
+		})
+	}
+}
+
+func TestDKGInvalid(t *testing.T) {
+	for _, ciphersuite := range ciphersuites {
+		t.Run(ciphersuite, func(t *testing.T) {
+			secret1, round1a, err := frost.DKGRound1(ciphersuite, 1, 2, 3, rand.Reader)
+			require.NoError(t, err)
+			secret2, round1b, err := frost.DKGRound1(ciphersuite, 2, 2, 3, rand.Reader)
+			require.NoError(t, err)
+			secret3, round1c, err := frost.DKGRound1(ciphersuite, 3, 2, 3, rand.Reader)
+			require.NoError(t, err)
+
+			_, _, err = frost.DKGRound1(ciphersuite, 4, 2, 3, rand.Reader)
+			require.ErrorIs(t, err, frost.ErrInvalidParticipants)
+
+			// missing package
+			_, err = frost.DKGRound2(secret1, []*frost.DKGRound1Package{round1a, round1b})
+			require.ErrorIs(t, err, frost.ErrInvalidKeyGen)
+
+			// invalid proof of knowledge
+			forged := *round1c
+			forged.ProofOfKnowledge = round1b.ProofOfKnowledge
+			_, err = frost.DKGRound2(secret1, []*frost.DKGRound1Package{round1b, &forged})
+			require.ErrorIs(t, err, frost.ErrInvalidKeyGen)
+
+			round1 := []*frost.DKGRound1Package{round1a, round1b, round1c}
+			round2b, err := frost.DKGRound2(secret2, round1)
+			require.NoError(t, err)
+			round2c, err := frost.DKGRound2(secret3, round1)
+			require.NoError(t, err)
+
+			// signing share not matching the commitment
+			tampered := *round2b[0]
+			tampered.SigningShare = round2c[0].SigningShare
+			_, err = frost.DKGFinalize(secret1, round1, []*frost.DKGRound2Package{&tampered, round2c[0]})
+			require.ErrorIs(t, err, frost.ErrInvalidKeyGen)
+
+			// package sent to another participant
+			_, err = frost.DKGFinalize(secret1, round1, []*frost.DKGRound2Package{round2b[1], round2c[0]})
+			require.ErrorIs(t, err, frost.ErrInvalidKeyGen)
+
+			_, err = frost.DKGFinalize(secret1, round1, []*frost.DKGRound2Package{round2c[0], round2b[0]})
+			require.NoError(t, err)
+		})
+	}
+}
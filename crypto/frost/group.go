@@ -0,0 +1,58 @@
+package frost
+
+import (
+	"io"
+)
+
+// scalar is an element of the scalar field of a group. Operations never
+// modify their receiver or arguments.
+type scalar interface {
+	Add(scalar) scalar
+	Sub(scalar) scalar
+	Mul(scalar) scalar
+	Invert() scalar
+	IsZero() bool
+	Equal(scalar) bool
+	Bytes() []byte
+}
+
+// element is an element of a prime-order group. Operations never modify
+// their receiver or arguments.
+type element interface {
+	Add(element) element
+	ScalarMult(scalar) element
+	Equal(element) bool
+	IsIdentity() bool
+	Bytes() []byte
+}
+
+// ciphersuite is a FROST ciphersuite, as defined in RFC 9591.
+type ciphersuite interface {
+	// ID returns the context string of the ciphersuite.
+	ID() string
+
+	// Scalar returns the scalar of the given integer.
+	Scalar(uint32) scalar
+	// RandomScalar returns a random non-zero scalar.
+	RandomScalar(io.Reader) (scalar, error)
+	// DecodeScalar deserializes a scalar, rejecting non canonical encodings.
+	DecodeScalar([]byte) (scalar, error)
+
+	// Identity returns the identity element.
+	Identity() element
+	// BaseMult returns the generator multiplied by the given scalar.
+	BaseMult(scalar) element
+	// DecodeElement deserializes an element, rejecting the identity and
+	// elements outside of the prime-order group.
+	DecodeElement([]byte) (element, error)
+
+	// H1, H2, H3, H4 and H5 are the hash functions of the ciphersuite.
+	H1([]byte) scalar
+	H2([]byte) scalar
+	H3([]byte) scalar
+	H4([]byte) []byte
+	H5([]byte) []byte
+	// HDKG is the hash function used in the proofs of knowledge of the
+	// distributed key generation.
+	HDKG([]byte) scalar
+}
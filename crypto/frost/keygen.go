@@ -0,0 +1,345 @@
+package frost
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrInvalidKeyGen is returned when a key generation package is invalid.
+var ErrInvalidKeyGen = errors.New("invalid FROST key generation package")
+
+// GenerateKeyShares splits a new random key into maxSigners key shares, any
+// minSigners of them being able to sign. The dealer learns the secret key, and
+// must send each share privately to its participant: DKGRound1 should be used
+// instead when no party is trusted with the secret key.
+func GenerateKeyShares(ciphersuiteID string, minSigners, maxSigners uint32, rand io.Reader) ([]*KeyShare, error) {
+	suite, err := getCiphersuite(ciphersuiteID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateParticipants(minSigners, maxSigners); err != nil {
+		return nil, err
+	}
+
+	coefficients, err := randomPolynomial(suite, minSigners, rand)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment := make([]element, len(coefficients))
+	for i, a := range coefficients {
+		commitment[i] = suite.BaseMult(a)
+	}
+
+	verifyingShares := make([]VerifyingShare, maxSigners)
+	for i := range verifyingShares {
+		id := uint32(i + 1)
+		verifyingShares[i] = VerifyingShare{
+			Identifier:     id,
+			VerifyingShare: evaluateCommitment(suite, commitment, id).Bytes(),
+		}
+	}
+
+	shares := make([]*KeyShare, maxSigners)
+	for i := range shares {
+		id := uint32(i + 1)
+		shares[i] = &KeyShare{
+			Ciphersuite:     ciphersuiteID,
+			Identifier:      id,
+			MinSigners:      minSigners,
+			SigningShare:    evaluatePolynomial(suite, coefficients, id).Bytes(),
+			GroupPublicKey:  commitment[0].Bytes(),
+			VerifyingShares: verifyingShares,
+		}
+	}
+
+	return shares, nil
+}
+
+// DKGRound1 starts the distributed key generation of the participant. The
+// round 1 package must be broadcast to all the other participants, while the
+// secret package must be kept until DKGFinalize.
+func DKGRound1(ciphersuiteID string, identifier, minSigners, maxSigners uint32, rand io.Reader) (*DKGSecretPackage, *DKGRound1Package, error) {
+	suite, err := getCiphersuite(ciphersuiteID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := validateParticipants(minSigners, maxSigners); err != nil {
+		return nil, nil, err
+	}
+
+	if identifier == 0 || identifier > maxSigners {
+		return nil, nil, fmt.Errorf("%w: invalid identifier %d", ErrInvalidParticipants, identifier)
+	}
+
+	coefficients, err := randomPolynomial(suite, minSigners, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret := &DKGSecretPackage{
+		Ciphersuite:  ciphersuiteID,
+		Identifier:   identifier,
+		MinSigners:   minSigners,
+		MaxSigners:   maxSigners,
+		Coefficients: make([][]byte, len(coefficients)),
+	}
+	round1 := &DKGRound1Package{
+		Ciphersuite: ciphersuiteID,
+		Identifier:  identifier,
+		Commitment:  make([][]byte, len(coefficients)),
+	}
+	for i, a := range coefficients {
+		secret.Coefficients[i] = a.Bytes()
+		round1.Commitment[i] = suite.BaseMult(a).Bytes()
+	}
+
+	// proof of knowledge of the secret coefficient a_0
+	k, err := suite.RandomScalar(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := suite.BaseMult(k)
+	c := dkgChallenge(suite, identifier, suite.BaseMult(coefficients[0]), r)
+	round1.ProofOfKnowledge = append(r.Bytes(), k.Add(coefficients[0].Mul(c)).Bytes()...)
+
+	return secret, round1, nil
+}
+
+// DKGRound2 verifies the round 1 packages of all the other participants, and
+// returns the round 2 packages to send privately to each of them.
+func DKGRound2(secret *DKGSecretPackage, round1 []*DKGRound1Package) ([]*DKGRound2Package, error) {
+	suite, coefficients, err := decodeSecretPackage(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := verifyRound1Packages(suite, secret, round1); err != nil {
+		return nil, err
+	}
+
+	round2 := make([]*DKGRound2Package, 0, secret.MaxSigners-1)
+	for id := uint32(1); id <= secret.MaxSigners; id++ {
+		if id == secret.Identifier {
+			continue
+		}
+
+		round2 = append(round2, &DKGRound2Package{
+			Sender:       secret.Identifier,
+			Recipient:    id,
+			SigningShare: evaluatePolynomial(suite, coefficients, id).Bytes(),
+		})
+	}
+
+	return round2, nil
+}
+
+// DKGFinalize verifies the round 2 packages received by the participant, and
+// returns its key share.
+func DKGFinalize(secret *DKGSecretPackage, round1 []*DKGRound1Package, round2 []*DKGRound2Package) (*KeyShare, error) {
+	suite, coefficients, err := decodeSecretPackage(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments, err := verifyRound1Packages(suite, secret, round1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(round2) != int(secret.MaxSigners-1) {
+		return nil, fmt.Errorf("%w: expected %d round 2 packages, got %d", ErrInvalidKeyGen, secret.MaxSigners-1, len(round2))
+	}
+
+	signingShare := evaluatePolynomial(suite, coefficients, secret.Identifier)
+	received := make(map[uint32]bool, len(round2))
+	for _, pkg := range round2 {
+		commitment, ok := commitments[pkg.Sender]
+		if !ok || received[pkg.Sender] || pkg.Recipient != secret.Identifier {
+			return nil, fmt.Errorf("%w: unexpected round 2 package from participant %d to participant %d", ErrInvalidKeyGen, pkg.Sender, pkg.Recipient)
+		}
+		received[pkg.Sender] = true
+
+		share, err := suite.DecodeScalar(pkg.SigningShare)
+		if err != nil {
+			return nil, fmt.Errorf("%w: participant %d: %w", ErrInvalidKeyGen, pkg.Sender, err)
+		}
+
+		if !suite.BaseMult(share).Equal(evaluateCommitment(suite, commitment, secret.Identifier)) {
+			return nil, fmt.Errorf("%w: the signing share of participant %d does not match its commitment", ErrInvalidKeyGen, pkg.Sender)
+		}
+
+		signingShare = signingShare.Add(share)
+	}
+
+	// the group commitment is the sum of the commitments of all the participants
+	groupCommitment := make([]element, secret.MinSigners)
+	for i, a := range coefficients {
+		groupCommitment[i] = suite.BaseMult(a)
+	}
+	for _, commitment := range commitments {
+		for i, c := range commitment {
+			groupCommitment[i] = groupCommitment[i].Add(c)
+		}
+	}
+
+	verifyingShares := make([]VerifyingShare, secret.MaxSigners)
+	for i := range verifyingShares {
+		id := uint32(i + 1)
+		verifyingShares[i] = VerifyingShare{
+			Identifier:     id,
+			VerifyingShare: evaluateCommitment(suite, groupCommitment, id).Bytes(),
+		}
+	}
+
+	share := &KeyShare{
+		Ciphersuite:     secret.Ciphersuite,
+		Identifier:      secret.Identifier,
+		MinSigners:      secret.MinSigners,
+		SigningShare:    signingShare.Bytes(),
+		GroupPublicKey:  groupCommitment[0].Bytes(),
+		VerifyingShares: verifyingShares,
+	}
+
+	if err := share.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeyGen, err)
+	}
+
+	return share, nil
+}
+
+func decodeSecretPackage(secret *DKGSecretPackage) (ciphersuite, []scalar, error) {
+	suite, err := getCiphersuite(secret.Ciphersuite)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := validateParticipants(secret.MinSigners, secret.MaxSigners); err != nil {
+		return nil, nil, err
+	}
+
+	if len(secret.Coefficients) != int(secret.MinSigners) {
+		return nil, nil, fmt.Errorf("%w: expected %d coefficients, got %d", ErrInvalidKeyGen, secret.MinSigners, len(secret.Coefficients))
+	}
+
+	coefficients := make([]scalar, len(secret.Coefficients))
+	for i, bz := range secret.Coefficients {
+		if coefficients[i], err = suite.DecodeScalar(bz); err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", ErrInvalidKeyGen, err)
+		}
+	}
+
+	return suite, coefficients, nil
+}
+
+// verifyRound1Packages verifies the round 1 packages of all the other
+// participants, ignoring the one of the participant itself, and returns their
+// commitments by identifier.
+func verifyRound1Packages(suite ciphersuite, secret *DKGSecretPackage, round1 []*DKGRound1Package) (map[uint32][]element, error) {
+	commitments := make(map[uint32][]element, secret.MaxSigners-1)
+	for _, pkg := range round1 {
+		if pkg.Identifier == secret.Identifier {
+			continue
+		}
+
+		if pkg.Ciphersuite != secret.Ciphersuite {
+			return nil, fmt.Errorf("%w: participant %d uses ciphersuite %s", ErrInvalidKeyGen, pkg.Identifier, pkg.Ciphersuite)
+		}
+
+		if _, ok := commitments[pkg.Identifier]; ok || pkg.Identifier == 0 || pkg.Identifier > secret.MaxSigners {
+			return nil, fmt.Errorf("%w: invalid or duplicated participant %d", ErrInvalidKeyGen, pkg.Identifier)
+		}
+
+		if len(pkg.Commitment) != int(secret.MinSigners) {
+			return nil, fmt.Errorf("%w: participant %d: expected %d commitments, got %d", ErrInvalidKeyGen, pkg.Identifier, secret.MinSigners, len(pkg.Commitment))
+		}
+
+		commitment := make([]element, len(pkg.Commitment))
+		for i, bz := range pkg.Commitment {
+			c, err := suite.DecodeElement(bz)
+			if err != nil {
+				return nil, fmt.Errorf("%w: participant %d: %w", ErrInvalidKeyGen, pkg.Identifier, err)
+			}
+			commitment[i] = c
+		}
+
+		if !verifyProofOfKnowledge(suite, pkg.Identifier, commitment[0], pkg.ProofOfKnowledge) {
+			return nil, fmt.Errorf("%w: invalid proof of knowledge of participant %d", ErrInvalidKeyGen, pkg.Identifier)
+		}
+
+		commitments[pkg.Identifier] = commitment
+	}
+
+	if len(commitments) != int(secret.MaxSigners-1) {
+		return nil, fmt.Errorf("%w: expected %d round 1 packages, got %d", ErrInvalidKeyGen, secret.MaxSigners-1, len(commitments))
+	}
+
+	return commitments, nil
+}
+
+func verifyProofOfKnowledge(suite ciphersuite, identifier uint32, commitment element, proof []byte) bool {
+	if len(proof) <= 32 {
+		return false
+	}
+
+	r, err := suite.DecodeElement(proof[:len(proof)-32])
+	if err != nil {
+		return false
+	}
+
+	mu, err := suite.DecodeScalar(proof[len(proof)-32:])
+	if err != nil {
+		return false
+	}
+
+	// mu * G == R + c * phi_0
+	c := dkgChallenge(suite, identifier, commitment, r)
+	return suite.BaseMult(mu).Equal(r.Add(commitment.ScalarMult(c)))
+}
+
+func dkgChallenge(suite ciphersuite, identifier uint32, commitment, r element) scalar {
+	input := append(suite.Scalar(identifier).Bytes(), commitment.Bytes()...)
+	return suite.HDKG(append(input, r.Bytes()...))
+}
+
+// randomPolynomial returns the coefficients of a random polynomial of degree
+// minSigners - 1.
+func randomPolynomial(suite ciphersuite, minSigners uint32, rand io.Reader) ([]scalar, error) {
+	coefficients := make([]scalar, minSigners)
+	for i := range coefficients {
+		a, err := suite.RandomScalar(rand)
+		if err != nil {
+			return nil, err
+		}
+		coefficients[i] = a
+	}
+
+	return coefficients, nil
+}
+
+// evaluatePolynomial evaluates the polynomial at x with Horner's method.
+func evaluatePolynomial(suite ciphersuite, coefficients []scalar, x uint32) scalar {
+	xs := suite.Scalar(x)
+	value := suite.Scalar(0)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		value = value.Mul(xs).Add(coefficients[i])
+	}
+
+	return value
+}
+
+// evaluateCommitment evaluates the commitment to a polynomial at x, that is
+// returns the polynomial evaluated at x multiplied by the generator.
+func evaluateCommitment(suite ciphersuite, commitment []element, x uint32) element {
+	xs := suite.Scalar(x)
+	value := suite.Identity()
+	for i := len(commitment) - 1; i >= 0; i-- {
+		value = value.ScalarMult(xs).Add(commitment[i])
+	}
+
+	return value
+}
@@ -0,0 +1,59 @@
+package frost
+
+import (
+	"bytes"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/registry"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const schnorrPubKeyName = "frost-secp256k1"
+
+var _ cryptotypes.PubKey = &SchnorrPubKey{}
+
+// RegisterInterfaces adds the FROST public keys to the pubkey registry.
+func RegisterInterfaces(registry registry.InterfaceRegistrar) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &SchnorrPubKey{})
+}
+
+// String implements proto.Message interface.
+func (pk *SchnorrPubKey) String() string {
+	return fmt.Sprintf("FrostSchnorrPubKey{%X}", pk.Key)
+}
+
+// Bytes implements SDK PubKey interface.
+func (pk *SchnorrPubKey) Bytes() []byte {
+	return pk.Key
+}
+
+// Equals implements SDK PubKey interface.
+func (pk *SchnorrPubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*SchnorrPubKey)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(pk.Key, pk2.Key)
+}
+
+// Address implements SDK PubKey interface. It differs from the address of the
+// secp256k1 public key of the same point, as their signatures differ.
+func (pk *SchnorrPubKey) Address() cmtcrypto.Address {
+	return address.Hash(proto.MessageName(pk), pk.Key)
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (pk *SchnorrPubKey) Type() string {
+	return schnorrPubKeyName
+}
+
+// VerifySignature implements SDK PubKey interface, verifying a FROST Schnorr
+// signature of the secp256k1 ciphersuite.
+func (pk *SchnorrPubKey) VerifySignature(msg, sig []byte) bool {
+	return Verify(Secp256k1, pk.Key, msg, sig)
+}
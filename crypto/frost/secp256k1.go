@@ -0,0 +1,215 @@
+package frost
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// Secp256k1 is the FROST(secp256k1, SHA-256) ciphersuite. Its signatures are
+// Schnorr signatures, serialized as the compressed commitment R followed by the
+// big-endian scalar z, which are not ECDSA signatures.
+const Secp256k1 = "FROST-secp256k1-SHA256-v1"
+
+type secp256k1Suite struct{}
+
+type secp256k1Scalar struct{ s *secp.ModNScalar }
+
+type secp256k1Element struct{ p *secp.JacobianPoint }
+
+func (secp256k1Suite) ID() string { return Secp256k1 }
+
+func (secp256k1Suite) Scalar(v uint32) scalar {
+	return secp256k1Scalar{new(secp.ModNScalar).SetInt(v)}
+}
+
+func (secp256k1Suite) RandomScalar(rand io.Reader) (scalar, error) {
+	var bz [32]byte
+	for {
+		if _, err := io.ReadFull(rand, bz[:]); err != nil {
+			return nil, err
+		}
+
+		s := new(secp.ModNScalar)
+		if overflow := s.SetBytes(&bz); overflow == 0 && !s.IsZero() {
+			return secp256k1Scalar{s}, nil
+		}
+	}
+}
+
+func (secp256k1Suite) DecodeScalar(bz []byte) (scalar, error) {
+	if len(bz) != 32 {
+		return nil, errors.New("invalid secp256k1 scalar")
+	}
+
+	s := new(secp.ModNScalar)
+	if overflow := s.SetByteSlice(bz); overflow {
+		return nil, errors.New("invalid secp256k1 scalar")
+	}
+
+	return secp256k1Scalar{s}, nil
+}
+
+func (secp256k1Suite) Identity() element {
+	return secp256k1Element{new(secp.JacobianPoint)}
+}
+
+func (secp256k1Suite) BaseMult(s scalar) element {
+	p := new(secp.JacobianPoint)
+	secp.ScalarBaseMultNonConst(s.(secp256k1Scalar).s, p)
+	return secp256k1Element{p}
+}
+
+func (secp256k1Suite) DecodeElement(bz []byte) (element, error) {
+	if len(bz) != secp.PubKeyBytesLenCompressed {
+		return nil, errors.New("invalid secp256k1 element")
+	}
+
+	pk, err := secp.ParsePubKey(bz)
+	if err != nil {
+		return nil, errors.New("invalid secp256k1 element")
+	}
+
+	p := new(secp.JacobianPoint)
+	pk.AsJacobian(p)
+	return secp256k1Element{p}, nil
+}
+
+// hashToScalar implements hash_to_field from RFC 9380 with expand_message_xmd
+// and SHA-256, as required by the ciphersuite.
+func (secp256k1Suite) hashToScalar(tag string, m []byte) scalar {
+	uniform := expandMessageXMD(m, []byte(Secp256k1+tag), 48)
+	v := new(big.Int).SetBytes(uniform)
+	v.Mod(v, secp.Params().N)
+
+	var bz [32]byte
+	v.FillBytes(bz[:])
+	s := new(secp.ModNScalar)
+	s.SetBytes(&bz)
+	return secp256k1Scalar{s}
+}
+
+func (secp256k1Suite) hash(tag string, m []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(Secp256k1 + tag))
+	h.Write(m)
+	return h.Sum(nil)
+}
+
+func (g secp256k1Suite) H1(m []byte) scalar { return g.hashToScalar("rho", m) }
+
+func (g secp256k1Suite) H2(m []byte) scalar { return g.hashToScalar("chal", m) }
+
+func (g secp256k1Suite) H3(m []byte) scalar { return g.hashToScalar("nonce", m) }
+
+func (g secp256k1Suite) H4(m []byte) []byte { return g.hash("msg", m) }
+
+func (g secp256k1Suite) H5(m []byte) []byte { return g.hash("com", m) }
+
+func (g secp256k1Suite) HDKG(m []byte) scalar { return g.hashToScalar("dkg", m) }
+
+// expandMessageXMD implements expand_message_xmd from RFC 9380 with SHA-256.
+func expandMessageXMD(msg, dst []byte, length int) []byte {
+	const blockSize = 64
+	ell := (length + sha256.Size - 1) / sha256.Size
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, blockSize))
+	h.Write(msg)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	out := make([]byte, 0, ell*sha256.Size)
+	bi := make([]byte, sha256.Size)
+	for i := 1; i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+
+		h.Reset()
+		h.Write(bi)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+
+	return out[:length]
+}
+
+func (s secp256k1Scalar) Add(t scalar) scalar {
+	return secp256k1Scalar{new(secp.ModNScalar).Add2(s.s, t.(secp256k1Scalar).s)}
+}
+
+func (s secp256k1Scalar) Sub(t scalar) scalar {
+	neg := new(secp.ModNScalar).NegateVal(t.(secp256k1Scalar).s)
+	return secp256k1Scalar{neg.Add(s.s)}
+}
+
+func (s secp256k1Scalar) Mul(t scalar) scalar {
+	return secp256k1Scalar{new(secp.ModNScalar).Mul2(s.s, t.(secp256k1Scalar).s)}
+}
+
+func (s secp256k1Scalar) Invert() scalar {
+	return secp256k1Scalar{new(secp.ModNScalar).InverseValNonConst(s.s)}
+}
+
+func (s secp256k1Scalar) IsZero() bool { return s.s.IsZero() }
+
+func (s secp256k1Scalar) Equal(t scalar) bool { return s.s.Equals(t.(secp256k1Scalar).s) }
+
+func (s secp256k1Scalar) Bytes() []byte {
+	bz := s.s.Bytes()
+	return bz[:]
+}
+
+func (e secp256k1Element) Add(f element) element {
+	p := new(secp.JacobianPoint)
+	secp.AddNonConst(e.p, f.(secp256k1Element).p, p)
+	return secp256k1Element{p}
+}
+
+func (e secp256k1Element) ScalarMult(s scalar) element {
+	p := new(secp.JacobianPoint)
+	secp.ScalarMultNonConst(s.(secp256k1Scalar).s, e.p, p)
+	return secp256k1Element{p}
+}
+
+func (e secp256k1Element) Equal(f element) bool {
+	a, b := e.affine(), f.(secp256k1Element).affine()
+	if a.IsIdentity() || b.IsIdentity() {
+		return a.IsIdentity() && b.IsIdentity()
+	}
+
+	return a.p.X.Equals(&b.p.X) && a.p.Y.Equals(&b.p.Y)
+}
+
+func (e secp256k1Element) IsIdentity() bool {
+	return e.p.Z.IsZero() || (e.p.X.IsZero() && e.p.Y.IsZero())
+}
+
+// Bytes returns the compressed SEC1 encoding of the element, and nil for the
+// identity which has no encoding.
+func (e secp256k1Element) Bytes() []byte {
+	if e.IsIdentity() {
+		return nil
+	}
+
+	a := e.affine()
+	return secp.NewPublicKey(&a.p.X, &a.p.Y).SerializeCompressed()
+}
+
+func (e secp256k1Element) affine() secp256k1Element {
+	p := new(secp.JacobianPoint)
+	p.Set(e.p)
+	if !e.IsIdentity() {
+		p.ToAffine()
+	}
+
+	return secp256k1Element{p}
+}
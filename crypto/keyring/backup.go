@@ -0,0 +1,287 @@
+package keyring
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ConflictPolicy defines how a restored key is handled when the keyring already
+// holds a key with the same name or address.
+type ConflictPolicy int
+
+const (
+	// ConflictPolicyFail fails the restore, without restoring any key.
+	ConflictPolicyFail ConflictPolicy = iota
+	// ConflictPolicySkip keeps the key of the keyring, the restored key is skipped.
+	ConflictPolicySkip
+	// ConflictPolicyOverwrite deletes the keys of the keyring with the same
+	// name or address, and restores the key.
+	ConflictPolicyOverwrite
+)
+
+// DecryptBackup decrypts a keyring backup exported with ExportBackup and
+// verifies its records.
+func DecryptBackup(cdc codec.Codec, armor, passphrase string) (*Backup, error) {
+	bz, err := crypto.UnarmorDecryptKeyringBackup(armor, passphrase)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decrypt keyring backup")
+	}
+
+	backup := new(Backup)
+	if err := cdc.Unmarshal(bz, backup); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unmarshal keyring backup")
+	}
+
+	if err := backup.Validate(); err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+// Validate verifies that the records of the backup are well-formed and
+// consistent, and that no two records have the same name or address.
+func (b *Backup) Validate() error {
+	names := make(map[string]bool, len(b.Records))
+	addrs := make(map[string]bool, len(b.Records))
+	for _, k := range b.Records {
+		if err := validateRecord(k); err != nil {
+			return errorsmod.Wrapf(err, "invalid record %s", k.Name)
+		}
+
+		addr, err := k.GetAddress()
+		if err != nil {
+			return err
+		}
+		if names[k.Name] {
+			return fmt.Errorf("duplicate record name %s", k.Name)
+		}
+		if addrs[string(addr)] {
+			return errorsmod.Wrap(ErrDuplicatedAddress, k.Name)
+		}
+		names[k.Name] = true
+		addrs[string(addr)] = true
+	}
+
+	return nil
+}
+
+// Select returns the records of the backup with the given names, or all the
+// records if no name is given. A name given several times is selected once.
+func (b *Backup) Select(uids []string) ([]*Record, error) {
+	if len(uids) == 0 {
+		return b.Records, nil
+	}
+
+	records := make([]*Record, 0, len(uids))
+	for _, uid := range dedupNames(uids) {
+		i := 0
+		for i < len(b.Records) && b.Records[i].Name != uid {
+			i++
+		}
+		if i == len(b.Records) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "key %s not found in backup", uid)
+		}
+		records = append(records, b.Records[i])
+	}
+
+	return records, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (b *Backup) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for _, k := range b.Records {
+		if err := k.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateRecord checks that the item of a record is consistent with its
+// public key.
+func validateRecord(k *Record) error {
+	if k.Name == "" {
+		return errors.New("empty name")
+	}
+
+	pk, err := k.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case k.GetLocal() != nil:
+		priv, err := extractPrivKeyFromLocal(k.GetLocal())
+		if err != nil {
+			return err
+		}
+		if !priv.PubKey().Equals(pk) {
+			return errors.New("private key does not match the public key")
+		}
+	case k.GetLedger() != nil:
+		if k.GetLedger().Path == nil {
+			return errors.New("missing ledger derivation path")
+		}
+	case k.GetThreshold() != nil:
+		share := k.GetThreshold().Share
+		if err := share.Validate(); err != nil {
+			return err
+		}
+		sharePK, err := share.PubKey()
+		if err != nil {
+			return err
+		}
+		if !sharePK.Equals(pk) {
+			return errors.New("key share does not match the public key")
+		}
+	case k.GetMulti() != nil, k.GetOffline() != nil, k.GetRemote() != nil:
+	default:
+		return errors.New("unrecognized record type")
+	}
+
+	return nil
+}
+
+// ExportBackup exports the keys with the given names, or all the keys if no
+// name is given, in a passphrase-encrypted ASCII armored backup. A name given
+// several times is exported once, and the backup is validated as it would be
+// on restore, so that an exported backup can always be restored.
+func (ks keystore) ExportBackup(uids []string, passphrase string) (string, error) {
+	var records []*Record
+	if len(uids) == 0 {
+		var err error
+		records, err = ks.List()
+		if err != nil {
+			return "", err
+		}
+	}
+	for _, uid := range dedupNames(uids) {
+		k, err := ks.Key(uid)
+		if err != nil {
+			return "", err
+		}
+		records = append(records, k)
+	}
+
+	backup := &Backup{
+		CreatedAt: time.Now().UTC(),
+		Backend:   ks.backend,
+		Records:   records,
+	}
+	if err := backup.Validate(); err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.Marshal(backup)
+	if err != nil {
+		return "", errorsmod.Wrap(ErrUnableToSerialize, err.Error())
+	}
+
+	return crypto.EncryptArmorKeyringBackup(bz, passphrase), nil
+}
+
+// ImportBackup restores the keys with the given names, or all the keys if no
+// name is given, from a backup exported with ExportBackup. The conflicts with
+// the keys of the keyring are all checked before any key is restored, so that a
+// restore failing on a conflict leaves the keyring untouched. With
+// ConflictPolicyOverwrite, the conflicting keys of a restored key are deleted
+// before the key is written, so a failure to write it leaves them deleted.
+func (ks keystore) ImportBackup(armor, passphrase string, uids []string, policy ConflictPolicy) ([]*Record, error) {
+	backup, err := DecryptBackup(ks.cdc, armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := backup.Select(uids)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		restored  []*Record
+		conflicts [][]string
+	)
+	for _, k := range records {
+		names, err := ks.conflictingKeys(k)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(names) > 0 {
+			switch policy {
+			case ConflictPolicySkip:
+				continue
+			case ConflictPolicyOverwrite:
+			default:
+				return nil, errorsmod.Wrapf(ErrKeyAlreadyExists, "%s conflicts with %v", k.Name, names)
+			}
+		}
+
+		restored = append(restored, k)
+		conflicts = append(conflicts, names)
+	}
+
+	for i, k := range restored {
+		for _, name := range conflicts[i] {
+			// the key may have been deleted already, when conflicting with several restored keys
+			if err := ks.Delete(name); err != nil && !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+				return nil, err
+			}
+		}
+
+		if err := ks.writeRecord(k); err != nil {
+			return nil, err
+		}
+	}
+
+	return restored, nil
+}
+
+// dedupNames returns the names without duplicates, in their order.
+func dedupNames(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	deduped := make([]string, 0, len(names))
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			deduped = append(deduped, name)
+		}
+	}
+	return deduped
+}
+
+// conflictingKeys returns the names of the keys with the same name or address
+// as the given record.
+func (ks keystore) conflictingKeys(k *Record) ([]string, error) {
+	var names []string
+	if _, err := ks.Key(k.Name); err == nil {
+		names = append(names, k.Name)
+	} else if !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	addr, err := k.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	if other, err := ks.KeyByAddress(addr); err == nil {
+		if other.Name != k.Name {
+			names = append(names, other.Name)
+		}
+	} else if !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	return names, nil
+}
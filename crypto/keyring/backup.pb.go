@@ -0,0 +1,426 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crypto/keyring/v1/backup.proto
+
+package keyring
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Backup is a backup of the records of a keyring, which is stored encrypted
+// with a passphrase.
+type Backup struct {
+	// created_at is the time the backup was created at.
+	CreatedAt time.Time `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// backend is the backend of the backed up keyring.
+	Backend string `protobuf:"bytes,2,opt,name=backend,proto3" json:"backend,omitempty"`
+	// records are the backed up records.
+	Records []*Record `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *Backup) Reset()         { *m = Backup{} }
+func (m *Backup) String() string { return proto.CompactTextString(m) }
+func (*Backup) ProtoMessage()    {}
+func (*Backup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6e1d9fb277a744a, []int{0}
+}
+func (m *Backup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Backup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Backup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backup.Merge(m, src)
+}
+func (m *Backup) XXX_Size() int {
+	return m.Size()
+}
+func (m *Backup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backup proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Backup)(nil), "cosmos.crypto.keyring.v1.Backup")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/crypto/keyring/v1/backup.proto", fileDescriptor_b6e1d9fb277a744a)
+}
+
+var fileDescriptor_b6e1d9fb277a744a = []byte{
+	// 281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbd, 0x6a, 0xc3, 0x30,
+	0x14, 0x85, 0xad, 0x06, 0x92, 0x46, 0xd9, 0x4c, 0x07, 0xe3, 0x41, 0x31, 0x85, 0x82, 0xa1, 0x54,
+	0x22, 0xe9, 0xd6, 0xad, 0xee, 0xd4, 0xd5, 0x74, 0xea, 0x52, 0x6c, 0x59, 0x55, 0x8d, 0xeb, 0x5c,
+	0x23, 0xcb, 0x81, 0xbc, 0x45, 0xde, 0xa1, 0x2f, 0xe3, 0x31, 0x63, 0xa7, 0xfe, 0xd8, 0x2f, 0x52,
+	0x22, 0xd9, 0x4b, 0x21, 0x93, 0x74, 0xe1, 0x3b, 0xfa, 0x74, 0x2e, 0xbe, 0xe2, 0x50, 0x97, 0x50,
+	0x33, 0xae, 0x76, 0x95, 0x06, 0x56, 0x88, 0x9d, 0xca, 0x37, 0x92, 0x6d, 0x57, 0x2c, 0x4d, 0x78,
+	0xd1, 0x54, 0xb4, 0x52, 0xa0, 0xc1, 0xf5, 0x2c, 0x46, 0x2d, 0x46, 0x07, 0x8c, 0x6e, 0x57, 0xfe,
+	0x85, 0x04, 0x09, 0x06, 0x62, 0xc7, 0x9b, 0xe5, 0xfd, 0xa5, 0x04, 0x90, 0xef, 0x82, 0x99, 0x29,
+	0x6d, 0x5e, 0x99, 0xce, 0x4b, 0x51, 0xeb, 0xa4, 0x1c, 0x1e, 0xf4, 0x4f, 0x7b, 0x95, 0xe0, 0xa0,
+	0x32, 0x8b, 0x5d, 0x7e, 0x20, 0x3c, 0x8d, 0xcc, 0x47, 0xdc, 0x07, 0x8c, 0xb9, 0x12, 0x89, 0x16,
+	0xd9, 0x4b, 0xa2, 0x3d, 0x14, 0xa0, 0x70, 0xb1, 0xf6, 0xa9, 0xf5, 0xd0, 0xd1, 0x43, 0x9f, 0x46,
+	0x4f, 0x74, 0xde, 0x7e, 0x2d, 0x9d, 0xfd, 0xf7, 0x12, 0xc5, 0xf3, 0x21, 0x77, 0xaf, 0x5d, 0x0f,
+	0xcf, 0x8e, 0xbd, 0xc4, 0x26, 0xf3, 0xce, 0x02, 0x14, 0xce, 0xe3, 0x71, 0x74, 0xef, 0xf0, 0xcc,
+	0x9a, 0x6b, 0x6f, 0x12, 0x4c, 0xc2, 0xc5, 0x3a, 0xa0, 0xa7, 0x3a, 0xd3, 0xd8, 0x80, 0xf1, 0x18,
+	0x88, 0x1e, 0xdb, 0x5f, 0xe2, 0xb4, 0x1d, 0x41, 0x87, 0x8e, 0xa0, 0x9f, 0x8e, 0xa0, 0x7d, 0x4f,
+	0x9c, 0x43, 0x4f, 0x9c, 0xcf, 0x9e, 0x38, 0xcf, 0xd7, 0x32, 0xd7, 0x6f, 0x4d, 0x4a, 0x39, 0x94,
+	0x6c, 0x6c, 0x6d, 0x8e, 0x9b, 0x3a, 0x2b, 0xfe, 0x2d, 0x20, 0x9d, 0x9a, 0x26, 0xb7, 0x7f, 0x03,
+	0x00, 0x13, 0xfc, 0x3f, 0xbe, 0x98, 0x01, 0x00, 0x00,
+}
+
+func (m *Backup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBackup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Backend) > 0 {
+		i -= len(m.Backend)
+		copy(dAtA[i:], m.Backend)
+		i = encodeVarintBackup(dAtA, i, uint64(len(m.Backend)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBackup(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBackup(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBackup(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Backup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovBackup(uint64(l))
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovBackup(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovBackup(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBackup(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBackup(x uint64) (n int) {
+	return sovBackup(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Backup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBackup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBackup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBackup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBackup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBackup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBackup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBackup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBackup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBackup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBackup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBackup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBackup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBackup(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBackup
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBackup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBackup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBackup
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBackup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBackup
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBackup        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBackup          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBackup = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,148 @@
+package keyring
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// requireEqualRecords compares the serialization of the records, as the cached
+// values of their public keys differ once serialized.
+func requireEqualRecords(t *testing.T, cdc codec.Codec, expected, actual []*Record) {
+	t.Helper()
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		require.Equal(t, cdc.MustMarshal(expected[i]), cdc.MustMarshal(actual[i]))
+	}
+}
+
+func TestBackup(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	local, _, err := kr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	offlinePubKey := secp256k1.GenPrivKey().PubKey()
+	_, err = kr.SaveOfflineKey("offline", offlinePubKey)
+	require.NoError(t, err)
+	_, err = kr.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(1, []types.PubKey{offlinePubKey, secp256k1.GenPrivKey().PubKey()}))
+	require.NoError(t, err)
+	ledger, err := NewLedgerRecord("ledger", secp256k1.GenPrivKey().PubKey(), hd.NewFundraiserParams(0, sdk.CoinType, 0))
+	require.NoError(t, err)
+	require.NoError(t, kr.(keystore).writeRecord(ledger))
+	shares, err := frost.GenerateKeyShares(frost.Ed25519, 2, 3, rand.Reader)
+	require.NoError(t, err)
+	_, err = kr.SaveThresholdKey("threshold", shares[0])
+	require.NoError(t, err)
+
+	records, err := kr.List()
+	require.NoError(t, err)
+	armor, err := kr.ExportBackup(nil, "passphrase")
+	require.NoError(t, err)
+
+	// the backup is encrypted and authenticated
+	_, err = DecryptBackup(cdc, armor, "wrong")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+	backup, err := DecryptBackup(cdc, armor, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, BackendTest, backup.Backend)
+	requireEqualRecords(t, cdc, records, backup.Records)
+
+	// restore all the keys
+	restoreKr := NewInMemory(cdc)
+	restored, err := restoreKr.ImportBackup(armor, "passphrase", nil, ConflictPolicyFail)
+	require.NoError(t, err)
+	require.Len(t, restored, 5)
+	restoredRecords, err := restoreKr.List()
+	require.NoError(t, err)
+	requireEqualRecords(t, cdc, records, restoredRecords)
+
+	_, _, err = restoreKr.Sign("local", []byte("msg"), 0)
+	require.NoError(t, err)
+
+	// export and restore some of the keys
+	armor, err = kr.ExportBackup([]string{"local", "ledger", "local"}, "passphrase")
+	require.NoError(t, err)
+	_, err = kr.ExportBackup([]string{"unknown"}, "passphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// names given several times are backed up and restored once
+	backup, err = DecryptBackup(cdc, armor, "passphrase")
+	require.NoError(t, err)
+	requireEqualRecords(t, cdc, []*Record{local, ledger}, backup.Records)
+
+	restoreKr = NewInMemory(cdc)
+	_, err = restoreKr.ImportBackup(armor, "passphrase", []string{"offline"}, ConflictPolicyFail)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	restored, err = restoreKr.ImportBackup(armor, "passphrase", []string{"ledger", "ledger"}, ConflictPolicyFail)
+	require.NoError(t, err)
+	requireEqualRecords(t, cdc, []*Record{ledger}, restored)
+	restoredRecords, err = restoreKr.List()
+	require.NoError(t, err)
+	requireEqualRecords(t, cdc, []*Record{ledger}, restoredRecords)
+
+	// the restored ledger key conflicts with the existing one
+	_, err = restoreKr.ImportBackup(armor, "passphrase", nil, ConflictPolicyFail)
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+	_, err = restoreKr.Key("local")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound, "a failed restore must not restore any key")
+
+	restored, err = restoreKr.ImportBackup(armor, "passphrase", nil, ConflictPolicySkip)
+	require.NoError(t, err)
+	requireEqualRecords(t, cdc, []*Record{local}, restored)
+}
+
+func TestBackupOverwrite(t *testing.T) {
+	cdc := getCodec()
+	kr := NewInMemory(cdc)
+	k, _, err := kr.NewMnemonic("key", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	armor, err := kr.ExportBackup(nil, "passphrase")
+	require.NoError(t, err)
+
+	// keys with the same name and with the same address
+	restoreKr := NewInMemory(cdc)
+	_, _, err = restoreKr.NewMnemonic("key", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	_, err = restoreKr.SaveOfflineKey("other", pubKey)
+	require.NoError(t, err)
+
+	restored, err := restoreKr.ImportBackup(armor, "passphrase", nil, ConflictPolicyOverwrite)
+	require.NoError(t, err)
+	requireEqualRecords(t, cdc, []*Record{k}, restored)
+
+	records, err := restoreKr.List()
+	require.NoError(t, err)
+	requireEqualRecords(t, cdc, []*Record{k}, records)
+}
+
+func TestBackupValidate(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	local, err := NewLocalRecord("local", priv, priv.PubKey())
+	require.NoError(t, err)
+	mismatch, err := NewLocalRecord("mismatch", priv, secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	offline, err := NewOfflineRecord("offline", priv.PubKey())
+	require.NoError(t, err)
+	ledger, err := NewLedgerRecord("ledger", secp256k1.GenPrivKey().PubKey(), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, (&Backup{Records: []*Record{local}}).Validate())
+	require.ErrorContains(t, (&Backup{Records: []*Record{mismatch}}).Validate(), "does not match the public key")
+	require.ErrorContains(t, (&Backup{Records: []*Record{ledger}}).Validate(), "missing ledger derivation path")
+	require.ErrorIs(t, (&Backup{Records: []*Record{local, offline}}).Validate(), ErrDuplicatedAddress)
+	require.ErrorContains(t, (&Backup{Records: []*Record{offline, offline}}).Validate(), "duplicate record name")
+}
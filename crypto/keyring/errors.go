@@ -35,4 +35,12 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrRemoteSignerAddress is raised when the remote backend is used without a remote signer address.
+	ErrRemoteSignerAddress = errors.New("remote signer address is not set")
+	// ErrRemoteUnsupported is raised when trying an operation the remote backend does not support.
+	ErrRemoteUnsupported = errors.New("operation not supported by the remote keyring backend, keys are managed by the remote signer")
+	// ErrSignModeNotAllowed is raised when a remote signer policy does not allow a key to sign with a sign mode.
+	ErrSignModeNotAllowed = errors.New("sign mode not allowed by the key policy")
+	// ErrThresholdSign is raised when trying to sign with a threshold record, whose signatures are produced with the FROST signing rounds.
+	ErrThresholdSign = errors.New("cannot sign with threshold keys, use the FROST signing rounds")
 )
@@ -20,7 +20,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,6 +38,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -56,7 +59,7 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory", "remote".
 	Backend() string
 
 	// DB get the db keyring used in the keystore.
@@ -100,6 +103,9 @@ type Keyring interface {
 	// SaveMultisig stores and returns a new multsig (offline) key reference.
 	SaveMultisig(uid string, pubkey types.PubKey) (*Record, error)
 
+	// SaveThresholdKey stores a FROST key share, under the group public key.
+	SaveThresholdKey(uid string, share *frost.KeyShare) (*Record, error)
+
 	Signer
 
 	Importer
@@ -125,6 +131,9 @@ type Importer interface {
 	ImportPrivKeyHex(uid, privKey, algoStr string) error
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid, armor string) error
+	// ImportBackup restores the keys of a passphrase-encrypted backup, all of
+	// them or the ones with the given names, and returns the restored records.
+	ImportBackup(armor, passphrase string, uids []string, policy ConflictPolicy) ([]*Record, error)
 }
 
 // Migrator is implemented by key stores and enables migration of keys from amino to proto
@@ -142,6 +151,10 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address []byte, encryptPassphrase string) (armor string, err error)
+
+	// ExportBackup returns a passphrase-encrypted backup, in ASCII armored format,
+	// of the records of all the keys or of the ones with the given names.
+	ExportBackup(uids []string, passphrase string) (armor string, err error)
 }
 
 // Option overrides keyring configuration options.
@@ -162,7 +175,7 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func newKeyringGeneric(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -174,6 +187,8 @@ func newKeyringGeneric(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
+	case BackendRemote:
+		return NewRemote(cdc, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -400,7 +415,7 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 			return nil, nil, err
 		}
 
-		sig, err := priv.Sign(msg)
+		sig, err := signWithPrivKey(priv, msg, signMode)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -410,6 +425,13 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetThreshold() != nil:
+		pub, err := k.GetPubKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, pub, ErrThresholdSign
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -461,6 +483,19 @@ func (ks keystore) SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, err
 	return ks.writeOfflineKey(uid, pubkey)
 }
 
+func (ks keystore) SaveThresholdKey(uid string, share *frost.KeyShare) (*Record, error) {
+	if err := share.Validate(); err != nil {
+		return nil, err
+	}
+
+	k, err := NewThresholdRecord(uid, share)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
 func (ks keystore) DeleteByAddress(address []byte) error {
 	k, err := ks.KeyByAddress(address)
 	if err != nil {
@@ -635,6 +670,21 @@ func (ks keystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
 	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
 }
 
+// signWithPrivKey signs a binary message with a local private key.
+// SIGN_MODE_EIP_191 signatures are Ethereum signatures, which require a secp256k1 key.
+func signWithPrivKey(priv types.PrivKey, msg []byte, signMode signing.SignMode) ([]byte, error) {
+	if signMode != signing.SignMode_SIGN_MODE_EIP_191 {
+		return priv.Sign(msg)
+	}
+
+	secp256k1Priv, ok := priv.(*secp256k1.PrivKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidSignMode, "%v requires a secp256k1 key, got %s", signMode, priv.Type())
+	}
+
+	return secp256k1Priv.SignEthereum(msg)
+}
+
 // SignWithLedger signs a binary message with the ledger device referenced by an Info object
 // and returns the signed bytes and the public key. It returns an error if the device could
 // not be queried or it returned an error.
@@ -8,6 +8,7 @@ import (
 	"io"
 
 	"github.com/99designs/keyring"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
@@ -40,6 +41,12 @@ type Options struct {
 	LedgerSigSkipDERConv bool
 	// KeyctlScope defines the scope of the keyctl's keyring.
 	KeyctlScope string
+	// RemoteSignerAddress is the address of the remote signer used by the remote backend,
+	// either a host:port address or a unix:// socket path.
+	RemoteSignerAddress string
+	// RemoteSignerDialOptions are the gRPC dial options used to connect to the remote signer.
+	// Insecure transport credentials are used if no dial option is set.
+	RemoteSignerDialOptions []grpc.DialOption
 }
 
 func newKeyctlBackendConfig(appName, _ string, _ io.Reader, opts ...Option) keyring.Config {
@@ -60,7 +67,7 @@ func newKeyctlBackendConfig(appName, _ string, _ io.Reader, opts ...Option) keyr
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "keyctl".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote", "keyctl".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
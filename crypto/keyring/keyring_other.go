@@ -6,6 +6,8 @@ package keyring
 import (
 	"io"
 
+	"google.golang.org/grpc"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
@@ -26,6 +28,12 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// RemoteSignerAddress is the address of the remote signer used by the remote backend,
+	// either a host:port address or a unix:// socket path.
+	RemoteSignerAddress string
+	// RemoteSignerDialOptions are the gRPC dial options used to connect to the remote signer.
+	// Insecure transport credentials are used if no dial option is set.
+	RemoteSignerDialOptions []grpc.DialOption
 }
 
 func New(
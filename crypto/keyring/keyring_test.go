@@ -1,6 +1,7 @@
 package keyring
 
 import (
+	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cosmosbcrypt "github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -1834,6 +1836,48 @@ func TestAltKeyring_SaveMultisig(t *testing.T) {
 	}
 }
 
+func TestAltKeyring_SaveThresholdKey(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	shares, err := frost.GenerateKeyShares(frost.Ed25519, 2, 3, rand.Reader)
+	require.NoError(t, err)
+
+	k, err := kr.SaveThresholdKey(someKey, shares[0])
+	require.NoError(t, err)
+	require.Equal(t, TypeThreshold, k.GetType())
+
+	k, err = kr.Key(someKey)
+	require.NoError(t, err)
+	require.Equal(t, shares[0], k.GetThreshold().Share)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, &ed25519.PubKey{Key: shares[0].GroupPublicKey}, pubKey)
+
+	_, _, err = kr.Sign(someKey, []byte("msg"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrThresholdSign)
+
+	invalid := *shares[1]
+	invalid.SigningShare = shares[0].SigningShare
+	_, err = kr.SaveThresholdKey(otherID, &invalid)
+	require.Error(t, err)
+
+	// secp256k1 shares are stored under a Schnorr public key, with its own address
+	shares, err = frost.GenerateKeyShares(frost.Secp256k1, 2, 3, rand.Reader)
+	require.NoError(t, err)
+	_, err = kr.SaveThresholdKey(otherID, shares[0])
+	require.NoError(t, err)
+	k, err = kr.Key(otherID)
+	require.NoError(t, err)
+	pubKey, err = k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, &frost.SchnorrPubKey{Key: shares[0].GroupPublicKey}, pubKey)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.NotEqual(t, sdk.AccAddress((&secp256k1.PubKey{Key: shares[0].GroupPublicKey}).Address()), addr)
+}
+
 // TODO: add more tests
 func TestAltKeyring_Sign(t *testing.T) {
 	cdc := getCodec()
@@ -1868,6 +1912,23 @@ func TestAltKeyring_Sign(t *testing.T) {
 	}
 }
 
+func TestAltKeyring_SignEIP191(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	msg := []byte("some message")
+
+	_, _, err = kr.NewMnemonic("secp256k1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	sign, key, err := kr.Sign("secp256k1", msg, signing.SignMode_SIGN_MODE_EIP_191)
+	require.NoError(t, err)
+	require.Len(t, sign, secp256k1.EthereumSignatureSize)
+	require.True(t, key.(*secp256k1.PubKey).VerifyEthereumSignature(msg, sign))
+	require.False(t, key.VerifySignature(msg, sign))
+}
+
 func TestAltKeyring_SignByAddress(t *testing.T) {
 	cdc := getCodec()
 	tests := []struct {
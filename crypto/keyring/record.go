@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -69,6 +70,25 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordMultiItem)
 }
 
+// NewRemoteRecord creates a new Record with remote item
+func NewRemoteRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
+	recordRemote := &Record_Remote{}
+	recordRemoteItem := &Record_Remote_{recordRemote}
+	return newRecord(name, pk, recordRemoteItem)
+}
+
+// NewThresholdRecord creates a new Record with threshold item, holding a FROST key share
+func NewThresholdRecord(name string, share *frost.KeyShare) (*Record, error) {
+	pk, err := share.PubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	recordThreshold := &Record_Threshold{share}
+	recordThresholdItem := &Record_Threshold_{recordThreshold}
+	return newRecord(name, pk, recordThresholdItem)
+}
+
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
@@ -99,6 +119,10 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetRemote() != nil:
+		return TypeRemote
+	case k.GetThreshold() != nil:
+		return TypeThreshold
 	default:
 		panic("unrecognized record type")
 	}
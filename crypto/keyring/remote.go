@@ -0,0 +1,281 @@
+package keyring
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/99designs/keyring"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/frost"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ Keyring = remoteKeystore{}
+
+// remoteKeystore is a Keyring delegating signing to a remote signer implementing
+// the RemoteSigner gRPC service. Keys are managed by the remote signer, hence all
+// the operations creating, importing, deleting or exporting private keys are not
+// supported.
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+}
+
+// NewRemote creates a keyring signing with the remote signer set with the RemoteSignerAddress
+// option. The address is either a host:port address or a unix:// socket path.
+func NewRemote(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	var options Options
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSignerAddress == "" {
+		return nil, ErrRemoteSignerAddress
+	}
+
+	dialOpts := options.RemoteSignerDialOptions
+	if len(dialOpts) == 0 {
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+
+	conn, err := grpc.NewClient(options.RemoteSignerAddress, dialOpts...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to connect to remote signer %s", options.RemoteSignerAddress)
+	}
+
+	return NewRemoteWithClient(NewRemoteSignerClient(conn), cdc, opts...), nil
+}
+
+// NewRemoteWithClient creates a keyring signing with the given remote signer client.
+func NewRemoteWithClient(client RemoteSignerClient, cdc codec.Codec, opts ...Option) Keyring {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	return remoteKeystore{
+		client:  client,
+		cdc:     cdc,
+		options: options,
+	}
+}
+
+// Backend returns the keyring backend option used in the config
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+// DB returns nil as the remote backend does not store keys locally.
+func (ks remoteKeystore) DB() keyring.Keyring {
+	return nil
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	res, err := ks.client.Keys(context.Background(), &KeysRequest{})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to list remote signer keys")
+	}
+
+	records := make([]*Record, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		pk, err := ks.unpackPubKey(key.PubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		record, err := NewRemoteRecord(key.Name, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	records, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.Name == uid {
+			return record, nil
+		}
+	}
+
+	return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, uid)
+}
+
+func (ks remoteKeystore) KeyByAddress(address []byte) (*Record, error) {
+	records, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		addr, err := record.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(addr, address) {
+			return record, nil
+		}
+	}
+
+	return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "key with given address not found")
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignRequest{Name: uid, Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) SignByAddress(address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignRequest{Address: address, Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) sign(req *SignRequest) ([]byte, types.PubKey, error) {
+	res, err := ks.client.Sign(context.Background(), req)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "remote signer failed to sign")
+	}
+
+	pk, err := ks.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Signature, pk, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.exportPubKeyArmor(k)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address []byte) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.exportPubKeyArmor(k)
+}
+
+func (ks remoteKeystore) exportPubKeyArmor(k *Record) (string, error) {
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+// MigrateAll returns the keys of the remote signer, which have nothing to migrate.
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return ks.List()
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) DeleteByAddress([]byte) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveThresholdKey(string, *frost.KeyShare) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportBackup(string, string, []string, ConflictPolicy) ([]*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportBackup([]string, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress([]byte, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) unpackPubKey(any *codectypes.Any) (types.PubKey, error) {
+	var pk types.PubKey
+	if err := ks.cdc.UnpackAny(any, &pk); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid remote signer public key")
+	}
+
+	return pk, nil
+}
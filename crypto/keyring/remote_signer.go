@@ -0,0 +1,129 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// KeyPolicy restricts the use of a key exposed by a remote signer.
+type KeyPolicy struct {
+	// SignModes are the sign modes the key is allowed to sign with.
+	// All sign modes are allowed if empty.
+	SignModes []signing.SignMode
+}
+
+// Allows returns whether the policy allows to sign with the given sign mode.
+func (p KeyPolicy) Allows(signMode signing.SignMode) bool {
+	return len(p.SignModes) == 0 || slices.Contains(p.SignModes, signMode)
+}
+
+var _ RemoteSignerServer = remoteSigner{}
+
+type remoteSigner struct {
+	kr       Keyring
+	policies map[string]KeyPolicy
+}
+
+// NewRemoteSignerServer returns a RemoteSigner service signing with the local and ledger keys
+// of the given keyring. If policies is not nil, only the keys it contains are exposed, and
+// are restricted by their policy.
+func NewRemoteSignerServer(kr Keyring, policies map[string]KeyPolicy) RemoteSignerServer {
+	return remoteSigner{
+		kr:       kr,
+		policies: policies,
+	}
+}
+
+// Keys implements RemoteSignerServer.
+func (s remoteSigner) Keys(_ context.Context, _ *KeysRequest) (*KeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	keys := make([]*RemoteKey, 0, len(records))
+	for _, record := range records {
+		policy, ok := s.policy(record)
+		if !ok {
+			continue
+		}
+
+		keys = append(keys, &RemoteKey{
+			Name:      record.Name,
+			PubKey:    record.PubKey,
+			SignModes: policy.SignModes,
+		})
+	}
+
+	return &KeysResponse{Keys: keys}, nil
+}
+
+// Sign implements RemoteSignerServer.
+func (s remoteSigner) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	var (
+		record *Record
+		err    error
+	)
+
+	switch {
+	case req.Name != "" && len(req.Address) == 0:
+		record, err = s.kr.Key(req.Name)
+	case req.Name == "" && len(req.Address) != 0:
+		record, err = s.kr.KeyByAddress(req.Address)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "exactly one of name and address must be set")
+	}
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	policy, ok := s.policy(record)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", record.Name)
+	}
+
+	if !policy.Allows(req.SignMode) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s: key %s cannot sign with %s", ErrSignModeNotAllowed, record.Name, req.SignMode)
+	}
+
+	sig, pk, err := s.kr.Sign(record.Name, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{
+		Signature: sig,
+		PubKey:    pkAny,
+	}, nil
+}
+
+// policy returns the policy of the given record, and false if the record is not exposed.
+func (s remoteSigner) policy(record *Record) (KeyPolicy, bool) {
+	if record.GetLocal() == nil && record.GetLedger() == nil {
+		return KeyPolicy{}, false
+	}
+
+	if s.policies == nil {
+		return KeyPolicy{}, true
+	}
+
+	policy, ok := s.policies[record.Name]
+	return policy, ok
+}
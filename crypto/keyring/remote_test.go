@@ -0,0 +1,126 @@
+package keyring
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func newTestRemoteKeyring(t *testing.T, kr Keyring, policies map[string]KeyPolicy) Keyring {
+	t.Helper()
+
+	socket := filepath.Join(t.TempDir(), "signer.sock")
+	lis, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, NewRemoteSignerServer(kr, policies))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	remote, err := New(t.Name(), BackendRemote, "", nil, getCodec(), func(options *Options) {
+		options.RemoteSignerAddress = "unix://" + socket
+	})
+	require.NoError(t, err)
+
+	return remote
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+	kr := NewInMemory(cdc)
+
+	signer, _, err := kr.NewMnemonic("signer", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	remote := newTestRemoteKeyring(t, kr, nil)
+	require.Equal(t, BackendRemote, remote.Backend())
+
+	// offline keys cannot sign and are not exposed
+	records, err := remote.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "signer", records[0].Name)
+	require.Equal(t, TypeRemote, records[0].GetType())
+
+	signerAddr, err := signer.GetAddress()
+	require.NoError(t, err)
+	record, err := remote.KeyByAddress(signerAddr)
+	require.NoError(t, err)
+	require.Equal(t, "signer", record.Name)
+
+	_, err = remote.Key("offline")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg := []byte("some message")
+	sig, pk, err := remote.Sign("signer", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	signerPk, err := signer.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, signerPk.Equals(pk))
+	require.True(t, pk.VerifySignature(msg, sig))
+
+	sig, _, err = remote.SignByAddress(signerAddr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, signerPk.VerifySignature(msg, sig))
+
+	_, _, err = remote.Sign("offline", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "NotFound")
+
+	armor, err := remote.ExportPubKeyArmor("signer")
+	require.NoError(t, err)
+	require.NotEmpty(t, armor)
+
+	_, _, err = remote.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	require.ErrorIs(t, remote.Delete("signer"), ErrRemoteUnsupported)
+	_, err = remote.ExportPrivKeyArmor("signer", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+}
+
+func TestRemoteKeyringPolicies(t *testing.T) {
+	cdc := getCodec()
+	kr := NewInMemory(cdc)
+
+	_, _, err := kr.NewMnemonic("direct", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("any", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("hidden", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	remote := newTestRemoteKeyring(t, kr, map[string]KeyPolicy{
+		"direct": {SignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT}},
+		"any":    {},
+	})
+
+	records, err := remote.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	msg := []byte("some message")
+	_, _, err = remote.Sign("direct", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, _, err = remote.Sign("direct", msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.ErrorContains(t, err, ErrSignModeNotAllowed.Error())
+	_, _, err = remote.Sign("any", msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.NoError(t, err)
+	_, _, err = remote.Sign("hidden", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "NotFound")
+}
+
+func TestNewRemoteWithoutAddress(t *testing.T) {
+	_, err := New(t.Name(), BackendRemote, "", nil, getCodec())
+	require.ErrorIs(t, err, ErrRemoteSignerAddress)
+}
@@ -45,17 +45,21 @@ type KeyType uint
 
 // Info KeyTypes
 const (
-	TypeLocal   KeyType = 0
-	TypeLedger  KeyType = 1
-	TypeOffline KeyType = 2
-	TypeMulti   KeyType = 3
+	TypeLocal     KeyType = 0
+	TypeLedger    KeyType = 1
+	TypeOffline   KeyType = 2
+	TypeMulti     KeyType = 3
+	TypeRemote    KeyType = 4
+	TypeThreshold KeyType = 5
 )
 
 var keyTypes = map[KeyType]string{
-	TypeLocal:   "local",
-	TypeLedger:  "ledger",
-	TypeOffline: "offline",
-	TypeMulti:   "multi",
+	TypeLocal:     "local",
+	TypeLedger:    "ledger",
+	TypeOffline:   "offline",
+	TypeMulti:     "multi",
+	TypeRemote:    "remote",
+	TypeThreshold: "threshold",
 }
 
 // String implements the stringer interface for KeyType.
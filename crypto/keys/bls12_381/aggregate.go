@@ -0,0 +1,30 @@
+//go:build !bls12381
+
+package bls12_381
+
+// Validate returns an error if the public key is not a valid point of the G1
+// subgroup, or is the point at infinity.
+func (pubKey PubKey) Validate() error {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// AggregateSignatures aggregates the signatures of distinct messages into a
+// single signature, to be verified with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignature verifies a signature aggregating the signatures of
+// each message by the public key at the same index. The messages must be
+// distinct, otherwise the aggregation would be vulnerable to rogue public keys.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+func aggregatePubKeys(pubKeys, coefficients [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+func aggregateSignatures(sigs, coefficients [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
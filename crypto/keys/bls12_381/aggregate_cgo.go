@@ -0,0 +1,116 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// dst is the domain separation tag of the signatures, it must be the one used
+// by CometBFT to sign and verify.
+var dst = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+
+// Validate returns an error if the public key is not a valid point of the G1
+// subgroup, or is the point at infinity.
+func (pubKey PubKey) Validate() error {
+	_, err := bls12381.NewPublicKeyFromBytes(pubKey.Key)
+	return err
+}
+
+// AggregateSignatures aggregates the signatures of distinct messages into a
+// single signature, to be verified with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signature to aggregate")
+	}
+
+	aggregate := new(blst.P2Aggregate)
+	if !aggregate.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return aggregate.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies a signature aggregating the signatures of
+// each message by the public key at the same index. The messages must be
+// distinct, otherwise the aggregation would be vulnerable to rogue public keys.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) || len(sig) != bls12381.SignatureLength {
+		return false
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	blstMsgs := make([]blst.Message, len(msgs))
+	for i, pubKey := range pubKeys {
+		for _, msg := range msgs[:i] {
+			if bytes.Equal(msg, msgs[i]) {
+				return false
+			}
+		}
+
+		pks[i] = new(blst.P1Affine).Deserialize(pubKey.Key)
+		if pks[i] == nil {
+			return false
+		}
+		blstMsgs[i] = msgs[i]
+	}
+
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	return signature.AggregateVerify(true, pks, true, blstMsgs, dst)
+}
+
+// aggregatePubKeys returns the sum of the public keys weighted by the given
+// coefficients.
+func aggregatePubKeys(pubKeys, coefficients [][]byte) ([]byte, error) {
+	if len(pubKeys) == 0 || len(pubKeys) != len(coefficients) {
+		return nil, errors.New("invalid number of public keys")
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pks[i] = new(blst.P1Affine).Deserialize(pubKey)
+		if pks[i] == nil || !pks[i].KeyValidate() {
+			return nil, fmt.Errorf("invalid public key %X", pubKey)
+		}
+	}
+
+	aggregate := blst.P1AffinesMult(pks, coefficients, coefficientSize*8)
+	if aggregate == nil {
+		return nil, errors.New("invalid coefficients")
+	}
+
+	return aggregate.ToAffine().Serialize(), nil
+}
+
+// aggregateSignatures returns the sum of the signatures weighted by the given
+// coefficients.
+func aggregateSignatures(sigs, coefficients [][]byte) ([]byte, error) {
+	if len(sigs) == 0 || len(sigs) != len(coefficients) {
+		return nil, errors.New("invalid number of signatures")
+	}
+
+	signatures := make([]*blst.P2Affine, len(sigs))
+	for i, sig := range sigs {
+		signatures[i] = new(blst.P2Affine).Uncompress(sig)
+		if signatures[i] == nil || !signatures[i].SigValidate(false) {
+			return nil, fmt.Errorf("invalid signature %X", sig)
+		}
+	}
+
+	aggregate := blst.P2AffinesMult(signatures, coefficients, coefficientSize*8)
+	if aggregate == nil {
+		return nil, errors.New("invalid coefficients")
+	}
+
+	return aggregate.ToAffine().Compress(), nil
+}
@@ -0,0 +1,148 @@
+//go:build bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func genPrivKeys(t *testing.T, n int) []bls12_381.PrivKey {
+	t.Helper()
+
+	privKeys := make([]bls12_381.PrivKey, n)
+	for i := range privKeys {
+		var err error
+		privKeys[i], err = bls12_381.GenPrivKey()
+		require.NoError(t, err)
+	}
+	return privKeys
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	privKeys := genPrivKeys(t, 3)
+	pubKeys := make([]*bls12_381.PubKey, len(privKeys))
+	msgs := make([][]byte, len(privKeys))
+	sigs := make([][]byte, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKeys[i] = privKey.PubKey().(*bls12_381.PubKey)
+		require.NoError(t, pubKeys[i].Validate())
+
+		msgs[i] = []byte{byte(i)}
+		var err error
+		sigs[i], err = privKey.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	sig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, sig, len(sigs[0]))
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, sig))
+
+	// a single signature is its own aggregate
+	sig0, err := bls12_381.AggregateSignatures(sigs[:1])
+	require.NoError(t, err)
+	require.Equal(t, sigs[0], sig0)
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys[:1], msgs[:1], sig0))
+
+	// messages signed by another key
+	require.False(t, bls12_381.VerifyAggregateSignature([]*bls12_381.PubKey{pubKeys[1], pubKeys[0], pubKeys[2]}, msgs, sig))
+	// missing signature
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys[:2], msgs[:2], sig))
+
+	// identical messages are rejected
+	sameMsgSigs := make([][]byte, len(privKeys))
+	for i, privKey := range privKeys {
+		sameMsgSigs[i], err = privKey.Sign([]byte("hello"))
+		require.NoError(t, err)
+	}
+	sig, err = bls12_381.AggregateSignatures(sameMsgSigs)
+	require.NoError(t, err)
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, [][]byte{[]byte("hello"), []byte("hello"), []byte("hello")}, sig))
+
+	_, err = bls12_381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12_381.AggregateSignatures([][]byte{[]byte("invalid")})
+	require.Error(t, err)
+
+	require.Error(t, bls12_381.PubKey{Key: make([]byte, len(pubKeys[0].Key))}.Validate())
+}
+
+func TestMultiPubKey(t *testing.T) {
+	privKeys := genPrivKeys(t, 3)
+	pubKeys := make([]cryptotypes.PubKey, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKeys[i] = privKey.PubKey()
+	}
+
+	multiPubKey, err := bls12_381.NewMultiPubKey(2, pubKeys)
+	require.NoError(t, err)
+	require.Len(t, multiPubKey.Address(), 32)
+
+	msg := []byte("hello")
+	sigs := make(map[int][]byte)
+	for i, privKey := range privKeys {
+		sigs[i], err = privKey.Sign(msg)
+		require.NoError(t, err)
+	}
+
+	for _, signers := range [][]int{{0, 1}, {1, 2}, {0, 2}, {0, 1, 2}} {
+		signerSigs := make(map[int][]byte)
+		for _, i := range signers {
+			signerSigs[i] = sigs[i]
+		}
+
+		sig, err := multiPubKey.AggregateSignatures(signerSigs)
+		require.NoError(t, err)
+		require.True(t, multiPubKey.VerifySignature(msg, sig))
+		require.False(t, multiPubKey.VerifySignature([]byte("world"), sig))
+	}
+
+	// not enough signatures
+	_, err = multiPubKey.AggregateSignatures(map[int][]byte{0: sigs[0]})
+	require.Error(t, err)
+	// unknown member
+	_, err = multiPubKey.AggregateSignatures(map[int][]byte{0: sigs[0], 3: sigs[1]})
+	require.Error(t, err)
+
+	// signature attributed to the wrong member
+	sig, err := multiPubKey.AggregateSignatures(map[int][]byte{0: sigs[1], 1: sigs[0]})
+	require.NoError(t, err)
+	require.False(t, multiPubKey.VerifySignature(msg, sig))
+
+	// the plain sum of the member signatures is not a valid signature
+	sum, err := bls12_381.AggregateSignatures([][]byte{sigs[0], sigs[1]})
+	require.NoError(t, err)
+	multiSig := bls12_381.MultiSignature{Signers: cryptotypes.NewCompactBitArray(3), Signature: sum}
+	multiSig.Signers.SetIndex(0, true)
+	multiSig.Signers.SetIndex(1, true)
+	bz, err := multiSig.Marshal()
+	require.NoError(t, err)
+	require.False(t, multiPubKey.VerifySignature(msg, bz))
+
+	// below the threshold
+	multiSig.Signers.SetIndex(1, false)
+	multiSig.Signature = sigs[0]
+	bz, err = multiSig.Marshal()
+	require.NoError(t, err)
+	require.False(t, multiPubKey.VerifySignature(msg, bz))
+
+	other, err := bls12_381.NewMultiPubKey(3, pubKeys)
+	require.NoError(t, err)
+	require.False(t, multiPubKey.Equals(other))
+	require.NotEqual(t, multiPubKey.Address(), other.Address())
+	same, err := bls12_381.NewMultiPubKey(2, pubKeys)
+	require.NoError(t, err)
+	require.True(t, multiPubKey.Equals(same))
+
+	_, err = bls12_381.NewMultiPubKey(0, pubKeys)
+	require.Error(t, err)
+	_, err = bls12_381.NewMultiPubKey(4, pubKeys)
+	require.Error(t, err)
+	_, err = bls12_381.NewMultiPubKey(2, []cryptotypes.PubKey{pubKeys[0], pubKeys[0]})
+	require.Error(t, err)
+}
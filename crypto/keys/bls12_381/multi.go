@@ -0,0 +1,181 @@
+package bls12_381
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// MultiPubKeyName is the amino name of MultiPubKey.
+	MultiPubKeyName = "cosmos/PubKeyBls12381Multi"
+
+	// coefficientSize is the size in bytes of the coefficients weighting the
+	// member keys and signatures of a MultiPubKey.
+	coefficientSize = 16
+)
+
+var _ cryptotypes.PubKey = &MultiPubKey{}
+
+// NewMultiPubKey returns a MultiPubKey requiring threshold signatures among the
+// given BLS12-381 public keys. The order of the keys is part of the key.
+func NewMultiPubKey(threshold int, pubKeys []cryptotypes.PubKey) (*MultiPubKey, error) {
+	if threshold <= 0 {
+		return nil, errors.New("threshold k of n multisignature: k <= 0")
+	}
+	if len(pubKeys) < threshold {
+		return nil, errors.New("threshold k of n multisignature: len(pubKeys) < k")
+	}
+
+	keys := make([][]byte, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		blsPubKey, ok := pubKey.(*PubKey)
+		if !ok {
+			return nil, fmt.Errorf("expected %T, got %T", &PubKey{}, pubKey)
+		}
+		for _, key := range keys[:i] {
+			if bytes.Equal(key, blsPubKey.Key) {
+				return nil, fmt.Errorf("duplicate public key %X", blsPubKey.Key)
+			}
+		}
+		keys[i] = blsPubKey.Key
+	}
+
+	return &MultiPubKey{Threshold: uint32(threshold), PubKeys: keys}, nil
+}
+
+// Address returns the ADR-28 address of the key.
+func (m *MultiPubKey) Address() crypto.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Bytes returns the proto encoding of the key.
+func (m *MultiPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifySignature verifies a MultiSignature of the given message, which must
+// be signed by at least threshold members.
+func (m *MultiPubKey) VerifySignature(msg, sig []byte) bool {
+	var multiSig MultiSignature
+	if err := multiSig.Unmarshal(sig); err != nil {
+		return false
+	}
+	if multiSig.Signers == nil || multiSig.Signers.Count() != len(m.PubKeys) {
+		return false
+	}
+
+	coefficients := m.coefficients()
+	var pubKeys, signerCoefficients [][]byte
+	for i, pubKey := range m.PubKeys {
+		if multiSig.Signers.GetIndex(i) {
+			pubKeys = append(pubKeys, pubKey)
+			signerCoefficients = append(signerCoefficients, coefficients[i])
+		}
+	}
+	if len(pubKeys) == 0 || len(pubKeys) < int(m.Threshold) {
+		return false
+	}
+
+	aggregatedPubKey, err := aggregatePubKeys(pubKeys, signerCoefficients)
+	if err != nil {
+		return false
+	}
+
+	return PubKey{Key: aggregatedPubKey}.VerifySignature(msg, multiSig.Signature)
+}
+
+// AggregateSignatures aggregates the signatures of the members into a
+// MultiSignature. The signatures are indexed by the position of the public key
+// of their signer.
+func (m *MultiPubKey) AggregateSignatures(sigs map[int][]byte) ([]byte, error) {
+	if len(sigs) < int(m.Threshold) {
+		return nil, fmt.Errorf("not enough signatures: got %d, expected at least %d", len(sigs), m.Threshold)
+	}
+
+	coefficients := m.coefficients()
+	signers := cryptotypes.NewCompactBitArray(len(m.PubKeys))
+	var memberSigs, signerCoefficients [][]byte
+	for i := range m.PubKeys {
+		sig, ok := sigs[i]
+		if !ok {
+			continue
+		}
+		signers.SetIndex(i, true)
+		memberSigs = append(memberSigs, sig)
+		signerCoefficients = append(signerCoefficients, coefficients[i])
+	}
+	if len(memberSigs) != len(sigs) {
+		return nil, errors.New("signature of an unknown member")
+	}
+
+	sig, err := aggregateSignatures(memberSigs, signerCoefficients)
+	if err != nil {
+		return nil, err
+	}
+
+	return (&MultiSignature{Signers: signers, Signature: sig}).Marshal()
+}
+
+// Equals returns true if the other key is a MultiPubKey with the same threshold
+// and public keys.
+func (m *MultiPubKey) Equals(other cryptotypes.PubKey) bool {
+	otherKey, ok := other.(*MultiPubKey)
+	if !ok {
+		return false
+	}
+	if m.Threshold != otherKey.Threshold || len(m.PubKeys) != len(otherKey.PubKeys) {
+		return false
+	}
+	for i := range m.PubKeys {
+		if !bytes.Equal(m.PubKeys[i], otherKey.PubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Type returns the key's type.
+func (m *MultiPubKey) Type() string {
+	return "PubKeyBls12381Multi"
+}
+
+// String returns the threshold and the hex representation of the public keys.
+func (m *MultiPubKey) String() string {
+	keys := make([]string, len(m.PubKeys))
+	for i, pubKey := range m.PubKeys {
+		keys[i] = fmt.Sprintf("%X", pubKey)
+	}
+	return fmt.Sprintf("PubKeyBLS12_381Multi{%d/%d: %s}", m.Threshold, len(m.PubKeys), strings.Join(keys, ", "))
+}
+
+// coefficients returns the coefficient of each member key, computed as
+// H(H(pk_1 || ... || pk_n) || pk_i). Weighting the keys and the signatures by
+// these coefficients prevents a member from choosing its key so as to cancel
+// out the keys of the others.
+func (m *MultiPubKey) coefficients() [][]byte {
+	h := sha256.New()
+	for _, pubKey := range m.PubKeys {
+		h.Write(pubKey)
+	}
+	keysHash := h.Sum(nil)
+
+	coefficients := make([][]byte, len(m.PubKeys))
+	for i, pubKey := range m.PubKeys {
+		sum := sha256.Sum256(append(append([]byte{}, keysHash...), pubKey...))
+		coefficients[i] = sum[:coefficientSize]
+	}
+	return coefficients
+}
@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crypto/bls12_381/multi.proto
+
+package bls12_381
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/crypto/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultiPubKey is a threshold public key made of BLS12-381 public keys. The
+// signatures of its members are aggregated into a single BLS12-381 signature,
+// so that a MultiSignature has the size of a single signature whatever the
+// number of signers.
+//
+// Each member signature is weighted by a coefficient derived from the whole
+// set of public keys, which prevents rogue public key attacks without
+// requiring a proof of possession of the keys.
+type MultiPubKey struct {
+	Threshold uint32   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	PubKeys   [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (m *MultiPubKey) Reset()      { *m = MultiPubKey{} }
+func (*MultiPubKey) ProtoMessage() {}
+func (*MultiPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4391c1fc467bac, []int{0}
+}
+func (m *MultiPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiPubKey.Merge(m, src)
+}
+func (m *MultiPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiPubKey proto.InternalMessageInfo
+
+// MultiSignature is the signature of a MultiPubKey. It is the aggregate of the
+// member signatures selected by the signers bit array.
+type MultiSignature struct {
+	Signers   *types.CompactBitArray `protobuf:"bytes,1,opt,name=signers,proto3" json:"signers,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MultiSignature) Reset()         { *m = MultiSignature{} }
+func (m *MultiSignature) String() string { return proto.CompactTextString(m) }
+func (*MultiSignature) ProtoMessage()    {}
+func (*MultiSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4391c1fc467bac, []int{1}
+}
+func (m *MultiSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignature.Merge(m, src)
+}
+func (m *MultiSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignature proto.InternalMessageInfo
+
+func (m *MultiSignature) GetSigners() *types.CompactBitArray {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *MultiSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultiPubKey)(nil), "cosmos.crypto.bls12_381.MultiPubKey")
+	proto.RegisterType((*MultiSignature)(nil), "cosmos.crypto.bls12_381.MultiSignature")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/crypto/bls12_381/multi.proto", fileDescriptor_dd4391c1fc467bac)
+}
+
+var fileDescriptor_dd4391c1fc467bac = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0x3f, 0x4b, 0xc3, 0x40,
+	0x1c, 0xcd, 0x55, 0xb0, 0x78, 0xa9, 0x82, 0x41, 0xb0, 0x14, 0x49, 0x4b, 0x1d, 0x2c, 0x62, 0x73,
+	0xa4, 0x5d, 0x8a, 0x9b, 0x71, 0x12, 0x15, 0x24, 0x6e, 0x2e, 0x25, 0x49, 0x43, 0x7a, 0x34, 0xe9,
+	0x85, 0xbb, 0x8b, 0x90, 0xd5, 0x49, 0x9c, 0x1c, 0x1d, 0xfb, 0x11, 0xfc, 0x18, 0x8e, 0x1d, 0x9d,
+	0x44, 0xd2, 0xc1, 0xaf, 0x21, 0x77, 0xd7, 0x34, 0x74, 0xb9, 0x7b, 0xbc, 0x7b, 0xbf, 0x3f, 0xef,
+	0x1d, 0x3c, 0x0d, 0x08, 0x4b, 0x08, 0x43, 0x01, 0xcd, 0x53, 0x4e, 0x90, 0x1f, 0x33, 0x7b, 0x30,
+	0x1e, 0x8e, 0x6c, 0x94, 0x64, 0x31, 0xc7, 0x56, 0x4a, 0x09, 0x27, 0xc6, 0xb1, 0x12, 0x59, 0x4a,
+	0x64, 0x6d, 0x44, 0xad, 0x43, 0x2f, 0xc1, 0x73, 0x82, 0xe4, 0xa9, 0xb4, 0xad, 0xa3, 0x88, 0x44,
+	0x44, 0x42, 0x24, 0xd0, 0x9a, 0xed, 0x6f, 0x8f, 0x91, 0xcd, 0x19, 0x8e, 0xd0, 0xb3, 0xed, 0x87,
+	0xdc, 0xb3, 0x37, 0x84, 0x92, 0x77, 0x5f, 0x00, 0xd4, 0xef, 0x05, 0xf5, 0x90, 0xf9, 0xb7, 0x61,
+	0x6e, 0x9c, 0xc0, 0x3d, 0x3e, 0xa5, 0x21, 0x9b, 0x92, 0x78, 0xd2, 0x04, 0x1d, 0xd0, 0xdb, 0x77,
+	0x2b, 0xc2, 0xb8, 0x80, 0x7a, 0x9a, 0xf9, 0x31, 0x0e, 0xc6, 0xb3, 0x30, 0x67, 0xcd, 0x5a, 0x67,
+	0xa7, 0xd7, 0x70, 0xf4, 0xe2, 0xa7, 0x5d, 0x57, 0xe5, 0xcc, 0x85, 0xea, 0x5d, 0xe0, 0xcb, 0xb3,
+	0xd7, 0x45, 0x5b, 0xfb, 0x58, 0xb4, 0xb5, 0xb7, 0xbf, 0xcf, 0xf3, 0xd6, 0x7a, 0x2f, 0x25, 0x74,
+	0x84, 0xb1, 0xe1, 0xc8, 0x96, 0xa3, 0xbb, 0x39, 0x3c, 0x90, 0xe0, 0x11, 0x47, 0x73, 0x8f, 0x67,
+	0x34, 0x34, 0x6e, 0x60, 0x9d, 0xe1, 0x68, 0x1e, 0x52, 0x26, 0x97, 0xd0, 0x07, 0xc8, 0xda, 0x4e,
+	0x66, 0x63, 0x63, 0xed, 0xcb, 0xba, 0x26, 0x49, 0xea, 0x05, 0xdc, 0xc1, 0xfc, 0x8a, 0x52, 0x2f,
+	0x77, 0xcb, 0x7a, 0xe1, 0x88, 0x95, 0x7d, 0x9b, 0xb5, 0x0e, 0xe8, 0x35, 0xdc, 0x8a, 0x70, 0xee,
+	0xbe, 0x0a, 0x13, 0x2c, 0x0b, 0x13, 0xfc, 0x16, 0x26, 0x78, 0x5f, 0x99, 0xda, 0x72, 0x65, 0x6a,
+	0xdf, 0x2b, 0x53, 0x7b, 0x1a, 0x44, 0x98, 0x4f, 0x33, 0xdf, 0x0a, 0x48, 0x82, 0xca, 0x4c, 0xe5,
+	0xd5, 0x67, 0x93, 0x59, 0x19, 0xaf, 0x88, 0xa1, 0xfa, 0x4a, 0x7f, 0x57, 0x86, 0x3a, 0xfc, 0x1f,
+	0x00, 0x97, 0x57, 0xf4, 0x52, 0xec, 0x01, 0x00, 0x00,
+}
+
+func (m *MultiPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintMulti(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintMulti(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMulti(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Signers != nil {
+		{
+			size, err := m.Signers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMulti(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMulti(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMulti(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultiPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovMulti(uint64(m.Threshold))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, b := range m.PubKeys {
+			l = len(b)
+			n += 1 + l + sovMulti(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Signers != nil {
+		l = m.Signers.Size()
+		n += 1 + l + sovMulti(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMulti(uint64(l))
+	}
+	return n
+}
+
+func sovMulti(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMulti(x uint64) (n int) {
+	return sovMulti(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultiPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMulti
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulti
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulti
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMulti
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMulti
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PubKeys[len(m.PubKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMulti(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMulti
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMulti
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulti
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMulti
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMulti
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signers == nil {
+				m.Signers = &types.CompactBitArray{}
+			}
+			if err := m.Signers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMulti
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMulti
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMulti
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMulti(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMulti
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMulti(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMulti
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMulti
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMulti
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMulti
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMulti
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMulti
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMulti        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMulti          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMulti = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,67 @@
+package secp256k1
+
+import (
+	"bytes"
+
+	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+// EthereumSignatureSize is the size of Ethereum signatures: R || S || V.
+const EthereumSignatureSize = 65
+
+// compactSigMagicOffset is the offset of the recovery code of compact signatures.
+const compactSigMagicOffset = 27
+
+// SignEthereum creates a recoverable ECDSA signature on curve Secp256k1, using
+// Keccak256 on the msg, as Ethereum wallets do.
+// The returned signature will be of the form R || S || V (in lower-S form),
+// with V the recovery id (0 or 1).
+func (privKey *PrivKey) SignEthereum(msg []byte) ([]byte, error) {
+	priv := secp256k1dcrd.PrivKeyFromBytes(privKey.Key)
+	sig := ecdsa.SignCompact(priv, keccak256(msg), false)
+
+	// move the recovery code from the first to the last byte
+	return append(sig[1:], sig[0]-compactSigMagicOffset), nil
+}
+
+// VerifyEthereumSignature verifies a signature of the form R || S || V on the
+// Keccak256 hash of the msg, as created by Ethereum wallets, by recovering its
+// public key. V is either the recovery id or the recovery id + 27.
+// It rejects signatures which are not in lower-S form.
+func (pubKey *PubKey) VerifyEthereumSignature(msg, sig []byte) bool {
+	if len(sig) != EthereumSignatureSize {
+		return false
+	}
+
+	recoveryID := sig[64]
+	if recoveryID >= compactSigMagicOffset {
+		recoveryID -= compactSigMagicOffset
+	}
+	if recoveryID > 1 {
+		return false
+	}
+
+	var s secp256k1dcrd.ModNScalar
+	s.SetByteSlice(sig[32:64])
+	if s.IsOverHalfOrder() {
+		return false
+	}
+
+	compactSig := make([]byte, 0, EthereumSignatureSize)
+	compactSig = append(compactSig, compactSigMagicOffset+recoveryID)
+	compactSig = append(compactSig, sig[:64]...)
+	pub, _, err := ecdsa.RecoverCompact(compactSig, keccak256(msg))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(pub.SerializeCompressed(), pubKey.Key)
+}
+
+func keccak256(msg []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(msg)
+	return hasher.Sum(nil)
+}
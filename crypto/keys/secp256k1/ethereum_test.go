@@ -0,0 +1,44 @@
+package secp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestEthereumSignature(t *testing.T) {
+	// web3.eth.accounts.sign("Some data", "0x4c0883a6...") test vector
+	privKeyBz, err := hex.DecodeString("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+	privKey := &secp256k1.PrivKey{Key: privKeyBz}
+	pubKey := privKey.PubKey().(*secp256k1.PubKey)
+	msg := []byte("\x19Ethereum Signed Message:\n9Some data")
+	walletSig, err := hex.DecodeString("b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c")
+	require.NoError(t, err)
+
+	// the signature of the wallet, with V = recovery id + 27
+	require.True(t, pubKey.VerifyEthereumSignature(msg, walletSig))
+
+	// the same deterministic signature, with V = recovery id
+	sig, err := privKey.SignEthereum(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, secp256k1.EthereumSignatureSize)
+	require.Equal(t, walletSig[:64], sig[:64])
+	require.Equal(t, walletSig[64]-27, sig[64])
+	require.True(t, pubKey.VerifyEthereumSignature(msg, sig))
+
+	// Ethereum signatures are not valid secp256k1 signatures
+	require.False(t, pubKey.VerifySignature(msg, sig[:64]))
+
+	// invalid signatures
+	require.False(t, pubKey.VerifyEthereumSignature([]byte("other data"), sig))
+	require.False(t, pubKey.VerifyEthereumSignature(msg, sig[:64]))
+	invalidV := append([]byte{}, sig...)
+	invalidV[64] = 2
+	require.False(t, pubKey.VerifyEthereumSignature(msg, invalidV))
+	otherPubKey := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+	require.False(t, otherPubKey.VerifyEthereumSignature(msg, sig))
+}
@@ -0,0 +1,11 @@
+// Package webauthn implements a Cosmos-SDK compatible public key for WebAuthn
+// credentials, such as platform passkeys, using the secp256r1 curve (COSE
+// algorithm ES256).
+//
+// The signature of a message is a WebAuthn authentication assertion whose
+// challenge is the SHA-256 hash of the message, so that the sign bytes of a
+// transaction are signed by requesting an assertion with
+// navigator.credentials.get. The assertion is verified as specified in section
+// 7.2 of the WebAuthn specification, except for the origin and the signature
+// counter which are left to the relying party: https://www.w3.org/TR/webauthn-2/#sctn-verifying-assertion
+package webauthn
@@ -0,0 +1,648 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crypto/webauthn/keys.proto
+
+package webauthn
+
+import (
+	fmt "fmt"
+	secp256r1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PubKey defines a WebAuthn credential public key, such as a passkey. Its
+// signatures are WebAuthn assertions whose challenge is the SHA-256 hash of
+// the signed message.
+type PubKey struct {
+	// key is the secp256r1 public key of the credential (COSE algorithm ES256).
+	Key *secp256r1.PubKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// rp_id is the identifier of the relying party the credential is scoped to.
+	RpID string `protobuf:"bytes,2,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+}
+
+func (m *PubKey) Reset()      { *m = PubKey{} }
+func (*PubKey) ProtoMessage() {}
+func (*PubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb5a8180b46277f5, []int{0}
+}
+func (m *PubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKey.Merge(m, src)
+}
+func (m *PubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKey proto.InternalMessageInfo
+
+func (*PubKey) XXX_MessageName() string {
+	return "cosmos.crypto.webauthn.PubKey"
+}
+
+// Assertion defines a WebAuthn authentication assertion, which is the
+// signature of a PubKey.
+type Assertion struct {
+	// authenticator_data is the authenticator data returned by the authenticator.
+	AuthenticatorData []byte `protobuf:"bytes,1,opt,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data,omitempty"`
+	// client_data_json is the JSON-compatible serialization of the client data.
+	ClientDataJSON []byte `protobuf:"bytes,2,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	// signature is the ASN.1 DER encoded ECDSA signature of the authenticator
+	// data and of the SHA-256 hash of the client data.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Assertion) Reset()         { *m = Assertion{} }
+func (m *Assertion) String() string { return proto.CompactTextString(m) }
+func (*Assertion) ProtoMessage()    {}
+func (*Assertion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb5a8180b46277f5, []int{1}
+}
+func (m *Assertion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Assertion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Assertion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Assertion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Assertion.Merge(m, src)
+}
+func (m *Assertion) XXX_Size() int {
+	return m.Size()
+}
+func (m *Assertion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Assertion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Assertion proto.InternalMessageInfo
+
+func (*Assertion) XXX_MessageName() string {
+	return "cosmos.crypto.webauthn.Assertion"
+}
+func init() {
+	proto.RegisterType((*PubKey)(nil), "cosmos.crypto.webauthn.PubKey")
+	proto.RegisterType((*Assertion)(nil), "cosmos.crypto.webauthn.Assertion")
+}
+
+func init() { proto.RegisterFile("cosmos/crypto/webauthn/keys.proto", fileDescriptor_fb5a8180b46277f5) }
+
+var fileDescriptor_fb5a8180b46277f5 = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x4f, 0xfa, 0x40,
+	0x18, 0xc7, 0x7b, 0x3f, 0xf8, 0x11, 0x39, 0x09, 0xd1, 0x8b, 0x31, 0x84, 0xe8, 0x15, 0x99, 0x58,
+	0x68, 0x03, 0x46, 0x07, 0xe3, 0x22, 0xb2, 0xa0, 0x89, 0x9a, 0x3a, 0x98, 0xb0, 0x90, 0xfe, 0xb9,
+	0x94, 0x8a, 0xdc, 0x35, 0x77, 0xd7, 0x98, 0xbe, 0x0b, 0x47, 0x07, 0x07, 0x5f, 0x0e, 0x23, 0x23,
+	0x13, 0x91, 0xf6, 0x8d, 0x98, 0x5e, 0x03, 0xc8, 0x74, 0x7f, 0x9e, 0xcf, 0x3d, 0xdf, 0xcb, 0xe7,
+	0x81, 0x67, 0x2e, 0x13, 0x53, 0x26, 0x4c, 0x97, 0xc7, 0xa1, 0x64, 0xe6, 0x3b, 0x71, 0xec, 0x48,
+	0x8e, 0xa9, 0x39, 0x21, 0xb1, 0x30, 0x42, 0xce, 0x24, 0x43, 0xc7, 0x39, 0x62, 0xe4, 0x88, 0xb1,
+	0x46, 0xea, 0x47, 0x3e, 0xf3, 0x99, 0x42, 0xcc, 0x6c, 0x97, 0xd3, 0xf5, 0xe6, 0x6e, 0x43, 0x41,
+	0xdc, 0xb0, 0x7b, 0x71, 0xc9, 0x3b, 0x7f, 0x3a, 0x36, 0x87, 0xb0, 0xf4, 0x14, 0x39, 0xf7, 0x24,
+	0x46, 0x1d, 0x58, 0x98, 0x90, 0xb8, 0x06, 0x1a, 0xa0, 0xb5, 0xdf, 0xd5, 0x8d, 0xdd, 0xa4, 0xcd,
+	0x5b, 0x23, 0xa7, 0xad, 0x8c, 0x45, 0xa7, 0xf0, 0x3f, 0x0f, 0x47, 0x81, 0x57, 0xfb, 0xd7, 0x00,
+	0xad, 0x72, 0x6f, 0x2f, 0x59, 0xea, 0x45, 0x2b, 0x1c, 0xf4, 0xad, 0x22, 0x0f, 0x07, 0x5e, 0xf3,
+	0x0b, 0xc0, 0xf2, 0x8d, 0x10, 0x84, 0xcb, 0x80, 0x51, 0xd4, 0x86, 0x28, 0xfb, 0x2c, 0xa1, 0x32,
+	0x70, 0x6d, 0xc9, 0xf8, 0xc8, 0xb3, 0xa5, 0xad, 0xe2, 0x2a, 0xd6, 0xe1, 0x4e, 0xa5, 0x6f, 0x4b,
+	0x1b, 0x5d, 0xc3, 0x03, 0xf7, 0x2d, 0x20, 0x54, 0x2a, 0x6e, 0xf4, 0x2a, 0x18, 0x55, 0x31, 0x95,
+	0x1e, 0x4a, 0x96, 0x7a, 0xf5, 0x56, 0xd5, 0x32, 0xf2, 0xee, 0xf9, 0xf1, 0xc1, 0xaa, 0xba, 0xdb,
+	0xb3, 0x60, 0x14, 0x9d, 0xc0, 0xb2, 0x08, 0x7c, 0x6a, 0xcb, 0x88, 0x93, 0x5a, 0x41, 0x65, 0x6c,
+	0x2f, 0xae, 0x8a, 0x9f, 0xdf, 0x3a, 0xe8, 0xbd, 0xcc, 0x56, 0x58, 0x5b, 0xac, 0xb0, 0x36, 0x4b,
+	0x30, 0x98, 0x27, 0x18, 0xfc, 0x24, 0x18, 0x7c, 0xa4, 0x58, 0x9b, 0xa5, 0x18, 0xcc, 0x53, 0xac,
+	0x2d, 0x52, 0xac, 0x0d, 0x3b, 0x7e, 0x20, 0xc7, 0x91, 0x63, 0xb8, 0x6c, 0x6a, 0xae, 0x7d, 0xaa,
+	0xa5, 0x2d, 0xbc, 0xc9, 0x5a, 0x6d, 0x26, 0x74, 0x33, 0x30, 0xa7, 0xa4, 0xd4, 0x9e, 0xff, 0x0e,
+	0x00, 0x18, 0x6a, 0xcd, 0xbc, 0xd1, 0x01, 0x00, 0x00,
+}
+
+func (m *PubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RpID) > 0 {
+		i -= len(m.RpID)
+		copy(dAtA[i:], m.RpID)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.RpID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Assertion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Assertion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Assertion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientDataJSON) > 0 {
+		i -= len(m.ClientDataJSON)
+		copy(dAtA[i:], m.ClientDataJSON)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ClientDataJSON)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthenticatorData) > 0 {
+		i -= len(m.AuthenticatorData)
+		copy(dAtA[i:], m.AuthenticatorData)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.AuthenticatorData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeys(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.RpID)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	return n
+}
+
+func (m *Assertion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthenticatorData)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.ClientDataJSON)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	return n
+}
+
+func sovKeys(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeys(x uint64) (n int) {
+	return sovKeys(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Key == nil {
+				m.Key = &secp256r1.PubKey{}
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Assertion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Assertion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Assertion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorData = append(m.AuthenticatorData[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthenticatorData == nil {
+				m.AuthenticatorData = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDataJSON", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientDataJSON = append(m.ClientDataJSON[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientDataJSON == nil {
+				m.ClientDataJSON = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKeys(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKeys
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKeys
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKeys
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKeys        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKeys          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKeys = fmt.Errorf("proto: unexpected end of group")
+)
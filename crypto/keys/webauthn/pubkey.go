@@ -0,0 +1,162 @@
+package webauthn
+
+import (
+	"bytes"
+	stdecdsa "crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	name = "webauthn"
+
+	// authenticatorDataMinSize is the size of the RP ID hash, the flags and the
+	// signature counter of the authenticator data.
+	authenticatorDataMinSize = sha256.Size + 1 + 4
+	// flagUserPresent is the authenticator data flag set when the user is present.
+	flagUserPresent = 0x01
+	// clientDataTypeGet is the client data type of an authentication assertion.
+	clientDataTypeGet = "webauthn.get"
+)
+
+var _ cryptotypes.PubKey = &PubKey{}
+
+// RegisterInterfaces adds webauthn PubKey to pubkey registry
+func RegisterInterfaces(registry registry.InterfaceRegistrar) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+}
+
+// NewPubKey returns the public key of a WebAuthn credential scoped to the
+// given relying party.
+func NewPubKey(key *secp256r1.PubKey, rpID string) *PubKey {
+	return &PubKey{Key: key, RpID: rpID}
+}
+
+// Challenge returns the WebAuthn challenge of an assertion signing msg.
+func Challenge(msg []byte) []byte {
+	h := sha256.Sum256(msg)
+	return h[:]
+}
+
+// Validate returns an error if the credential key is not a valid secp256r1
+// point, or if the relying party is missing.
+func (m *PubKey) Validate() error {
+	if m.Key == nil || m.Key.Key == nil {
+		return errors.New("missing webauthn credential key")
+	}
+	pk := m.Key.Key.PublicKey
+	if !pk.IsOnCurve(pk.X, pk.Y) {
+		return errors.New("webauthn credential key is not on curve")
+	}
+	if m.RpID == "" {
+		return errors.New("missing webauthn relying party identifier")
+	}
+	return nil
+}
+
+// String implements proto.Message interface.
+func (m *PubKey) String() string {
+	return fmt.Sprintf("%s{%X, %s}", name, m.Key.Bytes(), m.RpID)
+}
+
+// Bytes implements SDK PubKey interface.
+func (m *PubKey) Bytes() []byte {
+	if m == nil {
+		return nil
+	}
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// Equals implements SDK PubKey interface.
+func (m *PubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*PubKey)
+	if !ok {
+		return false
+	}
+	return m.RpID == pk2.RpID && m.Key.Equals(pk2.Key)
+}
+
+// Address implements SDK PubKey interface.
+func (m *PubKey) Address() cmtcrypto.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (m *PubKey) Type() string {
+	return name
+}
+
+// VerifySignature implements SDK PubKey interface. The signature is a proto
+// encoded Assertion, whose challenge must be the SHA-256 hash of msg.
+//
+// Note that authenticators do not produce low-s normalized signatures, so
+// unlike secp256r1 keys both s values of a signature are accepted.
+func (m *PubKey) VerifySignature(msg, sig []byte) bool {
+	var assertion Assertion
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+	return m.VerifyAssertion(Challenge(msg), &assertion) == nil
+}
+
+// VerifyAssertion verifies a WebAuthn assertion of the given challenge.
+func (m *PubKey) VerifyAssertion(challenge []byte, assertion *Assertion) error {
+	if m.Key == nil || m.Key.Key == nil {
+		return errors.New("missing webauthn credential key")
+	}
+
+	authData := assertion.AuthenticatorData
+	if len(authData) < authenticatorDataMinSize {
+		return fmt.Errorf("authenticator data too short: %d bytes", len(authData))
+	}
+	rpIDHash := sha256.Sum256([]byte(m.RpID))
+	if !bytes.Equal(authData[:sha256.Size], rpIDHash[:]) {
+		return errors.New("authenticator data of another relying party")
+	}
+	if authData[sha256.Size]&flagUserPresent == 0 {
+		return errors.New("user not present")
+	}
+
+	var clientData struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		return fmt.Errorf("invalid client data: %w", err)
+	}
+	if clientData.Type != clientDataTypeGet {
+		return fmt.Errorf("invalid client data type %q", clientData.Type)
+	}
+	clientChallenge, err := base64.RawURLEncoding.DecodeString(clientData.Challenge)
+	if err != nil {
+		return fmt.Errorf("invalid client data challenge: %w", err)
+	}
+	if !bytes.Equal(clientChallenge, challenge) {
+		return errors.New("client data challenge mismatch")
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	h := sha256.New()
+	h.Write(authData)
+	h.Write(clientDataHash[:])
+	if !stdecdsa.VerifyASN1(&m.Key.Key.PublicKey, h.Sum(nil), assertion.Signature) {
+		return errors.New("invalid assertion signature")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const rpID = "example.com"
+
+// sign returns an assertion of msg by an authenticator holding sk, as returned
+// by navigator.credentials.get.
+func sign(t *testing.T, sk *secp256r1.PrivKey, rpID, clientDataType string, flags byte, msg []byte) *webauthn.Assertion {
+	t.Helper()
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], flags, 0, 0, 0, 1)
+	clientData := fmt.Sprintf(`{"type":%q,"challenge":%q,"origin":"https://%s","crossOrigin":false}`,
+		clientDataType, base64.RawURLEncoding.EncodeToString(webauthn.Challenge(msg)), rpID)
+
+	clientDataHash := sha256.Sum256([]byte(clientData))
+	h := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, &sk.Secret.PrivateKey, h[:])
+	require.NoError(t, err)
+
+	return &webauthn.Assertion{
+		AuthenticatorData: authData,
+		ClientDataJSON:    []byte(clientData),
+		Signature:         sig,
+	}
+}
+
+func marshal(t *testing.T, assertion *webauthn.Assertion) []byte {
+	t.Helper()
+	bz, err := assertion.Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestVerifySignature(t *testing.T) {
+	sk, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	pk := webauthn.NewPubKey(sk.PubKey().(*secp256r1.PubKey), rpID)
+	require.NoError(t, pk.Validate())
+
+	msg := []byte("sign bytes")
+	valid := sign(t, sk, rpID, "webauthn.get", 0x05, msg)
+
+	otherSK, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		msg      []byte
+		sig      []byte
+		expValid bool
+	}{
+		{"valid", msg, marshal(t, valid), true},
+		{"other message", []byte("other sign bytes"), marshal(t, valid), false},
+		{"other key", msg, marshal(t, sign(t, otherSK, rpID, "webauthn.get", 0x01, msg)), false},
+		{"other relying party", msg, marshal(t, sign(t, sk, "example.org", "webauthn.get", 0x01, msg)), false},
+		{"user not present", msg, marshal(t, sign(t, sk, rpID, "webauthn.get", 0x04, msg)), false},
+		{"registration", msg, marshal(t, sign(t, sk, rpID, "webauthn.create", 0x01, msg)), false},
+		{"short authenticator data", msg, marshal(t, &webauthn.Assertion{
+			AuthenticatorData: valid.AuthenticatorData[:36],
+			ClientDataJSON:    valid.ClientDataJSON,
+			Signature:         valid.Signature,
+		}), false},
+		{"raw signature", msg, valid.Signature, false},
+		{"empty signature", msg, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expValid, pk.VerifySignature(tc.msg, tc.sig))
+		})
+	}
+}
+
+func TestPubKey(t *testing.T) {
+	sk, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	key := sk.PubKey().(*secp256r1.PubKey)
+	pk := webauthn.NewPubKey(key, rpID)
+
+	require.Equal(t, "webauthn", pk.Type())
+	require.True(t, pk.Equals(webauthn.NewPubKey(key, rpID)))
+	require.False(t, pk.Equals(webauthn.NewPubKey(key, "example.org")))
+	require.False(t, pk.Equals(key))
+	require.NotEqual(t, key.Address(), pk.Address())
+	require.NotEqual(t, pk.Address(), webauthn.NewPubKey(key, "example.org").Address())
+
+	require.Error(t, webauthn.NewPubKey(key, "").Validate())
+	require.Error(t, (&webauthn.PubKey{RpID: rpID}).Validate())
+
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	var decoded cryptotypes.PubKey
+	bz, err := cdc.MarshalInterface(pk)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalInterface(bz, &decoded))
+	require.True(t, pk.Equals(decoded))
+
+	bz, err = cdc.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &decoded))
+	require.True(t, pk.Equals(decoded))
+}
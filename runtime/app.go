@@ -1,13 +1,16 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"slices"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 
 	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
@@ -17,6 +20,8 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
@@ -25,12 +30,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
+// tracingShutdownTimeout is the maximum time spent flushing the spans when the
+// app is closed.
+const tracingShutdownTimeout = 5 * time.Second
+
 // KeyGenF is a function that generates a private key for use by comet.
 type KeyGenF = func() (cmtcrypto.PrivKey, error)
 
@@ -62,6 +72,9 @@ type App struct {
 	// initChainer is the init chainer function defined by the app config.
 	// this is only required if the chain wants to add special InitChainer logic.
 	initChainer sdk.InitChainer
+	// shutdownTracing flushes the spans and shuts down the tracer provider
+	// registered when the node started, if tracing is enabled.
+	shutdownTracing func(context.Context) error
 }
 
 // RegisterModules registers the provided modules with the module manager and
@@ -172,6 +185,14 @@ func (a *App) Close() error {
 		}
 	}
 
+	if a.shutdownTracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := a.shutdownTracing(ctx); err != nil {
+			return fmt.Errorf("failed to shut down tracing: %w", err)
+		}
+	}
+
 	return a.BaseApp.Close()
 }
 
@@ -232,9 +253,56 @@ func (a *App) RegisterTendermintService(clientCtx client.Context) {
 	)
 }
 
+// RegisterGRPCGatewayRoutes implements the Application.RegisterGRPCGatewayRoutes
+// method. Along with the routes of the modules, it registers the routes of the
+// gas profile and gas estimate gRPC services if they are enabled in app.toml.
+func (a *App) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	a.ModuleManager.RegisterGRPCGatewayRoutes(clientCtx, mux)
+
+	if a.GasProfiler() != nil {
+		gasprofile.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	}
+	if a.GasEstimator() != nil {
+		gasestimate.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	}
+}
+
 // RegisterNodeService registers the node gRPC service on the app gRPC router.
+// As it is called with the app config when the node starts, it also enables the
+// gas profile block replay and the OpenTelemetry tracing of the node if they are
+// enabled in the config. The tracer provider is shut down when the app is closed.
 func (a *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg)
+
+	if cfg.GasProfile.EnableReplay && a.GasProfiler() != nil && clientCtx.Client != nil {
+		a.SetGasProfileReplay(cometBlockFetcher(clientCtx.Client), cfg.GasProfile.ReplayMaxGas)
+	}
+
+	if cfg.Telemetry.TracingEnabled && a.shutdownTracing == nil {
+		shutdown, err := telemetry.EnableTracing(context.Background(), cfg.Telemetry)
+		if err != nil {
+			a.logger.Error("failed to enable tracing", "err", err)
+			return
+		}
+		a.shutdownTracing = shutdown
+	}
+}
+
+// cometBlockFetcher returns a gas profile block fetcher loading the blocks from
+// the given CometBFT client.
+func cometBlockFetcher(rpc client.CometRPC) gasprofile.BlockFetcher {
+	return func(ctx context.Context, height int64) (time.Time, [][]byte, error) {
+		res, err := rpc.Block(ctx, &height)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+
+		txs := make([][]byte, len(res.Block.Txs))
+		for i, tx := range res.Block.Txs {
+			txs[i] = tx
+		}
+		return res.Block.Time, txs, nil
+	}
 }
 
 // Configurator returns the app's configurator.
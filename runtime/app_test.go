@@ -0,0 +1,79 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+func TestAppTracing(t *testing.T) {
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	logger := log.NewTestLogger(t)
+	app := &App{BaseApp: baseapp.NewBaseApp("test", logger, coretesting.NewMemDB(), nil), logger: logger}
+
+	file := filepath.Join(t.TempDir(), "traces.json")
+	cfg := config.DefaultConfig()
+	cfg.Telemetry.TracingEnabled = true
+	cfg.Telemetry.TracingExporter = telemetry.TracingExporterFile
+	cfg.Telemetry.TracingFile = file
+	app.RegisterNodeService(client.Context{}, *cfg)
+
+	_, span := telemetry.StartSpan(context.Background(), "test")
+	require.True(t, span.IsRecording())
+	span.End()
+
+	// the spans are flushed when the app is closed
+	require.NoError(t, app.Close())
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"Name":"test"`)
+}
+
+func TestAppGRPCGatewayRoutes(t *testing.T) {
+	logger := log.NewTestLogger(t)
+	app := &App{
+		BaseApp:       baseapp.NewBaseApp("test", logger, coretesting.NewMemDB(), nil, baseapp.SetGasEstimator(gasestimate.NewEstimator(1))),
+		ModuleManager: module.NewManager(),
+		logger:        logger,
+	}
+
+	// the gateway forwards the requests to a node which is not running
+	conn, err := grpc.NewClient("127.0.0.1:1", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	mux := gwruntime.NewServeMux()
+	app.RegisterGRPCGatewayRoutes(client.Context{}.WithGRPCClient(conn), mux)
+
+	serve := func(req *http.Request) int {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// only the routes of the enabled services are registered
+	require.NotEqual(t, http.StatusNotFound, serve(httptest.NewRequest(http.MethodPost, "/cosmos/base/gasestimate/v1beta1/estimate", strings.NewReader("{}"))))
+	require.Equal(t, http.StatusNotFound, serve(httptest.NewRequest(http.MethodGet, "/cosmos/base/gasprofile/v1beta1/profile", nil)))
+}
@@ -12,6 +12,8 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -19,6 +21,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
 )
 
+const (
+	// flagGasProfileEnable is the app.toml option enabling the gas profiler.
+	flagGasProfileEnable = "gas-profile.enable"
+	// flagGasEstimateBlocks is the app.toml option enabling the gas estimator.
+	flagGasEstimateBlocks = "gas-estimate.blocks"
+)
+
 // AppBuilder is a type that is injected into a container by the runtime module
 // (as *AppBuilder) which can be used to create an app which is compatible with
 // the existing app.go initialization conventions.
@@ -39,6 +48,18 @@ func (a *AppBuilder) Build(db corestore.KVStoreWithBatch, traceStore io.Writer,
 		baseAppOptions = append(baseAppOptions, option)
 	}
 
+	// enable the gas profiler if enabled in app.toml
+	if a.appOptions != nil && cast.ToBool(a.appOptions.Get(flagGasProfileEnable)) {
+		baseAppOptions = append(baseAppOptions, baseapp.SetGasProfiler(gasprofile.NewProfiler()))
+	}
+
+	// enable the gas estimator if enabled in app.toml
+	if a.appOptions != nil {
+		if blocks := cast.ToInt(a.appOptions.Get(flagGasEstimateBlocks)); blocks > 0 {
+			baseAppOptions = append(baseAppOptions, baseapp.SetGasEstimator(gasestimate.NewEstimator(blocks)))
+		}
+	}
+
 	// set routers first in case they get modified by other options
 	baseAppOptions = append(
 		[]func(*baseapp.BaseApp){
@@ -162,6 +162,30 @@ type MempoolConfig struct {
 	MaxTxs int `mapstructure:"max-txs"`
 }
 
+// GasProfileConfig defines the configuration of the gas profiling of the
+// messages executed by the node.
+type GasProfileConfig struct {
+	// Enable enables the profiling of the gas consumed and the time spent by the
+	// messages of the finalized blocks, and the gas profile gRPC service.
+	Enable bool `mapstructure:"enable"`
+
+	// EnableReplay enables the re-execution of the blocks of the node by the
+	// ReplayBlock query of the gas profile gRPC service.
+	EnableReplay bool `mapstructure:"enable-replay"`
+
+	// ReplayMaxGas defines the maximum gas consumed by a block replay.
+	ReplayMaxGas uint64 `mapstructure:"replay-max-gas"`
+}
+
+// GasEstimateConfig defines the configuration of the gas estimation of the
+// transactions submitted to the node.
+type GasEstimateConfig struct {
+	// Blocks defines the number of recent blocks whose transactions the gas
+	// limits and fees suggested by the gas estimate gRPC service are computed
+	// from. A value of 0 disables the gas estimation.
+	Blocks int `mapstructure:"blocks"`
+}
+
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
@@ -187,6 +211,9 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+
+	GasProfile  GasProfileConfig  `mapstructure:"gas-profile"`
+	GasEstimate GasEstimateConfig `mapstructure:"gas-estimate"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -225,8 +252,12 @@ func DefaultConfig() *Config {
 			AppDBBackend:        "",
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:           false,
+			GlobalLabels:      [][]string{},
+			TracingExporter:   telemetry.TracingExporterOTLPGRPC,
+			TracingEndpoint:   "localhost:4317",
+			TracingInsecure:   true,
+			TracingSampleRate: 1,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -255,6 +286,14 @@ func DefaultConfig() *Config {
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
 		},
+		GasProfile: GasProfileConfig{
+			Enable:       false,
+			EnableReplay: false,
+			ReplayMaxGas: 100_000_000,
+		},
+		GasEstimate: GasEstimateConfig{
+			Blocks: 0,
+		},
 	}
 }
 
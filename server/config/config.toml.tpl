@@ -121,6 +121,25 @@ statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 # Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
 
+# TracingEnabled enables OpenTelemetry tracing of the ABCI methods, transactions,
+# messages, store commits and queries.
+tracing-enabled = {{ .Telemetry.TracingEnabled }}
+
+# TracingExporter defines the exporter of the spans: "otlp-grpc", "otlp-http" or "file".
+tracing-exporter = "{{ .Telemetry.TracingExporter }}"
+
+# TracingEndpoint defines the address of the OTLP collector.
+tracing-endpoint = "{{ .Telemetry.TracingEndpoint }}"
+
+# TracingInsecure disables the transport security of the OTLP exporters.
+tracing-insecure = {{ .Telemetry.TracingInsecure }}
+
+# TracingFile defines the file the spans are written to with the "file" exporter.
+tracing-file = "{{ .Telemetry.TracingFile }}"
+
+# TracingSampleRate defines the ratio of traces sampled, between 0 and 1.
+tracing-sample-rate = {{ .Telemetry.TracingSampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -223,4 +242,33 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 #
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
-max-txs = {{ .Mempool.MaxTxs }}
\ No newline at end of file
+max-txs = {{ .Mempool.MaxTxs }}
+
+###############################################################################
+###                               Gas Profile                               ###
+###############################################################################
+
+[gas-profile]
+
+# Enable enables the profiling of the gas consumed and the time spent by the messages
+# of the finalized blocks, queried with the gas profile gRPC service.
+enable = {{ .GasProfile.Enable }}
+
+# EnableReplay enables the re-execution of past blocks by the ReplayBlock query of the
+# gas profile gRPC service. A replay can be expensive, it should only be enabled on
+# nodes whose gRPC endpoint is not public.
+enable-replay = {{ .GasProfile.EnableReplay }}
+
+# ReplayMaxGas defines the maximum gas consumed by a block replay.
+replay-max-gas = {{ .GasProfile.ReplayMaxGas }}
+
+###############################################################################
+###                               Gas Estimate                              ###
+###############################################################################
+
+[gas-estimate]
+
+# Blocks defines the number of recent blocks whose transactions the gas limits and fees
+# suggested by the gas estimate gRPC service are computed from. The gas estimation is
+# disabled when set to 0.
+blocks = {{ .GasEstimate.Blocks }}
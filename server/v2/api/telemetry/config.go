@@ -13,6 +13,12 @@ func DefaultConfig() *Config {
 		MetricsSink:             "",
 		StatsdAddr:              "",
 		DatadogHostname:         "",
+		TracingEnabled:          false,
+		TracingExporter:         TracingExporterOTLPGRPC,
+		TracingEndpoint:         "localhost:4317",
+		TracingInsecure:         true,
+		TracingFile:             "",
+		TracingSampleRate:       1,
 	}
 }
 
@@ -58,6 +64,26 @@ type Config struct {
 	// DatadogHostname defines the hostname to use when emitting metrics to
 	// Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 	DatadogHostname string `mapstructure:"datadog-hostname" toml:"data-dog-hostname" comment:"DatadogHostname defines the hostname to use when emitting metrics to Datadog. Only utilized if MetricsSink is set to \"dogstatsd\"."`
+
+	// TracingEnabled enables the OpenTelemetry tracing of the ABCI calls, transactions and messages.
+	TracingEnabled bool `mapstructure:"tracing-enabled" toml:"tracing-enabled" comment:"TracingEnabled enables the OpenTelemetry tracing of the ABCI calls, transactions and messages."`
+
+	// TracingExporter defines the type of trace exporter to use.
+	TracingExporter string `mapstructure:"tracing-exporter" toml:"tracing-exporter" comment:"TracingExporter defines the type of trace exporter to use: \"otlp-grpc\", \"otlp-http\" or \"file\"."`
+
+	// TracingEndpoint defines the address of the OTLP collector.
+	// Only utilized if TracingExporter is set to "otlp-grpc" or "otlp-http".
+	TracingEndpoint string `mapstructure:"tracing-endpoint" toml:"tracing-endpoint" comment:"TracingEndpoint defines the address of the OTLP collector. Only utilized if TracingExporter is set to \"otlp-grpc\" or \"otlp-http\"."`
+
+	// TracingInsecure disables the transport security with the OTLP collector.
+	TracingInsecure bool `mapstructure:"tracing-insecure" toml:"tracing-insecure" comment:"TracingInsecure disables the transport security with the OTLP collector."`
+
+	// TracingFile defines the file the spans are written to as JSON.
+	// Only utilized if TracingExporter is set to "file".
+	TracingFile string `mapstructure:"tracing-file" toml:"tracing-file" comment:"TracingFile defines the file the spans are written to as JSON. Only utilized if TracingExporter is set to \"file\"."`
+
+	// TracingSampleRate defines the ratio, between 0 and 1, of the traces to sample.
+	TracingSampleRate float64 `mapstructure:"tracing-sample-rate" toml:"tracing-sample-rate" comment:"TracingSampleRate defines the ratio, between 0 and 1, of the traces to sample."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
@@ -3,6 +3,7 @@ package telemetry
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -26,6 +27,8 @@ type Server[T transaction.Tx] struct {
 	cfgOptions []CfgOption
 	server     *http.Server
 	metrics    *Metrics
+	// shutdownTracing flushes the remaining spans and shuts down the tracer provider.
+	shutdownTracing func(context.Context) error
 }
 
 // New creates a new telemetry server.
@@ -54,6 +57,12 @@ func New[T transaction.Tx](cfg server.ConfigMap, logger log.Logger, enableTeleme
 		return nil, fmt.Errorf("failed to initialize metrics: %w", err)
 	}
 	srv.metrics = metrics
+
+	srv.shutdownTracing, err = EnableTracing(context.Background(), srv.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize tracing: %w", err)
+	}
+
 	mux := http.NewServeMux()
 	// /metrics is the default standard path for Prometheus metrics.
 	mux.HandleFunc("/metrics", srv.metricsHandler)
@@ -111,12 +120,17 @@ func (s *Server[T]) Start(ctx context.Context) error {
 }
 
 func (s *Server[T]) Stop(ctx context.Context) error {
+	var err error
+	if s.shutdownTracing != nil {
+		err = s.shutdownTracing(ctx)
+	}
+
 	if !s.config.Enable || s.server == nil {
-		return nil
+		return err
 	}
 
 	s.logger.Info("stopping telemetry server...", "address", s.config.Address)
-	return s.server.Shutdown(ctx)
+	return errors.Join(err, s.server.Shutdown(ctx))
 }
 
 func (s *Server[T]) metricsHandler(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,133 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer used by the server instrumentation.
+const TracerName = "cosmossdk.io/server/v2"
+
+// Tracing exporter types.
+const (
+	TracingExporterOTLPGRPC = "otlp-grpc"
+	TracingExporterOTLPHTTP = "otlp-http"
+	TracingExporterFile     = "file"
+)
+
+// Span attribute keys set by the server instrumentation.
+const (
+	AttributeKeyBlockHeight = "block.height"
+	AttributeKeyQueryPath   = "query.path"
+)
+
+// Tracer returns the server tracer of the global tracer provider. Spans are no-op
+// until a tracer provider is registered with EnableTracing.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartSpan starts a span as a child of the span of the given context, if any.
+// A nil context is treated as an empty context.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the error, if any, on the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// EnableTracing creates a tracer provider exporting spans as configured and
+// registers it as the global OpenTelemetry tracer provider. The returned function
+// flushes the remaining spans and shuts down the tracer provider. It is a no-op
+// when tracing is disabled.
+func EnableTracing(ctx context.Context, cfg *Config) (shutdown func(context.Context) error, err error) {
+	shutdown = func(context.Context) error { return nil }
+	if !cfg.TracingEnabled {
+		return shutdown, nil
+	}
+
+	if cfg.TracingSampleRate < 0 || cfg.TracingSampleRate > 1 {
+		return nil, fmt.Errorf("tracing sample rate must be between 0 and 1, got %f", cfg.TracingSampleRate)
+	}
+
+	var (
+		exporter sdktrace.SpanExporter
+		file     *os.File
+	)
+	switch cfg.TracingExporter {
+	case TracingExporterOTLPGRPC, "":
+		opts := []otlptracegrpc.Option{}
+		if cfg.TracingEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.TracingEndpoint))
+		}
+		if cfg.TracingInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case TracingExporterOTLPHTTP:
+		opts := []otlptracehttp.Option{}
+		if cfg.TracingEndpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(cfg.TracingEndpoint))
+		}
+		if cfg.TracingInsecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case TracingExporterFile:
+		if cfg.TracingFile == "" {
+			return nil, errors.New("tracing file must be set with the file exporter")
+		}
+		file, err = os.OpenFile(cfg.TracingFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open tracing file: %w", err)
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.TracingExporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing exporter: %w", err)
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = "cosmos-sdk"
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingSampleRate))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		if file != nil {
+			err = errors.Join(err, file.Close())
+		}
+		return err
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogoproto "github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 
@@ -25,6 +26,7 @@ import (
 	errorsmod "cosmossdk.io/errors/v2"
 	"cosmossdk.io/log"
 	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/server/v2/api/telemetry"
 	"cosmossdk.io/server/v2/appmanager"
 	"cosmossdk.io/server/v2/cometbft/handlers"
 	"cosmossdk.io/server/v2/cometbft/mempool"
@@ -34,6 +36,8 @@ import (
 	"cosmossdk.io/server/v2/streaming"
 	"cosmossdk.io/store/v2/snapshots"
 	consensustypes "cosmossdk.io/x/consensus/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
 )
 
 const (
@@ -83,11 +87,18 @@ type consensus[T transaction.Tx] struct {
 	queryHandlersMap map[string]appmodulev2.Handler
 	getProtoRegistry func() (*protoregistry.Files, error)
 	cfgMap           server.ConfigMap
+
+	// gasEstimator records the gas used and the gas prices of the transactions
+	// of the finalized blocks, nil if gas estimation is disabled.
+	gasEstimator *gasestimate.Estimator
 }
 
 // CheckTx implements types.Application.
 // It is called by cometbft to verify transaction validity
-func (c *consensus[T]) CheckTx(ctx context.Context, req *abciproto.CheckTxRequest) (*abciproto.CheckTxResponse, error) {
+func (c *consensus[T]) CheckTx(ctx context.Context, req *abciproto.CheckTxRequest) (_ *abciproto.CheckTxResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "CheckTx")
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	decodedTx, err := c.appCodecs.TxCodec.Decode(req.Tx)
 	if err != nil {
 		return nil, err
@@ -174,6 +185,12 @@ func (c *consensus[T]) Info(ctx context.Context, _ *abciproto.InfoRequest) (*abc
 // Query implements types.Application.
 // It is called by cometbft to query application state.
 func (c *consensus[T]) Query(ctx context.Context, req *abciproto.QueryRequest) (resp *abciproto.QueryResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "Query",
+		attribute.String(telemetry.AttributeKeyQueryPath, req.Path),
+		attribute.Int64(telemetry.AttributeKeyBlockHeight, req.Height),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	resp, isGRPC, err := c.maybeRunGRPCQuery(ctx, req)
 	if isGRPC {
 		return resp, err
@@ -276,7 +293,10 @@ func (c *consensus[T]) maybeRunGRPCQuery(ctx context.Context, req *abci.QueryReq
 }
 
 // InitChain implements types.Application.
-func (c *consensus[T]) InitChain(ctx context.Context, req *abciproto.InitChainRequest) (*abciproto.InitChainResponse, error) {
+func (c *consensus[T]) InitChain(ctx context.Context, req *abciproto.InitChainRequest) (_ *abciproto.InitChainResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "InitChain", attribute.Int64(telemetry.AttributeKeyBlockHeight, req.InitialHeight))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	c.logger.Info("InitChain", "initialHeight", req.InitialHeight, "chainID", req.ChainId)
 
 	// store chainID to be used later on in execution
@@ -368,6 +388,9 @@ func (c *consensus[T]) PrepareProposal(
 		return nil, errors.New("PrepareProposal called with invalid height")
 	}
 
+	ctx, span := telemetry.StartSpan(ctx, "PrepareProposal", attribute.Int64(telemetry.AttributeKeyBlockHeight, req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if c.prepareProposalHandler == nil {
 		return nil, errors.New("no prepare proposal function was set")
 	}
@@ -407,11 +430,14 @@ func (c *consensus[T]) PrepareProposal(
 func (c *consensus[T]) ProcessProposal(
 	ctx context.Context,
 	req *abciproto.ProcessProposalRequest,
-) (*abciproto.ProcessProposalResponse, error) {
+) (_ *abciproto.ProcessProposalResponse, err error) {
 	if req.Height < 1 {
 		return nil, errors.New("ProcessProposal called with invalid height")
 	}
 
+	ctx, span := telemetry.StartSpan(ctx, "ProcessProposal", attribute.Int64(telemetry.AttributeKeyBlockHeight, req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if c.processProposalHandler == nil {
 		return nil, errors.New("no process proposal function was set")
 	}
@@ -433,7 +459,7 @@ func (c *consensus[T]) ProcessProposal(
 		LastCommit:      toCoreCommitInfo(req.ProposedLastCommit),
 	})
 
-	err := c.processProposalHandler(ciCtx, c.app, c.appCodecs.TxCodec, req, c.chainID)
+	err = c.processProposalHandler(ciCtx, c.app, c.appCodecs.TxCodec, req, c.chainID)
 	if err != nil {
 		c.logger.Error("failed to process proposal", "height", req.Height, "time", req.Time, "hash", fmt.Sprintf("%X", req.Hash), "err", err)
 		return &abciproto.ProcessProposalResponse{
@@ -462,12 +488,14 @@ func (c *consensus[T]) ProcessProposal(
 func (c *consensus[T]) FinalizeBlock(
 	ctx context.Context,
 	req *abciproto.FinalizeBlockRequest,
-) (*abciproto.FinalizeBlockResponse, error) {
+) (_ *abciproto.FinalizeBlockResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "FinalizeBlock", attribute.Int64(telemetry.AttributeKeyBlockHeight, req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	var (
 		resp       *server.BlockResponse
 		newState   store.WriterMap
 		decodedTxs []T
-		err        error
 	)
 
 	if c.optimisticExec.Initialized() {
@@ -505,7 +533,9 @@ func (c *consensus[T]) FinalizeBlock(
 	if err != nil {
 		return nil, err
 	}
+	_, storeSpan := telemetry.StartSpan(ctx, "store.Commit")
 	appHash, err := c.store.Commit(&store.Changeset{Version: uint64(req.Height), Changes: stateChanges})
+	telemetry.EndSpan(storeSpan, err)
 	if err != nil {
 		return nil, fmt.Errorf("unable to commit the changeset: %w", err)
 	}
@@ -532,6 +562,7 @@ func (c *consensus[T]) FinalizeBlock(
 	}
 
 	c.lastCommittedHeight.Store(req.Height)
+	c.recordGasEstimate(decodedTxs, resp.TxResults)
 
 	cp, err := GetConsensusParams(ctx, c.app) // we get the consensus params from the latest state because we committed state above
 	if err != nil {
@@ -595,9 +626,12 @@ func (c *consensus[T]) internalFinalizeBlock(
 
 // Commit implements types.Application.
 // It is called by cometbft to notify the application that a block was committed.
-func (c *consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (*abciproto.CommitResponse, error) {
+func (c *consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (_ *abciproto.CommitResponse, err error) {
 	lastCommittedHeight := c.lastCommittedHeight.Load()
 
+	ctx, span := telemetry.StartSpan(ctx, "Commit", attribute.Int64(telemetry.AttributeKeyBlockHeight, lastCommittedHeight))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	c.snapshotManager.SnapshotIfApplicable(lastCommittedHeight)
 
 	cp, err := GetConsensusParams(ctx, c.app)
@@ -616,7 +650,10 @@ func (c *consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (
 func (c *consensus[T]) VerifyVoteExtension(
 	ctx context.Context,
 	req *abciproto.VerifyVoteExtensionRequest,
-) (*abciproto.VerifyVoteExtensionResponse, error) {
+) (_ *abciproto.VerifyVoteExtensionResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "VerifyVoteExtension", attribute.Int64(telemetry.AttributeKeyBlockHeight, req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// If vote extensions are not enabled, as a safety precaution, we return an
 	// error.
 	cp, err := GetConsensusParams(ctx, c.app)
@@ -655,7 +692,10 @@ func (c *consensus[T]) VerifyVoteExtension(
 }
 
 // ExtendVote implements types.Application.
-func (c *consensus[T]) ExtendVote(ctx context.Context, req *abciproto.ExtendVoteRequest) (*abciproto.ExtendVoteResponse, error) {
+func (c *consensus[T]) ExtendVote(ctx context.Context, req *abciproto.ExtendVoteRequest) (_ *abciproto.ExtendVoteResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "ExtendVote", attribute.Int64(telemetry.AttributeKeyBlockHeight, req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// If vote extensions are not enabled, as a safety precaution, we return an
 	// error.
 	cp, err := GetConsensusParams(ctx, c.app)
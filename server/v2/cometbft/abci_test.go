@@ -35,6 +35,7 @@ import (
 	"cosmossdk.io/server/v2/stf/mock"
 	consensustypes "cosmossdk.io/x/consensus/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -304,6 +305,43 @@ func TestConsensus_FinalizeBlock_MultiTxs(t *testing.T) {
 	require.Equal(t, int64(endBlock), c.lastCommittedHeight.Load())
 }
 
+func TestConsensus_GasEstimate(t *testing.T) {
+	c := setUpConsensus(t, 100_000, mempool.NoOpMempool[mock.Tx]{})
+	c.gasEstimator = gasestimate.NewEstimator(gasestimate.DefaultWindow)
+
+	_, err := c.InitChain(context.Background(), &abciproto.InitChainRequest{
+		Time:          time.Now(),
+		ChainId:       "test",
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+
+	for i := 1; i <= 2; i++ {
+		_, err = c.FinalizeBlock(context.Background(), &abciproto.FinalizeBlockRequest{
+			Time:   time.Now(),
+			Height: int64(i),
+			Hash:   sum[:],
+			Txs:    [][]byte{mockTx.Bytes()},
+		})
+		require.NoError(t, err)
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(&gogotypes.BoolValue{})
+	_, _, samples := c.gasEstimator.SuggestGasLimit([]string{msgTypeURL}, 1000)
+	require.Equal(t, uint64(2), samples)
+
+	queryServer := gasestimate.NewQueryServer(gasEstimateApp[mock.Tx]{c.appCodecs.TxCodec, c.app}, c.gasEstimator)
+	res, err := queryServer.EstimateGas(context.Background(), &gasestimate.EstimateGasRequest{TxBytes: mockTx.Bytes()})
+	require.NoError(t, err)
+	require.Len(t, res.Msgs, 1)
+	require.Equal(t, msgTypeURL, res.Msgs[0].MsgTypeUrl)
+	require.Equal(t, uint64(1), res.Msgs[0].Count)
+	require.Equal(t, uint64(2), res.Samples)
+
+	_, err = queryServer.EstimateGas(context.Background(), &gasestimate.EstimateGasRequest{TxBytes: []byte("invalid")})
+	require.Error(t, err)
+}
+
 func TestConsensus_CheckTx(t *testing.T) {
 	c := setUpConsensus(t, 0, mempool.NoOpMempool[mock.Tx]{})
 
@@ -30,6 +30,7 @@ func DefaultAppTomlConfig() *AppTomlConfig {
 		IndexABCIEvents:        make([]string, 0),
 		DisableIndexABCIEvents: false,
 		DisableABCIEvents:      false,
+		GasEstimateBlocks:      0,
 	}
 }
 
@@ -48,6 +49,7 @@ type AppTomlConfig struct {
 	IndexABCIEvents        []string               `mapstructure:"index-abci-events" toml:"index-abci-events" comment:"index-abci-events defines the set of events in the form {eventType}.{attributeKey}, which informs CometBFT what to index. If empty, all events will be indexed."`
 	DisableIndexABCIEvents bool                   `mapstructure:"disable-index-abci-events" toml:"disable-index-abci-events" comment:"disable-index-abci-events disables the ABCI event indexing done by CometBFT. Useful when relying on the SDK indexer for event indexing, but still want events to be included in FinalizeBlockResponse."`
 	DisableABCIEvents      bool                   `mapstructure:"disable-abci-events" toml:"disable-abci-events" comment:"disable-abci-events disables all ABCI events. Useful when relying on the SDK indexer for event indexing."`
+	GasEstimateBlocks      int                    `mapstructure:"gas-estimate-blocks" toml:"gas-estimate-blocks" comment:"gas-estimate-blocks defines the number of recent blocks whose transactions the gas limits and fees suggested by the gas estimate gRPC service and REST routes are computed from. The gas estimation is disabled when set to 0."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
@@ -0,0 +1,110 @@
+package cometbft
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/gas"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/stf"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
+	"github.com/cosmos/cosmos-sdk/baseapp/gasprofile"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ gasestimate.App = gasEstimateApp[transaction.Tx]{}
+
+// gasEstimateApp simulates the transactions estimated by the gas estimate
+// service.
+type gasEstimateApp[T transaction.Tx] struct {
+	txCodec transaction.Codec[T]
+	app     appSimulator[T]
+}
+
+// SimulateGasProfile implements gasestimate.App.
+func (a gasEstimateApp[T]) SimulateGasProfile(ctx context.Context, txBytes []byte) (uint64, *gasprofile.GasProfile, error) {
+	tx, err := a.txCodec.Decode(txBytes)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.InvalidArgument, "failed to decode tx: %v", err)
+	}
+
+	profiler := gasprofile.NewProfiler()
+	txResult, _, err := a.app.Simulate(stf.ContextWithGasRecorder(ctx, &gasProfileRecorder{profiler: profiler}), tx)
+	if err != nil {
+		return 0, nil, err
+	}
+	if txResult.Error != nil {
+		return txResult.GasUsed, nil, txResult.Error
+	}
+
+	return txResult.GasUsed, profiler.Profile(), nil
+}
+
+var _ stf.GasRecorder = (*gasProfileRecorder)(nil)
+
+// gasProfileRecorder records the gas consumed by the messages of a transaction
+// in a gas profiler. The store of an actor is profiled under its name.
+type gasProfileRecorder struct {
+	profiler *gasprofile.Profiler
+	meter    *gasprofile.Meter
+}
+
+// BeginMsg implements stf.GasRecorder.
+func (r *gasProfileRecorder) BeginMsg(msg transaction.Msg) {
+	// the gas is metered by the state transition function, the profiler meter
+	// only records it
+	r.meter = r.profiler.Meter(sdk.MsgTypeURL(msg), storetypes.NewInfiniteGasMeter())
+}
+
+// ConsumeGas implements stf.GasRecorder.
+func (r *gasProfileRecorder) ConsumeGas(actor []byte, amount gas.Gas, descriptor string) {
+	if actor == nil {
+		r.meter.ConsumeGas(amount, descriptor)
+		return
+	}
+
+	r.meter.ForStore(string(actor)).ConsumeGas(amount, descriptor)
+}
+
+// EndMsg implements stf.GasRecorder.
+func (r *gasProfileRecorder) EndMsg() {
+	r.meter.Finish()
+}
+
+// recordGasEstimate records the transactions successfully executed in a
+// finalized block in the gas estimator, if enabled.
+func (c *consensus[T]) recordGasEstimate(txs []T, txResults []server.TxResult) {
+	if c.gasEstimator == nil {
+		return
+	}
+
+	for i, tx := range txs {
+		if i >= len(txResults) || txResults[i].Error != nil {
+			continue
+		}
+
+		msgs, err := tx.GetMessages()
+		if err != nil {
+			continue
+		}
+
+		msgTypeURLs := make([]string, len(msgs))
+		for j, msg := range msgs {
+			msgTypeURLs[j] = sdk.MsgTypeURL(msg)
+		}
+
+		var fee sdk.Coins
+		if feeTx, ok := any(tx).(sdk.FeeTx); ok {
+			fee = feeTx.GetFee()
+		}
+
+		c.gasEstimator.RecordTx(msgTypeURLs, txResults[i].GasWanted, txResults[i].GasUsed, fee)
+	}
+
+	c.gasEstimator.EndBlock()
+}
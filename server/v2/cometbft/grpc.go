@@ -22,6 +22,7 @@ import (
 	"cosmossdk.io/log"
 	storeserver "cosmossdk.io/server/v2/store"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
@@ -49,11 +50,15 @@ func gRPCServiceRegistrar[T transaction.Tx](
 	txCodec transaction.Codec[T],
 	consensus abci.Application,
 	app appSimulator[T],
+	gasEstimator *gasestimate.Estimator,
 ) func(srv *grpc.Server) error {
 	return func(srv *grpc.Server) error {
 		cmtservice.RegisterServiceServer(srv, cmtservice.NewQueryServer(clientCtx.Client, consensus.Query, clientCtx.ConsensusAddressCodec))
 		txtypes.RegisterServiceServer(srv, txServer[T]{clientCtx, txCodec, app, consensus})
 		nodeservice.RegisterServiceServer(srv, nodeServer[T]{cfg, cometBFTAppConfig, consensus})
+		if gasEstimator != nil {
+			gasestimate.RegisterServiceServer(srv, gasestimate.NewQueryServer(gasEstimateApp[T]{txCodec, app}, gasEstimator))
+		}
 
 		return nil
 	}
@@ -10,6 +10,8 @@ import (
 	"cosmossdk.io/server/v2/cometbft/types"
 	"cosmossdk.io/server/v2/streaming"
 	"cosmossdk.io/store/v2/snapshots"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
 )
 
 type keyGenF = func() (cmtcrypto.PrivKey, error)
@@ -35,6 +37,11 @@ type ServerOptions[T transaction.Tx] struct {
 
 	AddrPeerFilter types.PeerFilter // filter peers by address and port
 	IdPeerFilter   types.PeerFilter // filter peers by node ID
+
+	// GasEstimator enables the recording of the gas used and the gas prices of
+	// the transactions of the finalized blocks, and the gas estimate gRPC
+	// service, which suggests gas limits and fees from them.
+	GasEstimator *gasestimate.Estimator
 }
 
 // DefaultServerOptions returns the default server options.
@@ -40,6 +40,7 @@ import (
 	"cosmossdk.io/server/v2/cometbft/types"
 	"cosmossdk.io/store/v2/snapshots"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -120,6 +121,11 @@ func New[T transaction.Tx](
 		AppTomlConfig:    appTomlConfig,
 	}
 
+	// enable the gas estimator if enabled in app.toml and not set by the app
+	if srv.serverOptions.GasEstimator == nil && appTomlConfig.GasEstimateBlocks > 0 {
+		srv.serverOptions.GasEstimator = gasestimate.NewEstimator(appTomlConfig.GasEstimateBlocks)
+	}
+
 	chainID, _ := cfg[FlagChainID].(string)
 	if chainID == "" {
 		// fallback to genesis chain-id
@@ -204,6 +210,7 @@ func New[T transaction.Tx](
 		addrPeerFilter:         srv.serverOptions.AddrPeerFilter,
 		idPeerFilter:           srv.serverOptions.IdPeerFilter,
 		cfgMap:                 cfg,
+		gasEstimator:           srv.serverOptions.GasEstimator,
 	}
 
 	c.optimisticExec = oe.NewOptimisticExecution(
@@ -362,6 +369,12 @@ func (s *CometBFTServer[T]) CLICommands() serverv2.CLIConfig {
 	}
 }
 
+// GasEstimator returns the estimator of the finalized blocks, nil if gas
+// estimation is disabled.
+func (s *CometBFTServer[T]) GasEstimator() *gasestimate.Estimator {
+	return s.serverOptions.GasEstimator
+}
+
 // CometBFT is a special server, it has config in config.toml and app.toml
 
 // Config returns the (app.toml) server configuration.
@@ -397,5 +410,5 @@ func (s *CometBFTServer[T]) GRPCServiceRegistrar(
 	clientCtx client.Context,
 	cfg server.ConfigMap,
 ) func(srv *grpc.Server) error {
-	return gRPCServiceRegistrar[T](clientCtx, cfg, s.Config().(*AppTomlConfig), s.txCodec, s.Consensus, s.app)
+	return gRPCServiceRegistrar[T](clientCtx, cfg, s.Config().(*AppTomlConfig), s.txCodec, s.Consensus, s.app, s.serverOptions.GasEstimator)
 }
@@ -37,3 +37,17 @@ func (g gasService) GasMeter(ctx context.Context) gas.Meter {
 
 	return exCtx.meter
 }
+
+// BlockGasMeter returns the gas meter of the transactions of the current block,
+// or a no-op meter outside of a block.
+func (g gasService) BlockGasMeter(ctx context.Context) gas.Meter {
+	exCtx, err := getExecutionCtxFromContext(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	if exCtx.blockGasMeter == nil {
+		return stfgas.NoOpMeter{}
+	}
+	return exCtx.blockGasMeter
+}
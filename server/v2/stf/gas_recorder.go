@@ -0,0 +1,100 @@
+package stf
+
+import (
+	"context"
+
+	"cosmossdk.io/core/gas"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+// GasRecorder records the gas consumed by the messages of the transactions
+// executed with a context returned by ContextWithGasRecorder, e.g. to profile
+// the gas of a simulated transaction. The gas consumed by the validation of the
+// transactions is not recorded.
+type GasRecorder interface {
+	// BeginMsg is called before the execution of a message.
+	BeginMsg(msg transaction.Msg)
+	// ConsumeGas is called for each gas consumption of the message being
+	// executed, with the actor of the accessed store, or nil if the gas is
+	// not consumed by a store access.
+	ConsumeGas(actor []byte, amount gas.Gas, descriptor string)
+	// EndMsg is called after the execution of a message, even if it failed.
+	EndMsg()
+}
+
+type gasRecorderContextKey struct{}
+
+// ContextWithGasRecorder returns a context recording the gas consumed by the
+// messages of the transactions executed with it in the given recorder.
+func ContextWithGasRecorder(ctx context.Context, recorder GasRecorder) context.Context {
+	return context.WithValue(ctx, gasRecorderContextKey{}, recorder)
+}
+
+// gasRecorderFromContext returns the gas recorder of the context, if any.
+func gasRecorderFromContext(ctx context.Context) GasRecorder {
+	recorder, _ := ctx.Value(gasRecorderContextKey{}).(GasRecorder)
+	return recorder
+}
+
+// recordGas records the gas consumed in the execution context, from now on, in
+// the given recorder.
+func (e *executionContext) recordGas(recorder GasRecorder) {
+	e.state = recordingWriterMap{
+		WriterMap:           e.state,
+		unmeteredState:      e.unmeteredState,
+		meter:               e.meter,
+		recorder:            recorder,
+		makeGasMeteredState: e.makeGasMeteredStore,
+		writers:             make(map[string]store.Writer),
+	}
+	e.meter = recordingMeter{Meter: e.meter, recorder: recorder}
+}
+
+// recordingMeter is a gas.Meter recording the gas it consumes.
+type recordingMeter struct {
+	gas.Meter
+
+	actor    []byte
+	recorder GasRecorder
+}
+
+// Consume implements the gas.Meter interface.
+func (m recordingMeter) Consume(amount gas.Gas, descriptor string) error {
+	if err := m.Meter.Consume(amount, descriptor); err != nil {
+		return err
+	}
+
+	m.recorder.ConsumeGas(m.actor, amount, descriptor)
+	return nil
+}
+
+// recordingWriterMap is a gas metered store.WriterMap recording the gas
+// consumed by the accesses to the store of each actor. The state changes go
+// through the wrapped metered state.
+type recordingWriterMap struct {
+	store.WriterMap
+
+	unmeteredState      store.WriterMap
+	meter               gas.Meter
+	recorder            GasRecorder
+	makeGasMeteredState makeGasMeteredStateFn
+	writers             map[string]store.Writer
+}
+
+func (m recordingWriterMap) GetReader(actor []byte) (store.Reader, error) { return m.GetWriter(actor) }
+
+func (m recordingWriterMap) GetWriter(actor []byte) (store.Writer, error) {
+	if writer, ok := m.writers[string(actor)]; ok {
+		return writer, nil
+	}
+
+	meter := recordingMeter{Meter: m.meter, actor: actor, recorder: m.recorder}
+	writer, err := m.makeGasMeteredState(meter, m.unmeteredState).GetWriter(actor)
+	if err != nil {
+		return nil, err
+	}
+
+	m.writers[string(actor)] = writer
+	return writer, nil
+}
@@ -8,9 +8,14 @@ require (
 	cosmossdk.io/schema v1.0.0
 	github.com/cosmos/gogoproto v1.7.0
 	github.com/tidwall/btree v1.7.0
+	go.opentelemetry.io/otel v1.28.0
+	go.opentelemetry.io/otel/trace v1.28.0
 )
 
 require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
+	go.opentelemetry.io/otel/metric v1.28.0 // indirect
 	google.golang.org/protobuf v1.36.1 // indirect
 )
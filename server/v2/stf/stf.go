@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	corecontext "cosmossdk.io/core/context"
 	"cosmossdk.io/core/event"
@@ -92,6 +94,9 @@ func (s STF[T]) DeliverBlock(
 	block *server.BlockRequest[T],
 	state store.ReaderMap,
 ) (blockResult *server.BlockResponse, newState store.WriterMap, err error) {
+	ctx, span := startSpan(ctx, "DeliverBlock", attribute.Int64(attributeKeyBlockHeight, int64(block.Height)))
+	defer func() { endSpan(span, err) }()
+
 	// creates a new branchFn state, from the readonly view of the state
 	// that can be written to.
 	newState = s.branchFn(state)
@@ -110,6 +115,7 @@ func (s STF[T]) DeliverBlock(
 
 	exCtx := s.makeContext(ctx, ConsensusIdentity, newState, internal.ExecModeFinalize)
 	exCtx.setHeaderInfo(hi)
+	exCtx.blockGasMeter = stfgas.NewMeter(gas.NoGasLimit)
 
 	// reset events
 	exCtx.events = make([]event.Event, 0)
@@ -149,6 +155,9 @@ func (s STF[T]) DeliverBlock(
 			return nil, nil, err
 		}
 		txResults[i] = s.deliverTx(exCtx, newState, txBytes, transaction.ExecModeFinalize, hi, int32(i+1))
+		if err = exCtx.blockGasMeter.Consume(txResults[i].GasUsed, "block gas"); err != nil {
+			return nil, nil, err
+		}
 	}
 	// reset events
 	exCtx.events = make([]event.Event, 0)
@@ -175,7 +184,16 @@ func (s STF[T]) deliverTx(
 	execMode transaction.ExecMode,
 	hi header.Info,
 	txIndex int32,
-) server.TxResult {
+) (txResult server.TxResult) {
+	spanCtx, span := startSpan(ctx, "DeliverTx",
+		txHashAttribute(tx.Hash()),
+		attribute.Int(attributeKeyExecMode, int(execMode)),
+	)
+	defer func() { endSpan(span, txResult.Error) }()
+	if span.IsRecording() {
+		ctx = spanCtx
+	}
+
 	// recover in the case of a panic
 	var recoveryError error
 	defer func() {
@@ -346,11 +364,21 @@ func (s STF[T]) runTxMsgs(
 	execCtx := s.makeContext(ctx, RuntimeIdentity, state, execMode)
 	execCtx.setHeaderInfo(hi)
 	execCtx.setGasLimit(gasLimit)
+	recorder := gasRecorderFromContext(ctx)
+	if recorder != nil {
+		execCtx.recordGas(recorder)
+	}
 	events := make([]event.Event, 0)
 	for i, msg := range msgs {
 		execCtx.sender = txSenders[i]
 		execCtx.events = make([]event.Event, 0) // reset events
+		if recorder != nil {
+			recorder.BeginMsg(msg)
+		}
 		resp, err := s.msgRouter.Invoke(execCtx, msg)
+		if recorder != nil {
+			recorder.EndMsg()
+		}
 		if err != nil {
 			return nil, 0, nil, err // do not wrap the error or we lose the original error type
 		}
@@ -525,6 +553,9 @@ func (s STF[T]) Query(
 	if err != nil {
 		return nil, err
 	}
+	ctx, span := startSpan(ctx, "Query")
+	defer span.End()
+
 	queryCtx := s.makeContext(ctx, nil, queryState, internal.ExecModeSimulate)
 	queryCtx.setHeaderInfo(hi)
 	queryCtx.setGasLimit(gasLimit)
@@ -560,6 +591,9 @@ type executionContext struct {
 	state store.WriterMap
 	// meter is the gas meter.
 	meter gas.Meter
+	// blockGasMeter is the gas consumed by the transactions of the block, nil
+	// outside of a block.
+	blockGasMeter gas.Meter
 	// events are the current events.
 	events []event.Event
 	// sender is the causer of the state transition.
@@ -616,7 +650,7 @@ func (s STF[T]) makeContext(
 	execMode transaction.ExecMode,
 ) *executionContext {
 	valuedCtx := context.WithValue(ctx, corecontext.ExecModeKey, execMode)
-	return newExecutionContext(
+	exCtx := newExecutionContext(
 		valuedCtx,
 		s.makeGasMeter,
 		s.makeGasMeteredState,
@@ -627,6 +661,11 @@ func (s STF[T]) makeContext(
 		s.msgRouter,
 		s.queryRouter,
 	)
+	// the contexts of the transactions of a block share its block gas meter
+	if parent, err := getExecutionCtxFromContext(ctx); err == nil {
+		exCtx.blockGasMeter = parent.blockGasMeter
+	}
+	return exCtx
 }
 
 func newExecutionContext(
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/router"
@@ -166,5 +167,11 @@ func (r coreRouterImpl) Invoke(ctx context.Context, req transaction.Msg) (res tr
 		return nil, fmt.Errorf("%w: %s", ErrNoHandler, typeName)
 	}
 
+	spanCtx, span := startSpan(ctx, typeName, attribute.String(attributeKeyMsgTypeURL, "/"+typeName))
+	defer func() { endSpan(span, err) }()
+	if span.IsRecording() {
+		ctx = spanCtx
+	}
+
 	return handler(ctx, req)
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
@@ -341,6 +342,63 @@ func TestSTF(t *testing.T) {
 		}
 	})
 
+	t.Run("record exec tx gas", func(t *testing.T) {
+		recorder := &gasRecorder{}
+		ctx := ContextWithGasRecorder(context.Background(), recorder)
+		result, _ := s.Simulate(ctx, state, mockTx.GasLimit, mockTx)
+		if result.Error != nil {
+			t.Errorf("Simulate error: %v", result.Error)
+		}
+
+		// only the gas of the message is recorded, attributed to the accessed store
+		if len(recorder.msgs) != 1 || recorder.msgs[0] != "google.protobuf.BoolValue" {
+			t.Errorf("Expected the gas of a google.protobuf.BoolValue message to be recorded, got %v", recorder.msgs)
+		}
+		if recorder.open {
+			t.Error("Expected the message gas recording to be ended")
+		}
+		storeGas := recorder.gas[string(actorName)]
+		if storeGas == 0 || storeGas != recorder.total {
+			t.Errorf("Expected the gas to be consumed by the %s store, got %v", actorName, recorder.gas)
+		}
+		if storeGas >= result.GasUsed {
+			t.Errorf("Expected the recorded gas %d to be lower than the gas used %d", storeGas, result.GasUsed)
+		}
+	})
+
+	t.Run("block gas meter", func(t *testing.T) {
+		s := s.clone()
+		var endBlockGas, txBlockGas coregas.Gas
+		s.doEndBlock = func(ctx context.Context) error {
+			endBlockGas = gasService{}.BlockGasMeter(ctx).Consumed()
+			return nil
+		}
+		s.postTxExec = func(ctx context.Context, tx mock.Tx, success bool) error {
+			txBlockGas = gasService{}.BlockGasMeter(ctx).Consumed()
+			return nil
+		}
+
+		blockResult, _, err := s.DeliverBlock(context.Background(), &server.BlockRequest[mock.Tx]{
+			Height:  uint64(1),
+			Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+			AppHash: sum[:],
+			Hash:    sum[:],
+			Txs:     []mock.Tx{mockTx, mockTx},
+		}, state)
+		if err != nil {
+			t.Errorf("DeliverBlock error: %v", err)
+		}
+
+		// the transactions see the gas of the previous transactions of the block
+		txGas := blockResult.TxResults[0].GasUsed
+		if txBlockGas != txGas {
+			t.Errorf("Expected a block gas of %d in the second transaction, got %d", txGas, txBlockGas)
+		}
+		if endBlockGas != txGas+blockResult.TxResults[1].GasUsed {
+			t.Errorf("Expected a block gas of %d in end block, got %d", txGas+blockResult.TxResults[1].GasUsed, endBlockGas)
+		}
+	})
+
 	t.Run("fail exec tx", func(t *testing.T) {
 		// update the stf to fail on the handler
 		s := s.clone()
@@ -510,3 +568,26 @@ func stateNotHas(t *testing.T, accountState store.ReaderMap, key string) {
 		t.Errorf("State was not supposed to have key: %s", key)
 	}
 }
+
+// gasRecorder records the gas consumed per actor.
+type gasRecorder struct {
+	msgs  []string
+	open  bool
+	gas   map[string]coregas.Gas
+	total coregas.Gas
+}
+
+func (r *gasRecorder) BeginMsg(msg transaction.Msg) {
+	r.msgs = append(r.msgs, proto.MessageName(msg))
+	r.open = true
+}
+
+func (r *gasRecorder) ConsumeGas(actor []byte, amount coregas.Gas, _ string) {
+	if r.gas == nil {
+		r.gas = make(map[string]coregas.Gas)
+	}
+	r.gas[string(actor)] += amount
+	r.total += amount
+}
+
+func (r *gasRecorder) EndMsg() { r.open = false }
@@ -0,0 +1,43 @@
+package stf
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the tracer used by the STF instrumentation.
+const tracerName = "cosmossdk.io/server/v2/stf"
+
+// span attribute keys set by the STF instrumentation.
+const (
+	attributeKeyTxHash      = "tx.hash"
+	attributeKeyMsgTypeURL  = "msg.type_url"
+	attributeKeyBlockHeight = "block.height"
+	attributeKeyExecMode    = "exec_mode"
+)
+
+// startSpan starts a span as a child of the span of the given context, if any.
+// Spans are no-op until a tracer provider is registered globally, e.g. by the
+// telemetry server.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the error, if any, on the span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// txHashAttribute returns the transaction hash span attribute.
+func txHashAttribute(hash [32]byte) attribute.KeyValue {
+	return attribute.String(attributeKeyTxHash, fmt.Sprintf("%X", hash))
+}
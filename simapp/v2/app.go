@@ -64,6 +64,7 @@ func AppConfig() depinject.Config {
 
 			// provide base account options
 			basedepinject.ProvideSecp256K1PubKey,
+			basedepinject.ProvideWebAuthnPubKey,
 		),
 		depinject.Invoke(
 			std.RegisterInterfaces,
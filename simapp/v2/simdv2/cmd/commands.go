@@ -21,6 +21,7 @@ import (
 	"cosmossdk.io/simapp/v2"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/gasestimate"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/debug"
@@ -230,6 +231,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
+		authcmd.GetAggregateSignaturesCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
@@ -278,4 +280,8 @@ func registerGRPCGatewayRoutes[T transaction.Tx](
 	cmtservice.RegisterGRPCGatewayRoutes(deps.ClientContext, server.GRPCGatewayRouter)
 	_ = nodeservice.RegisterServiceHandlerClient(context.Background(), server.GRPCGatewayRouter, nodeservice.NewServiceClient(deps.ClientContext))
 	_ = txtypes.RegisterServiceHandlerClient(context.Background(), server.GRPCGatewayRouter, txtypes.NewServiceClient(deps.ClientContext))
+	// the gas estimate service is only served when enabled in app.toml (comet.gas-estimate-blocks)
+	if deps.ConsensusServer.GasEstimator() != nil {
+		gasestimate.RegisterGRPCGatewayRoutes(deps.ClientContext, server.GRPCGatewayRouter)
+	}
 }
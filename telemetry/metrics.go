@@ -90,6 +90,27 @@ type Config struct {
 	// DatadogHostname defines the hostname to use when emitting metrics to
 	// Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 	DatadogHostname string `mapstructure:"datadog-hostname"`
+
+	// TracingEnabled enables OpenTelemetry tracing of the ABCI methods, transactions,
+	// messages, store commits and queries.
+	TracingEnabled bool `mapstructure:"tracing-enabled"`
+
+	// TracingExporter defines the exporter of the spans: "otlp-grpc" (default),
+	// "otlp-http" or "file".
+	TracingExporter string `mapstructure:"tracing-exporter"`
+
+	// TracingEndpoint defines the address of the OTLP collector. If empty, the
+	// exporter default (or the OTEL_EXPORTER_OTLP_ENDPOINT variable) is used.
+	TracingEndpoint string `mapstructure:"tracing-endpoint"`
+
+	// TracingInsecure disables the transport security of the OTLP exporters.
+	TracingInsecure bool `mapstructure:"tracing-insecure"`
+
+	// TracingFile defines the file the spans are written to with the "file" exporter.
+	TracingFile string `mapstructure:"tracing-file"`
+
+	// TracingSampleRate defines the ratio of traces sampled, between 0 and 1.
+	TracingSampleRate float64 `mapstructure:"tracing-sample-rate"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -0,0 +1,81 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTracing_Disabled(t *testing.T) {
+	shutdown, err := EnableTracing(context.Background(), Config{TracingEnabled: false})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+
+	_, span := StartSpan(context.Background(), "test")
+	require.False(t, span.IsRecording())
+	span.End()
+}
+
+func TestTracing_InvalidConfig(t *testing.T) {
+	_, err := EnableTracing(context.Background(), Config{TracingEnabled: true, TracingExporter: "foo"})
+	require.ErrorContains(t, err, "unknown tracing exporter")
+
+	_, err = EnableTracing(context.Background(), Config{TracingEnabled: true, TracingExporter: TracingExporterFile})
+	require.ErrorContains(t, err, "tracing file must be set")
+
+	_, err = EnableTracing(context.Background(), Config{TracingEnabled: true, TracingSampleRate: 2})
+	require.ErrorContains(t, err, "sample rate must be between 0 and 1")
+}
+
+func TestTracing_File(t *testing.T) {
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	file := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := EnableTracing(context.Background(), Config{
+		ServiceName:       "test",
+		TracingEnabled:    true,
+		TracingExporter:   TracingExporterFile,
+		TracingFile:       file,
+		TracingSampleRate: 1,
+	})
+	require.NoError(t, err)
+
+	ctx, parent := StartSpan(context.Background(), "parent", attribute.Int64(AttributeKeyBlockHeight, 1))
+	require.True(t, parent.IsRecording())
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("failure"))
+	EndSpan(parent, nil)
+	require.NoError(t, shutdown(context.Background()))
+
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	type span struct {
+		Name        string
+		SpanContext struct{ SpanID string }
+		Parent      struct{ SpanID string }
+		Status      struct{ Code string }
+	}
+	var spans []span
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	for dec.More() {
+		var s span
+		require.NoError(t, dec.Decode(&s))
+		spans = append(spans, s)
+	}
+
+	require.Len(t, spans, 2)
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, "Error", spans[0].Status.Code)
+	require.Equal(t, "parent", spans[1].Name)
+	require.Equal(t, spans[1].SpanContext.SpanID, spans[0].Parent.SpanID)
+}
@@ -52,6 +52,7 @@ func defaultConfig() *Config {
 		ModuleConfigs: make(map[string]*appv1alpha1.ModuleConfig),
 		PreBlockersOrder: []string{
 			testutil.UpgradeModuleName,
+			testutil.AuthModuleName,
 		},
 		BeginBlockersOrder: []string{
 			testutil.MintModuleName,
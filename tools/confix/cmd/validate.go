@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/confix"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// ValidateCommand creates a new command for validating a configuration file against the config of a version
+func ValidateCommand() *cobra.Command {
+	var (
+		flagOutputFormat = "output-format"
+		flagStrict       = "strict"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "validate [target-version] <config-path>",
+		Short: "Validate a configuration file against the config of a version.",
+		Long: `This command validates the specified configuration file (app.toml or client.toml) against the config of the target version.
+It reports the unknown and deprecated keys, the values of an unexpected type and the values the node would reject, such as invalid pruning settings or servers bound to the same port.
+The command fails if an error is found, or any issue with --strict.`,
+		Example: `validate v0.50 ~/.simapp/config/app.toml --output-format json`,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var configPath string
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			switch {
+			case len(args) > 1:
+				configPath = args[1]
+			case clientCtx.HomeDir != "":
+				configPath = filepath.Join(clientCtx.HomeDir, "config", "app.toml")
+			default:
+				return errors.New("must provide a path to the app.toml or client.toml")
+			}
+
+			configType := confix.AppConfigType
+			if ok, _ := cmd.Flags().GetBool(confix.ClientConfigType); ok {
+				configPath = strings.ReplaceAll(configPath, "app.toml", "client.toml") // for the case we are using the home dir of client ctx
+				configType = confix.ClientConfigType
+			} else if strings.HasSuffix(configPath, "client.toml") {
+				return errors.New("app.toml file expected, got client.toml, use --client flag to validate client.toml")
+			}
+
+			targetVersion := args[0]
+			if _, ok := confix.Migrations[targetVersion]; !ok {
+				return fmt.Errorf("unknown version %q, supported versions are: %q", targetVersion, slices.Collect(maps.Keys(confix.Migrations)))
+			}
+
+			outputFormat, _ := cmd.Flags().GetString(flagOutputFormat)
+			if outputFormat != "text" && outputFormat != "json" {
+				return fmt.Errorf("unknown output format %q, supported formats are: text, json", outputFormat)
+			}
+
+			rawFile, err := confix.LoadConfig(configPath)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			issues, err := confix.Validate(rawFile, targetVersion, configType)
+			if err != nil {
+				return err
+			}
+
+			strict, _ := cmd.Flags().GetBool(flagStrict)
+			invalid := confix.HasErrors(issues, strict)
+
+			if outputFormat == "json" {
+				if issues == nil {
+					issues = []confix.ValidationIssue{}
+				}
+
+				e := json.NewEncoder(cmd.OutOrStdout())
+				e.SetIndent("", "  ")
+				if err := e.Encode(struct {
+					Valid  bool                     `json:"valid"`
+					Issues []confix.ValidationIssue `json:"issues"`
+				}{Valid: !invalid, Issues: issues}); err != nil {
+					return err
+				}
+			} else {
+				if len(issues) == 0 {
+					return clientCtx.PrintString(fmt.Sprintf("The config is valid for %s.\n", targetVersion))
+				}
+				confix.PrintIssues(cmd.OutOrStdout(), issues)
+			}
+
+			if invalid {
+				// the issues are already reported, do not print the usage
+				cmd.SilenceUsage = true
+				return fmt.Errorf("config %s is invalid for %s: %d issue(s) found", configPath, targetVersion, len(issues))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(confix.ClientConfigType, false, "validate client.toml instead of app.toml")
+	cmd.Flags().String(flagOutputFormat, "text", "Output format (text|json)")
+	cmd.Flags().Bool(flagStrict, false, "fail on warnings as well as errors")
+
+	return cmd
+}
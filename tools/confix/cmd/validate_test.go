@@ -0,0 +1,64 @@
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/tools/confix"
+	"cosmossdk.io/tools/confix/cmd"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+)
+
+func TestValidateCmd(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t)
+	defer cleanup()
+
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(), []string{"v0.0"})
+	assert.ErrorContains(t, err, "unknown version")
+
+	// clientCtx does not create app.toml, so this should fail
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(), []string{"v0.50"})
+	assert.ErrorContains(t, err, "no such file or directory")
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(), []string{"v0.50", "--client"})
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(out.String()), "The config is valid for v0.50.")
+
+	appConfig := filepath.Join(clientCtx.HomeDir, "config", "app.toml")
+	err = os.WriteFile(appConfig, []byte("pruning = \"custom\"\npruning-keep-recent = \"0\"\npruning-interval = \"10\"\n"), 0o600)
+	assert.NilError(t, err)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(), []string{"v0.50", appConfig})
+	assert.ErrorContains(t, err, "is invalid for v0.50")
+	assert.Assert(t, strings.Contains(out.String(), "ERROR   [invalid-value] pruning-keep-recent: must not be less than 2"))
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(), []string{"v0.50", appConfig, "--output-format", "json"})
+	assert.ErrorContains(t, err, "is invalid for v0.50")
+
+	var result struct {
+		Valid  bool                     `json:"valid"`
+		Issues []confix.ValidationIssue `json:"issues"`
+	}
+	// the output is followed by the command error
+	assert.NilError(t, json.NewDecoder(bytes.NewReader(out.Bytes())).Decode(&result))
+	assert.Assert(t, !result.Valid)
+	assert.Equal(t, len(result.Issues), 1)
+	assert.Equal(t, result.Issues[0].Key, "pruning-keep-recent")
+	assert.Equal(t, result.Issues[0].Severity, confix.SeverityError)
+
+	// warnings only fail with --strict
+	err = os.WriteFile(appConfig, []byte("custom-key = 1\n"), 0o600)
+	assert.NilError(t, err)
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(), []string{"v0.50", appConfig})
+	assert.NilError(t, err)
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd.ValidateCommand(), []string{"v0.50", appConfig, "--strict"})
+	assert.ErrorContains(t, err, "is invalid for v0.50")
+}
@@ -0,0 +1,570 @@
+package confix
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/creachadair/tomledit"
+	"github.com/creachadair/tomledit/parser"
+	"github.com/creachadair/tomledit/scanner"
+)
+
+// Severity is the severity of a validation issue.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// IssueType is the kind of a validation issue.
+type IssueType string
+
+const (
+	UnknownKey    IssueType = "unknown-key"
+	DeprecatedKey IssueType = "deprecated-key"
+	TypeMismatch  IssueType = "type-mismatch"
+	InvalidValue  IssueType = "invalid-value"
+	MissingKey    IssueType = "missing-key"
+)
+
+// ValidationIssue is an issue found when validating a configuration file.
+type ValidationIssue struct {
+	Severity Severity  `json:"severity"`
+	Type     IssueType `json:"type"`
+	Key      string    `json:"key"`
+	Message  string    `json:"message"`
+	Hint     string    `json:"hint,omitempty"`
+}
+
+// Validate validates the configuration document against the configuration
+// template of the target version. It reports:
+//   - the keys unknown to the template, as warnings since applications may define custom configuration,
+//   - the keys removed or renamed in the target version, with a replacement hint if any,
+//   - the values whose type does not match the type of the template value,
+//   - the values the node would reject or misuse, e.g. invalid pruning settings or several servers bound to the same port.
+//
+// Issues are sorted by key.
+func Validate(doc *tomledit.Document, targetVersion, configType string) ([]ValidationIssue, error) {
+	target, err := LoadLocalConfig(targetVersion, configType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load internal config: %w", err)
+	}
+
+	values, targetValues := allValues(doc), allValues(target)
+
+	var issues []ValidationIssue
+	for key, value := range values {
+		targetValue, ok := targetValues[key]
+		if !ok {
+			issues = append(issues, unknownKeyIssue(key, targetVersion, configType))
+			continue
+		}
+
+		if kind, targetKind := valueKind(value), valueKind(targetValue); !compatibleKinds(kind, targetKind, targetValue) {
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityError,
+				Type:     TypeMismatch,
+				Key:      key,
+				Message:  fmt.Sprintf("expected %s, got %s %s", targetKind, kind, value.String()),
+			})
+		}
+	}
+
+	switch configType {
+	case AppConfigType:
+		issues = append(issues, validatePruning(values)...)
+		issues = append(issues, validateMinGasPrices(values)...)
+		issues = append(issues, validateAddresses(values)...)
+	case ClientConfigType:
+		issues = append(issues, validateClient(values)...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Key != issues[j].Key {
+			return issues[i].Key < issues[j].Key
+		}
+		return issues[i].Type < issues[j].Type
+	})
+
+	return issues, nil
+}
+
+// HasErrors reports whether the issues contain an error, or a warning when strict is true.
+func HasErrors(issues []ValidationIssue, strict bool) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError || strict {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintIssues prints one line per validation issue, followed by its hint if any.
+func PrintIssues(w io.Writer, issues []ValidationIssue) {
+	for _, issue := range issues {
+		fmt.Fprintf(w, "%-7s [%s] %s: %s\n", strings.ToUpper(string(issue.Severity)), issue.Type, issue.Key, issue.Message)
+		if issue.Hint != "" {
+			fmt.Fprintf(w, "        hint: %s\n", issue.Hint)
+		}
+	}
+}
+
+// unknownKeyIssue returns the issue of a key absent from the template of the
+// target version, which is a deprecated key if it was renamed or defined by
+// the template of an earlier version.
+func unknownKeyIssue(key, targetVersion, configType string) ValidationIssue {
+	if newKeys, ok := v2KeyChanges[key]; ok && targetVersion == "v2" && configType == AppConfigType {
+		return ValidationIssue{
+			Severity: SeverityWarning,
+			Type:     DeprecatedKey,
+			Key:      key,
+			Message:  fmt.Sprintf("key is not used since %s", targetVersion),
+			Hint:     fmt.Sprintf("replaced by %s, run `confix migrate %s` to update the config", strings.Join(newKeys, ", "), targetVersion),
+		}
+	}
+
+	if removedIn := removedInVersion(key, targetVersion, configType); removedIn != "" {
+		return ValidationIssue{
+			Severity: SeverityWarning,
+			Type:     DeprecatedKey,
+			Key:      key,
+			Message:  fmt.Sprintf("key is not used since %s", removedIn),
+			Hint:     fmt.Sprintf("run `confix migrate %s` to remove it", targetVersion),
+		}
+	}
+
+	return ValidationIssue{
+		Severity: SeverityWarning,
+		Type:     UnknownKey,
+		Key:      key,
+		Message:  fmt.Sprintf("key is not defined by the %s %s config", targetVersion, configType),
+		Hint:     "ignore this warning if the key is a custom application config",
+	}
+}
+
+// removedInVersion returns the version following the last version, prior to the
+// target version, whose template defines the key, or an empty string if none does.
+func removedInVersion(key, targetVersion, configType string) string {
+	versions := sortedVersions()
+	removedIn := targetVersion
+	for i := len(versions) - 1; i >= 0; i-- {
+		if compareVersions(versions[i], targetVersion) >= 0 {
+			continue
+		}
+
+		doc, err := LoadLocalConfig(versions[i], configType)
+		if err != nil {
+			// no template for this version and config type
+			continue
+		}
+		if _, ok := allValues(doc)[key]; ok {
+			return removedIn
+		}
+		removedIn = versions[i]
+	}
+	return ""
+}
+
+// sortedVersions returns the versions supported by the migrations in ascending order.
+func sortedVersions() []string {
+	versions := make([]string, 0, len(Migrations))
+	for version := range Migrations {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareVersions(versions[i], versions[j]) < 0 })
+	return versions
+}
+
+// compareVersions compares two versions of the form vMAJOR[.MINOR].
+func compareVersions(a, b string) int {
+	parse := func(v string) (major, minor int) {
+		majorStr, minorStr, _ := strings.Cut(strings.TrimPrefix(v, "v"), ".")
+		major, _ = strconv.Atoi(majorStr)
+		minor, _ = strconv.Atoi(minorStr)
+		return major, minor
+	}
+
+	aMajor, aMinor := parse(a)
+	bMajor, bMinor := parse(b)
+	switch {
+	case aMajor != bMajor:
+		return aMajor - bMajor
+	default:
+		return aMinor - bMinor
+	}
+}
+
+// allValues returns the values of all key-value mappings of the document by their complete key.
+func allValues(doc *tomledit.Document) map[string]parser.Value {
+	values := make(map[string]parser.Value)
+	doc.Scan(func(key parser.Key, entry *tomledit.Entry) bool {
+		if entry.KeyValue != nil {
+			values[key.String()] = entry.Value
+		}
+		return true
+	})
+	return values
+}
+
+// valueKind returns the TOML type of the value.
+func valueKind(v parser.Value) string {
+	switch x := v.X.(type) {
+	case parser.Array:
+		return "array"
+	case parser.Inline:
+		return "table"
+	case parser.Token:
+		switch x.Type {
+		case scanner.String, scanner.MString, scanner.LString, scanner.MLString:
+			return "string"
+		case scanner.Integer:
+			return "integer"
+		case scanner.Float:
+			return "float"
+		case scanner.DateTime, scanner.LocalDate, scanner.LocalTime, scanner.LocalDateTime:
+			return "datetime"
+		case scanner.Word:
+			if s := x.String(); s == "true" || s == "false" {
+				return "boolean"
+			}
+			// inf and nan
+			return "float"
+		}
+	}
+	return "unknown"
+}
+
+// compatibleKinds reports whether a value of the given kind can be decoded as
+// the template value. Integers are decoded as floats and numbers as strings
+// holding numbers, as the SDK config decoding casts them.
+func compatibleKinds(kind, targetKind string, targetValue parser.Value) bool {
+	switch {
+	case kind == targetKind:
+		return true
+	case kind == "integer" && targetKind == "float":
+		return true
+	case (kind == "integer" || kind == "float") && targetKind == "string":
+		s, _ := stringValue(targetValue)
+		_, err := strconv.ParseFloat(s, 64)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// stringValue returns the value of a string, or the literal of any other token.
+func stringValue(v parser.Value) (string, bool) {
+	token, ok := v.X.(parser.Token)
+	if !ok {
+		return "", false
+	}
+
+	s := token.String()
+	switch token.Type {
+	case scanner.String:
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return strings.Trim(s, `"`), true
+		}
+		return unquoted, true
+	case scanner.LString:
+		return strings.Trim(s, "'"), true
+	case scanner.MString:
+		return strings.Trim(s, `"`), true
+	case scanner.MLString:
+		return strings.Trim(s, "'"), true
+	default:
+		return s, true
+	}
+}
+
+// uintValue returns the unsigned integer held by an integer or a string value.
+func uintValue(v parser.Value) (uint64, error) {
+	s, _ := stringValue(v)
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// validatePruning validates the application state pruning settings of the
+// configs predating v2, mirroring the validation of the store pruning options.
+func validatePruning(values map[string]parser.Value) []ValidationIssue {
+	const (
+		minKeepRecent = 2
+		minInterval   = 10
+	)
+
+	strategyValue, ok := values["pruning"]
+	if !ok {
+		return nil
+	}
+
+	strategy, _ := stringValue(strategyValue)
+	switch strategy {
+	case "default", "nothing", "everything":
+		var issues []ValidationIssue
+		for _, key := range []string{"pruning-keep-recent", "pruning-interval"} {
+			if v, ok := values[key]; ok {
+				if n, err := uintValue(v); err == nil && n != 0 {
+					issues = append(issues, ValidationIssue{
+						Severity: SeverityWarning,
+						Type:     InvalidValue,
+						Key:      key,
+						Message:  fmt.Sprintf("value %d is ignored with pruning = %q", n, strategy),
+						Hint:     `set pruning = "custom" to apply it`,
+					})
+				}
+			}
+		}
+		return issues
+	case "custom":
+	default:
+		return []ValidationIssue{{
+			Severity: SeverityError,
+			Type:     InvalidValue,
+			Key:      "pruning",
+			Message:  fmt.Sprintf("unknown pruning strategy %q", strategy),
+			Hint:     `use one of "default", "nothing", "everything" or "custom"`,
+		}}
+	}
+
+	var issues []ValidationIssue
+	for _, key := range []string{"pruning-keep-recent", "pruning-interval"} {
+		if _, ok := values[key]; !ok {
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityError,
+				Type:     MissingKey,
+				Key:      key,
+				Message:  `missing key, required with pruning = "custom"`,
+			})
+		}
+	}
+	if len(issues) > 0 {
+		return issues
+	}
+
+	keepRecent, err := uintValue(values["pruning-keep-recent"])
+	switch {
+	case err != nil:
+		issues = append(issues, ValidationIssue{
+			Severity: SeverityError,
+			Type:     InvalidValue,
+			Key:      "pruning-keep-recent",
+			Message:  fmt.Sprintf("invalid value %s: must be an unsigned integer", values["pruning-keep-recent"].String()),
+		})
+	case keepRecent < minKeepRecent:
+		issues = append(issues, ValidationIssue{
+			Severity: SeverityError,
+			Type:     InvalidValue,
+			Key:      "pruning-keep-recent",
+			Message:  fmt.Sprintf("must not be less than %d", minKeepRecent),
+			Hint:     `for the most aggressive pruning, select pruning = "everything"`,
+		})
+	}
+
+	interval, err := uintValue(values["pruning-interval"])
+	switch {
+	case err != nil:
+		issues = append(issues, ValidationIssue{
+			Severity: SeverityError,
+			Type:     InvalidValue,
+			Key:      "pruning-interval",
+			Message:  fmt.Sprintf("invalid value %s: must be an unsigned integer", values["pruning-interval"].String()),
+		})
+	case interval == 0:
+		issues = append(issues, ValidationIssue{
+			Severity: SeverityError,
+			Type:     InvalidValue,
+			Key:      "pruning-interval",
+			Message:  "must not be 0",
+			Hint:     `to disable pruning, select pruning = "nothing"`,
+		})
+	case interval < minInterval:
+		issues = append(issues, ValidationIssue{
+			Severity: SeverityError,
+			Type:     InvalidValue,
+			Key:      "pruning-interval",
+			Message:  fmt.Sprintf("must not be less than %d", minInterval),
+			Hint:     `for the most aggressive pruning, select pruning = "everything"`,
+		})
+	}
+
+	return issues
+}
+
+// validateMinGasPrices warns when the node accepts transactions without fees.
+func validateMinGasPrices(values map[string]parser.Value) []ValidationIssue {
+	for _, key := range []string{"minimum-gas-prices", "server.minimum-gas-prices"} {
+		v, ok := values[key]
+		if !ok {
+			continue
+		}
+		if s, _ := stringValue(v); strings.TrimSpace(s) == "" {
+			return []ValidationIssue{{
+				Severity: SeverityWarning,
+				Type:     InvalidValue,
+				Key:      key,
+				Message:  "no minimum gas prices are set, the node accepts transactions without fees",
+				Hint:     `set a minimum gas price, e.g. "0.025stake"`,
+			}}
+		}
+	}
+	return nil
+}
+
+// validateAddresses validates the bind addresses of the enabled servers and
+// reports the servers bound to the same port.
+func validateAddresses(values map[string]parser.Value) []ValidationIssue {
+	type bind struct {
+		key, host string
+	}
+
+	var (
+		issues []ValidationIssue
+		binds  = make(map[string][]bind)
+	)
+	for key, value := range values {
+		section, name, ok := cutLast(key, ".")
+		if !ok || name != "address" {
+			continue
+		}
+		if enable, ok := values[section+".enable"]; ok && enable.String() == "false" {
+			continue
+		}
+
+		address, _ := stringValue(value)
+		host, port, err := splitHostPort(address)
+		if err != nil {
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityError,
+				Type:     InvalidValue,
+				Key:      key,
+				Message:  fmt.Sprintf("invalid address %q: %v", address, err),
+			})
+			continue
+		}
+		binds[port] = append(binds[port], bind{key: key, host: host})
+	}
+
+	for port, portBinds := range binds {
+		sort.Slice(portBinds, func(i, j int) bool { return portBinds[i].key < portBinds[j].key })
+		for i := 1; i < len(portBinds); i++ {
+			for j := 0; j < i; j++ {
+				if !sameHost(portBinds[i].host, portBinds[j].host) {
+					continue
+				}
+				issues = append(issues, ValidationIssue{
+					Severity: SeverityError,
+					Type:     InvalidValue,
+					Key:      portBinds[i].key,
+					Message:  fmt.Sprintf("port %s is already bound by %s", port, portBinds[j].key),
+					Hint:     "bind each server to a distinct port, or disable one of them",
+				})
+				break
+			}
+		}
+	}
+
+	return issues
+}
+
+// validateClient validates the values of the client config.
+func validateClient(values map[string]parser.Value) []ValidationIssue {
+	var issues []ValidationIssue
+
+	if v, ok := values["chain-id"]; ok {
+		if s, _ := stringValue(v); s == "" {
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityWarning,
+				Type:     InvalidValue,
+				Key:      "chain-id",
+				Message:  "chain-id is empty",
+				Hint:     "set the chain-id of the network the client connects to",
+			})
+		}
+	}
+
+	enums := []struct {
+		key     string
+		allowed []string
+	}{
+		{"keyring-backend", []string{"os", "file", "kwallet", "pass", "test", "memory"}},
+		{"output", []string{"text", "json"}},
+		{"broadcast-mode", []string{"sync", "async"}},
+	}
+	for _, enum := range enums {
+		v, ok := values[enum.key]
+		if !ok {
+			continue
+		}
+		s, _ := stringValue(v)
+		if !slices.Contains(enum.allowed, s) {
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityError,
+				Type:     InvalidValue,
+				Key:      enum.key,
+				Message:  fmt.Sprintf("invalid value %q", s),
+				Hint:     fmt.Sprintf("use one of %q", enum.allowed),
+			})
+		}
+	}
+
+	if v, ok := values["node"]; ok {
+		s, _ := stringValue(v)
+		if u, err := url.Parse(s); err != nil || u.Scheme == "" || u.Host == "" {
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityError,
+				Type:     InvalidValue,
+				Key:      "node",
+				Message:  fmt.Sprintf("invalid node address %q", s),
+				Hint:     `use a URL such as "tcp://localhost:26657"`,
+			})
+		}
+	}
+
+	return issues
+}
+
+// splitHostPort splits an address, optionally prefixed by a scheme, into its host and port.
+func splitHostPort(address string) (host, port string, err error) {
+	if _, rest, ok := strings.Cut(address, "://"); ok {
+		address = rest
+	}
+	host, port, err = net.SplitHostPort(address)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return "", "", fmt.Errorf("invalid port %q", port)
+	}
+	return host, port, nil
+}
+
+// sameHost reports whether servers bound to the two hosts on the same port collide.
+func sameHost(a, b string) bool {
+	normalize := func(host string) string {
+		switch host {
+		case "", "0.0.0.0", "::":
+			return ""
+		case "localhost", "::1":
+			return "127.0.0.1"
+		default:
+			return host
+		}
+	}
+
+	a, b = normalize(a), normalize(b)
+	return a == "" || b == "" || a == b
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
@@ -0,0 +1,201 @@
+package confix_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/creachadair/tomledit"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/tools/confix"
+)
+
+func mustParseConfig(t *testing.T, config string) *tomledit.Document {
+	t.Helper()
+	doc, err := tomledit.Parse(strings.NewReader(config))
+	assert.NilError(t, err)
+	return doc
+}
+
+func findIssue(issues []confix.ValidationIssue, key string, issueType confix.IssueType) (confix.ValidationIssue, bool) {
+	for _, issue := range issues {
+		if issue.Key == key && issue.Type == issueType {
+			return issue, true
+		}
+	}
+	return confix.ValidationIssue{}, false
+}
+
+func TestValidateDefaults(t *testing.T) {
+	for _, tc := range []struct {
+		version, configType string
+	}{
+		{"v0.47", confix.AppConfigType},
+		{"v0.50", confix.AppConfigType},
+		{"v0.50", confix.ClientConfigType},
+		{"v2", confix.AppConfigType},
+		{"v2", confix.ClientConfigType},
+	} {
+		doc, err := confix.LoadLocalConfig(tc.version, tc.configType)
+		assert.NilError(t, err)
+
+		issues, err := confix.Validate(doc, tc.version, tc.configType)
+		assert.NilError(t, err)
+		assert.Assert(t, !confix.HasErrors(issues, false), "%s %s: %v", tc.version, tc.configType, issues)
+	}
+}
+
+func TestValidateKeys(t *testing.T) {
+	doc, err := confix.LoadLocalConfig("v0.50", confix.AppConfigType)
+	assert.NilError(t, err)
+
+	issues, err := confix.Validate(doc, "v2", confix.AppConfigType)
+	assert.NilError(t, err)
+
+	// renamed key
+	issue, ok := findIssue(issues, "minimum-gas-prices", confix.DeprecatedKey)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Severity, confix.SeverityWarning)
+	assert.Assert(t, strings.Contains(issue.Hint, "server.minimum-gas-prices"))
+
+	// removed key
+	issue, ok = findIssue(issues, "api.swagger", confix.DeprecatedKey)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Message, "key is not used since v2")
+
+	// key never defined
+	doc = mustParseConfig(t, "custom-key = 1\n[wasm]\nmemory-cache-size = 100\n")
+	issues, err = confix.Validate(doc, "v0.50", confix.AppConfigType)
+	assert.NilError(t, err)
+	_, ok = findIssue(issues, "custom-key", confix.UnknownKey)
+	assert.Assert(t, ok)
+	_, ok = findIssue(issues, "wasm.memory-cache-size", confix.UnknownKey)
+	assert.Assert(t, ok)
+	assert.Assert(t, !confix.HasErrors(issues, false))
+	assert.Assert(t, confix.HasErrors(issues, true))
+}
+
+func TestValidateTypes(t *testing.T) {
+	doc := mustParseConfig(t, `
+pruning-keep-recent = 100
+halt-height = "10"
+iavl-cache-size = 1.5
+
+[api]
+enable = "true"
+
+[state-sync]
+snapshot-interval = 1000
+`)
+
+	issues, err := confix.Validate(doc, "v0.50", confix.AppConfigType)
+	assert.NilError(t, err)
+
+	// numbers are accepted for strings holding numbers
+	_, ok := findIssue(issues, "pruning-keep-recent", confix.TypeMismatch)
+	assert.Assert(t, !ok)
+	_, ok = findIssue(issues, "state-sync.snapshot-interval", confix.TypeMismatch)
+	assert.Assert(t, !ok)
+
+	issue, ok := findIssue(issues, "halt-height", confix.TypeMismatch)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Message, `expected integer, got string "10"`)
+	_, ok = findIssue(issues, "iavl-cache-size", confix.TypeMismatch)
+	assert.Assert(t, ok)
+	_, ok = findIssue(issues, "api.enable", confix.TypeMismatch)
+	assert.Assert(t, ok)
+	assert.Assert(t, confix.HasErrors(issues, false))
+}
+
+func TestValidateSemantics(t *testing.T) {
+	doc := mustParseConfig(t, `
+minimum-gas-prices = ""
+pruning = "custom"
+pruning-keep-recent = "1"
+pruning-interval = "5"
+
+[api]
+enable = true
+address = "tcp://0.0.0.0:9090"
+
+[grpc]
+enable = true
+address = "localhost:9090"
+
+[telemetry]
+enable = false
+address = "localhost:9090"
+`)
+
+	issues, err := confix.Validate(doc, "v0.50", confix.AppConfigType)
+	assert.NilError(t, err)
+
+	issue, ok := findIssue(issues, "minimum-gas-prices", confix.InvalidValue)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Severity, confix.SeverityWarning)
+
+	issue, ok = findIssue(issues, "pruning-keep-recent", confix.InvalidValue)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Message, "must not be less than 2")
+	issue, ok = findIssue(issues, "pruning-interval", confix.InvalidValue)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Message, "must not be less than 10")
+
+	issue, ok = findIssue(issues, "grpc.address", confix.InvalidValue)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Message, "port 9090 is already bound by api.address")
+	// disabled servers are ignored
+	_, ok = findIssue(issues, "telemetry.address", confix.InvalidValue)
+	assert.Assert(t, !ok)
+
+	doc = mustParseConfig(t, `
+pruning = "custom"
+pruning-interval = "10"
+`)
+	issues, err = confix.Validate(doc, "v0.50", confix.AppConfigType)
+	assert.NilError(t, err)
+	issue, ok = findIssue(issues, "pruning-keep-recent", confix.MissingKey)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Severity, confix.SeverityError)
+	_, ok = findIssue(issues, "pruning-interval", confix.MissingKey)
+	assert.Assert(t, !ok)
+
+	doc = mustParseConfig(t, `
+pruning = "default"
+pruning-keep-recent = "100"
+pruning-interval = "0"
+`)
+	issues, err = confix.Validate(doc, "v0.50", confix.AppConfigType)
+	assert.NilError(t, err)
+	issue, ok = findIssue(issues, "pruning-keep-recent", confix.InvalidValue)
+	assert.Assert(t, ok)
+	assert.Equal(t, issue.Severity, confix.SeverityWarning)
+	_, ok = findIssue(issues, "pruning-interval", confix.InvalidValue)
+	assert.Assert(t, !ok)
+}
+
+func TestValidateClient(t *testing.T) {
+	doc := mustParseConfig(t, `
+chain-id = ""
+keyring-backend = "ledger"
+output = "json"
+node = "localhost"
+broadcast-mode = "block"
+`)
+
+	issues, err := confix.Validate(doc, "v0.50", confix.ClientConfigType)
+	assert.NilError(t, err)
+
+	for key, severity := range map[string]confix.Severity{
+		"chain-id":        confix.SeverityWarning,
+		"keyring-backend": confix.SeverityError,
+		"node":            confix.SeverityError,
+		"broadcast-mode":  confix.SeverityError,
+	} {
+		issue, ok := findIssue(issues, key, confix.InvalidValue)
+		assert.Assert(t, ok, key)
+		assert.Equal(t, issue.Severity, severity, key)
+	}
+	_, ok := findIssue(issues, "output", confix.InvalidValue)
+	assert.Assert(t, !ok)
+}
@@ -39,6 +39,14 @@ const (
 	EnvTimeFormatLogs           = "COSMOVISOR_TIMEFORMAT_LOGS"
 	EnvCustomPreupgrade         = "COSMOVISOR_CUSTOM_PREUPGRADE"
 	EnvDisableRecase            = "COSMOVISOR_DISABLE_RECASE"
+	EnvRollbackMaxCrashes       = "COSMOVISOR_ROLLBACK_MAX_CRASHES"
+	EnvRollbackCrashWindow      = "COSMOVISOR_ROLLBACK_CRASH_WINDOW"
+	EnvRollbackBlockTimeout     = "COSMOVISOR_ROLLBACK_BLOCK_TIMEOUT"
+	EnvStatusAddress            = "COSMOVISOR_STATUS_ADDRESS"
+	EnvHookUpgradeDetected      = "COSMOVISOR_HOOK_UPGRADE_DETECTED"
+	EnvHookUpgradeStarted       = "COSMOVISOR_HOOK_UPGRADE_STARTED"
+	EnvHookUpgradeCompleted     = "COSMOVISOR_HOOK_UPGRADE_COMPLETED"
+	EnvHookUpgradeFailed        = "COSMOVISOR_HOOK_UPGRADE_FAILED"
 )
 
 const (
@@ -70,6 +78,14 @@ type Config struct {
 	TimeFormatLogs           string        `toml:"cosmovisor_timeformat_logs" mapstructure:"cosmovisor_timeformat_logs" default:"kitchen"`
 	CustomPreUpgrade         string        `toml:"cosmovisor_custom_preupgrade" mapstructure:"cosmovisor_custom_preupgrade" default:""`
 	DisableRecase            bool          `toml:"cosmovisor_disable_recase" mapstructure:"cosmovisor_disable_recase" default:"false"`
+	RollbackMaxCrashes       int           `toml:"cosmovisor_rollback_max_crashes" mapstructure:"cosmovisor_rollback_max_crashes" default:"0"`
+	RollbackCrashWindow      time.Duration `toml:"cosmovisor_rollback_crash_window" mapstructure:"cosmovisor_rollback_crash_window" default:"10m"`
+	RollbackBlockTimeout     time.Duration `toml:"cosmovisor_rollback_block_timeout" mapstructure:"cosmovisor_rollback_block_timeout"`
+	StatusAddress            string        `toml:"cosmovisor_status_address" mapstructure:"cosmovisor_status_address" default:""`
+	HookUpgradeDetected      string        `toml:"cosmovisor_hook_upgrade_detected" mapstructure:"cosmovisor_hook_upgrade_detected" default:""`
+	HookUpgradeStarted       string        `toml:"cosmovisor_hook_upgrade_started" mapstructure:"cosmovisor_hook_upgrade_started" default:""`
+	HookUpgradeCompleted     string        `toml:"cosmovisor_hook_upgrade_completed" mapstructure:"cosmovisor_hook_upgrade_completed" default:""`
+	HookUpgradeFailed        string        `toml:"cosmovisor_hook_upgrade_failed" mapstructure:"cosmovisor_hook_upgrade_failed" default:""`
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -220,6 +236,12 @@ func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 		Name:             os.Getenv(EnvName),
 		DataBackupPath:   os.Getenv(EnvDataBackupPath),
 		CustomPreUpgrade: os.Getenv(EnvCustomPreupgrade),
+
+		StatusAddress:        os.Getenv(EnvStatusAddress),
+		HookUpgradeDetected:  os.Getenv(EnvHookUpgradeDetected),
+		HookUpgradeStarted:   os.Getenv(EnvHookUpgradeStarted),
+		HookUpgradeCompleted: os.Getenv(EnvHookUpgradeCompleted),
+		HookUpgradeFailed:    os.Getenv(EnvHookUpgradeFailed),
 	}
 
 	if cfg.DataBackupPath == "" {
@@ -291,6 +313,29 @@ func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
 	}
 
+	envRollbackMaxCrashesVal := os.Getenv(EnvRollbackMaxCrashes)
+	if cfg.RollbackMaxCrashes, err = strconv.Atoi(envRollbackMaxCrashesVal); err != nil && envRollbackMaxCrashesVal != "" {
+		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvRollbackMaxCrashes, err))
+	}
+
+	if crashWindow := os.Getenv(EnvRollbackCrashWindow); crashWindow != "" {
+		val, err := parseEnvDuration(crashWindow)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvRollbackCrashWindow, err))
+		} else {
+			cfg.RollbackCrashWindow = val
+		}
+	}
+
+	if blockTimeout := os.Getenv(EnvRollbackBlockTimeout); blockTimeout != "" {
+		val, err := parseEnvDuration(blockTimeout)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvRollbackBlockTimeout, err))
+		} else {
+			cfg.RollbackBlockTimeout = val
+		}
+	}
+
 	cfg.GRPCAddress = os.Getenv(EnvGRPCAddress)
 	if cfg.GRPCAddress == "" {
 		cfg.GRPCAddress = "localhost:9090"
@@ -359,6 +404,13 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	if cfg.RollbackMaxCrashes < 0 {
+		errs = append(errs, fmt.Errorf("%s must not be negative", EnvRollbackMaxCrashes))
+	}
+	if cfg.rollbackEnabled() && cfg.UnsafeSkipBackup {
+		errs = append(errs, fmt.Errorf("upgrade rollbacks restore the data backup, they cannot be enabled with %s", EnvSkipBackup))
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -561,6 +613,14 @@ func (cfg Config) DetailString() string {
 		{EnvTimeFormatLogs, cfg.TimeFormatLogs},
 		{EnvCustomPreupgrade, cfg.CustomPreUpgrade},
 		{EnvDisableRecase, fmt.Sprintf("%t", cfg.DisableRecase)},
+		{EnvRollbackMaxCrashes, fmt.Sprintf("%d", cfg.RollbackMaxCrashes)},
+		{EnvRollbackCrashWindow, cfg.rollbackCrashWindow().String()},
+		{EnvRollbackBlockTimeout, cfg.RollbackBlockTimeout.String()},
+		{EnvStatusAddress, cfg.StatusAddress},
+		{EnvHookUpgradeDetected, cfg.HookUpgradeDetected},
+		{EnvHookUpgradeStarted, cfg.HookUpgradeStarted},
+		{EnvHookUpgradeCompleted, cfg.HookUpgradeCompleted},
+		{EnvHookUpgradeFailed, cfg.HookUpgradeFailed},
 	}
 
 	derivedEntries := []struct{ name, value string }{
@@ -569,6 +629,7 @@ func (cfg Config) DetailString() string {
 		{"Genesis Bin", cfg.GenesisBin()},
 		{"Monitored File", cfg.UpgradeInfoFilePath()},
 		{"Data Backup Dir", cfg.DataBackupPath},
+		{"Upgrade Status File", cfg.UpgradeStatusFilePath()},
 	}
 
 	var sb strings.Builder
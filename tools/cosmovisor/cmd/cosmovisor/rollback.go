@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func NewRollbackCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back the last upgrade to the previous binary and the pre-upgrade data backup.",
+		Long: `Roll back the last upgrade performed by cosmovisor: restore the data backup taken before the upgrade,
+switch back to the previous binary and mark the upgrade as failed in the upgrade status file.
+The validator signing state of the upgrade binary is kept to prevent double signing.
+A failed upgrade is not performed again until its binary is replaced.
+The APP must be stopped before rolling back.`,
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := cmd.Flags().GetString(cosmovisor.FlagCosmovisorConfig)
+			if err != nil {
+				return fmt.Errorf("failed to get config flag: %w", err)
+			}
+
+			cfg, err := cosmovisor.GetConfigFromFile(configPath)
+			if err != nil {
+				return err
+			}
+
+			if err := cosmovisor.Rollback(cfg.Logger(os.Stdout), cfg, "manual rollback"); err != nil {
+				return fmt.Errorf("failed to roll back upgrade: %w", err)
+			}
+
+			status, err := cfg.UpgradeStatus()
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Upgrade %q rolled back, current binary is %s\n", status.Name, status.PreviousLink)
+			return nil
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -52,6 +53,17 @@ func run(cfgPath string, args []string, options ...RunOption) error {
 		return err
 	}
 
+	if cfg.StatusAddress != "" {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			if err := launcher.ServeStatus(ctx); err != nil {
+				logger.Error("status server failed", "error", err)
+			}
+		}()
+	}
+
 	doUpgrade, err := launcher.Run(args, runCfg.StdIn, runCfg.StdOut, runCfg.StdErr)
 	// if RestartAfterUpgrade, we launch after a successful upgrade (given that condition launcher.Run returns nil)
 	for cfg.RestartAfterUpgrade && err == nil && doUpgrade {
@@ -0,0 +1,84 @@
+package cosmovisor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// hookTimeout is the maximum duration of an upgrade hook.
+const hookTimeout = time.Minute
+
+// UpgradeEvent is an event of the upgrade process.
+type UpgradeEvent string
+
+const (
+	// UpgradeDetected is emitted when the app requests an upgrade.
+	UpgradeDetected UpgradeEvent = "detected"
+	// UpgradeStarted is emitted when cosmovisor starts upgrading, after the restart delay.
+	UpgradeStarted UpgradeEvent = "started"
+	// UpgradeCompleted is emitted when the upgrade binary is in place and its pre-upgrade command succeeded.
+	UpgradeCompleted UpgradeEvent = "completed"
+	// UpgradeFailed is emitted when the upgrade process failed or the upgrade was rolled back.
+	UpgradeFailed UpgradeEvent = "failed"
+)
+
+// hook returns the configured hook of the event.
+func (cfg *Config) hook(event UpgradeEvent) string {
+	switch event {
+	case UpgradeDetected:
+		return cfg.HookUpgradeDetected
+	case UpgradeStarted:
+		return cfg.HookUpgradeStarted
+	case UpgradeCompleted:
+		return cfg.HookUpgradeCompleted
+	case UpgradeFailed:
+		return cfg.HookUpgradeFailed
+	default:
+		return ""
+	}
+}
+
+// emit records the upgrade event in the status and runs its hook, if any.
+// The hook is run with the upgrade name and height as arguments, and the
+// COSMOVISOR_UPGRADE_* environment variables describing the event. A failing
+// hook is logged and does not interrupt the upgrade.
+func (l Launcher) emit(event UpgradeEvent, p upgradetypes.Plan, cause error) {
+	l.status.setEvent(event, p, cause)
+
+	hook := l.cfg.hook(event)
+	if hook == "" {
+		return
+	}
+	if !filepath.IsAbs(hook) {
+		hook = filepath.Join(l.cfg.Root(), hook)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+
+	height := fmt.Sprintf("%d", p.Height)
+	cmd := exec.CommandContext(ctx, hook, p.Name, height)
+	cmd.Dir = l.cfg.Home
+	cmd.Env = append(os.Environ(),
+		"COSMOVISOR_UPGRADE_EVENT="+string(event),
+		"COSMOVISOR_UPGRADE_NAME="+p.Name,
+		"COSMOVISOR_UPGRADE_HEIGHT="+height,
+	)
+	if cause != nil {
+		cmd.Env = append(cmd.Env, "COSMOVISOR_UPGRADE_ERROR="+cause.Error())
+	}
+
+	result, err := cmd.CombinedOutput()
+	if err != nil {
+		l.logger.Error("upgrade hook failed", "event", event, "hook", hook, "error", err, "result", result)
+		return
+	}
+
+	l.logger.Info("upgrade hook result", "event", event, "hook", hook, "result", result)
+}
@@ -0,0 +1,49 @@
+//go:build linux || darwin
+
+package cosmovisor_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func TestUpgradeHooks(t *testing.T) {
+	cfg := prepareConfig(t, fmt.Sprintf("%s/%s", workDir, "testdata/validate"), cosmovisor.Config{
+		Name:             "dummyd",
+		PollInterval:     15,
+		UnsafeSkipBackup: true,
+	})
+
+	events := filepath.Join(cfg.Home, "events")
+	hook := filepath.Join(cfg.Root(), "hook.sh")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$COSMOVISOR_UPGRADE_EVENT $COSMOVISOR_UPGRADE_NAME $COSMOVISOR_UPGRADE_HEIGHT $1 $2\" >> %s\n", events)
+	require.NoError(t, os.WriteFile(hook, []byte(script), 0o755))
+	failingHook := filepath.Join(cfg.Root(), "failing-hook.sh")
+	require.NoError(t, os.WriteFile(failingHook, []byte("#!/bin/sh\nexit 1\n"), 0o755))
+
+	// hooks are resolved relative to the cosmovisor directory
+	cfg.HookUpgradeDetected = "hook.sh"
+	cfg.HookUpgradeStarted = "failing-hook.sh"
+	cfg.HookUpgradeCompleted = hook
+	cfg.HookUpgradeFailed = hook
+
+	launcher, err := cosmovisor.NewLauncher(log.NewTestLogger(t), cfg)
+	require.NoError(t, err)
+
+	stdin, _ := os.Open(os.DevNull)
+	stdout, stderr := newBuffer(), newBuffer()
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}, stdin, stdout, stderr)
+	require.NoError(t, err, "a failing hook does not interrupt the upgrade")
+	require.True(t, doUpgrade)
+
+	bz, err := os.ReadFile(events)
+	require.NoError(t, err)
+	require.Equal(t, "detected chain2 49 chain2 49\ncompleted chain2 49 chain2 49\n", string(bz))
+}
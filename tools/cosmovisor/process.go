@@ -33,6 +33,7 @@ type Launcher struct {
 	logger log.Logger
 	cfg    *Config
 	fw     *fileWatcher
+	status *statusTracker
 }
 
 func NewLauncher(logger log.Logger, cfg *Config) (Launcher, error) {
@@ -41,7 +42,7 @@ func NewLauncher(logger log.Logger, cfg *Config) (Launcher, error) {
 		return Launcher{}, err
 	}
 
-	return Launcher{logger: logger, cfg: cfg, fw: fw}, nil
+	return Launcher{logger: logger, cfg: cfg, fw: fw, status: &statusTracker{}}, nil
 }
 
 // loadBatchUpgradeFile loads the batch upgrade file into memory, sorted by
@@ -203,6 +204,7 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 	if err := cmd.Start(); err != nil {
 		return false, fmt.Errorf("launching process %s %s failed: %w", bin, strings.Join(args, " "), err)
 	}
+	l.status.appStarted(bin, args, cmd.Process.Pid)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	var wg sync.WaitGroup
@@ -224,28 +226,49 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
-		return false, err
+	// monitor the binary of a pending upgrade to roll it back if it fails
+	var monitor *upgradeMonitor
+	if status, err := l.cfg.UpgradeStatus(); err == nil && status.State == UpgradeStatePending && l.cfg.rollbackEnabled() {
+		monitor = l.monitorUpgrade(status, cmd)
 	}
 
-	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
-		l.cfg.WaitRestartDelay()
-
-		if err := l.doBackup(); err != nil {
-			return false, err
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	l.status.appExited(needsUpdate, err)
+	if monitor != nil {
+		if timedOut := monitor.stop(); timedOut {
+			if err := l.rollback(fmt.Sprintf("no block committed within %s", l.cfg.RollbackBlockTimeout)); err != nil {
+				return false, fmt.Errorf("failed to roll back upgrade: %w", err)
+			}
+			return false, errors.New("upgrade failed and was rolled back, replace the upgrade binary and restart cosmovisor")
 		}
 
-		if err := l.doCustomPreUpgrade(); err != nil {
-			return false, err
+		if err != nil && !needsUpdate {
+			rolledBack, rerr := l.recordCrash()
+			if rerr != nil {
+				return false, fmt.Errorf("failed to roll back upgrade: %w (app error: %w)", rerr, err)
+			}
+			if rolledBack {
+				return false, fmt.Errorf("upgrade failed and was rolled back, replace the upgrade binary and restart cosmovisor: %w", err)
+			}
 		}
+	}
+	if err != nil || !needsUpdate {
+		return false, err
+	}
 
-		if err := UpgradeBinary(l.logger, l.cfg, l.fw.currentInfo); err != nil {
-			return false, err
-		}
+	upgrade := l.fw.currentInfo
+	l.emit(UpgradeDetected, upgrade, nil)
+
+	if !IsSkipUpgradeHeight(args, upgrade) {
+		l.waitForFixedBinary(upgrade)
+		l.cfg.WaitRestartDelay()
 
-		if err = l.doPreUpgrade(); err != nil {
+		l.emit(UpgradeStarted, upgrade, nil)
+		if err := l.doUpgrade(upgrade); err != nil {
+			l.emit(UpgradeFailed, upgrade, err)
 			return false, err
 		}
+		l.emit(UpgradeCompleted, upgrade, nil)
 
 		return true, nil
 	}
@@ -256,6 +279,32 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 	return false, nil
 }
 
+// doUpgrade backs up the data directory, switches to the binary of the upgrade
+// and runs its pre-upgrade command.
+func (l Launcher) doUpgrade(upgrade upgradetypes.Plan) error {
+	backupStart := time.Now()
+	backupDir, err := l.doBackup()
+	l.recordBackup(backupStart, backupDir, err)
+	if err != nil {
+		return err
+	}
+
+	if err := l.doCustomPreUpgrade(); err != nil {
+		return err
+	}
+
+	previousLink := l.cfg.currentLinkTarget()
+	if err := UpgradeBinary(l.logger, l.cfg, upgrade); err != nil {
+		return err
+	}
+
+	if err := l.recordUpgrade(upgrade, previousLink, backupDir); err != nil {
+		return fmt.Errorf("failed to record upgrade status: %w", err)
+	}
+
+	return l.doPreUpgrade()
+}
+
 // WaitForUpgradeOrExit checks upgrade plan file created by the app.
 // When it returns, the process (app) is finished.
 //
@@ -321,22 +370,24 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
+// doBackup takes a backup of the data directory, unless UNSAFE_SKIP_BACKUP is
+// set, and returns the backup directory.
+func (l Launcher) doBackup() (string, error) {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(l.cfg.UpgradeInfoFilePath())
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return errors.New("upgrade-info.json is empty")
+			return "", errors.New("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
@@ -348,15 +399,16 @@ func (l Launcher) doBackup() error {
 
 		// copy the $DAEMON_HOME/data to a backup dir
 		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for backup process
 		et := time.Now()
 		l.logger.Info("backup completed", "backup saved at", dst, "backup completion time", et, "time taken to complete backup", et.Sub(st))
+		return dst, nil
 	}
 
-	return nil
+	return "", nil
 }
 
 // doCustomPreUpgrade executes the custom preupgrade script if provided.
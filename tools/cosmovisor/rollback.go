@@ -0,0 +1,400 @@
+package cosmovisor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/otiai10/copy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+)
+
+const (
+	upgradeStatusFilename = "upgrade-status.json"
+
+	// privValidatorStateFilename is the CometBFT file, in the data directory,
+	// recording the last height, round and step signed by the validator.
+	privValidatorStateFilename = "priv_validator_state.json"
+
+	defaultRollbackCrashWindow = 10 * time.Minute
+)
+
+// UpgradeState is the state of the last upgrade performed by cosmovisor.
+type UpgradeState string
+
+const (
+	// UpgradeStatePending is the state of an upgrade whose binary was switched
+	// but which has not produced a block yet.
+	UpgradeStatePending UpgradeState = "pending"
+	// UpgradeStateSucceeded is the state of an upgrade which produced a block, or
+	// whose binary did not crash within the crash window.
+	UpgradeStateSucceeded UpgradeState = "succeeded"
+	// UpgradeStateFailed is the state of an upgrade which was rolled back.
+	UpgradeStateFailed UpgradeState = "failed"
+)
+
+// UpgradeStatus is the status of the last upgrade performed by cosmovisor,
+// holding what is needed to roll it back.
+type UpgradeStatus struct {
+	Name   string       `json:"name"`
+	Height int64        `json:"height"`
+	State  UpgradeState `json:"state"`
+	// PreviousLink is the target of the current link before the upgrade, e.g. genesis or upgrades/<name>.
+	PreviousLink string `json:"previous_link"`
+	// BackupDir is the backup of the data directory taken before the upgrade, empty if none was taken.
+	BackupDir string `json:"backup_dir,omitempty"`
+	// BinaryChecksum is the sha256 checksum of the upgrade binary.
+	BinaryChecksum string `json:"binary_checksum"`
+	// Crashes are the times the upgrade binary crashed while the upgrade was pending.
+	Crashes []time.Time `json:"crashes,omitempty"`
+	// Reason is the reason of the rollback of a failed upgrade.
+	Reason    string    `json:"reason,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UpgradeStatusFilePath is the path to the status of the last upgrade.
+func (cfg *Config) UpgradeStatusFilePath() string {
+	return filepath.Join(cfg.Root(), upgradeStatusFilename)
+}
+
+// UpgradeStatus returns the status of the last upgrade, or an error wrapping
+// os.ErrNotExist if cosmovisor did not perform any upgrade yet.
+func (cfg *Config) UpgradeStatus() (UpgradeStatus, error) {
+	var status UpgradeStatus
+	bz, err := os.ReadFile(cfg.UpgradeStatusFilePath())
+	if err != nil {
+		return status, err
+	}
+
+	if err := json.Unmarshal(bz, &status); err != nil {
+		return status, fmt.Errorf("failed to parse %s: %w", cfg.UpgradeStatusFilePath(), err)
+	}
+	return status, nil
+}
+
+// writeUpgradeStatus atomically writes the status of the last upgrade.
+func (cfg *Config) writeUpgradeStatus(status UpgradeStatus) error {
+	status.UpdatedAt = time.Now().UTC()
+	bz, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := cfg.UpgradeStatusFilePath() + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return fmt.Errorf("error writing %s: %w", upgradeStatusFilename, err)
+	}
+	return os.Rename(tmp, cfg.UpgradeStatusFilePath())
+}
+
+// rollbackEnabled returns true if failed upgrades are automatically rolled back.
+func (cfg *Config) rollbackEnabled() bool {
+	return cfg.RollbackMaxCrashes > 0 || cfg.RollbackBlockTimeout > 0
+}
+
+func (cfg *Config) rollbackCrashWindow() time.Duration {
+	if cfg.RollbackCrashWindow <= 0 {
+		return defaultRollbackCrashWindow
+	}
+	return cfg.RollbackCrashWindow
+}
+
+// Rollback rolls back the last upgrade: it restores the data backup taken
+// before the upgrade, keeping the validator signing state of the upgrade binary
+// to prevent double signing, switches the current link back to the previous
+// binary and marks the upgrade as failed with the given reason.
+// The application must not be running.
+//
+// A failed upgrade is not performed again until its binary is replaced, which
+// allows to restart the node from the pre-upgrade state once a fixed binary is
+// available.
+func Rollback(logger log.Logger, cfg *Config, reason string) error {
+	status, err := cfg.UpgradeStatus()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return errors.New("no upgrade to roll back")
+		}
+		return err
+	}
+
+	if status.State == UpgradeStateFailed {
+		return fmt.Errorf("upgrade %q was already rolled back: %s", status.Name, status.Reason)
+	}
+	if status.BackupDir == "" {
+		return fmt.Errorf("upgrade %q has no data backup (%s was set), cannot roll back", status.Name, EnvSkipBackup)
+	}
+	if info, err := os.Stat(status.BackupDir); err != nil || !info.IsDir() {
+		return fmt.Errorf("data backup %s of upgrade %q is missing: %w", status.BackupDir, status.Name, err)
+	}
+
+	logger.Info("rolling back upgrade", "upgrade", status.Name, "reason", reason)
+
+	if err := restoreDataBackup(logger, cfg, status); err != nil {
+		return err
+	}
+
+	link := filepath.Join(cfg.Root(), currentLink)
+	if err := os.Remove(link); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove existing link: %w", err)
+	}
+	if err := os.Symlink(status.PreviousLink, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+	// forget the upgrade, as the current link changed
+	cfg.currentUpgrade = upgradetypes.Plan{}
+
+	status.State = UpgradeStateFailed
+	status.Reason = reason
+	if err := cfg.writeUpgradeStatus(status); err != nil {
+		return err
+	}
+
+	logger.Info("upgrade rolled back", "upgrade", status.Name, "current", status.PreviousLink, "data backup", status.BackupDir)
+	return nil
+}
+
+// restoreDataBackup replaces the data directory by the backup of the upgrade.
+// The data directory of the failed upgrade is kept next to it for inspection.
+func restoreDataBackup(logger log.Logger, cfg *Config, status UpgradeStatus) error {
+	dataDir := filepath.Join(cfg.Home, "data")
+	failedDir := filepath.Join(cfg.Home, fmt.Sprintf("data-failed-%s", url.PathEscape(status.Name)))
+
+	if err := os.RemoveAll(failedDir); err != nil {
+		return fmt.Errorf("error while removing %s: %w", failedDir, err)
+	}
+	if err := os.Rename(dataDir, failedDir); err != nil {
+		return fmt.Errorf("error while moving the data directory of the failed upgrade: %w", err)
+	}
+
+	if err := copy.Copy(status.BackupDir, dataDir); err != nil {
+		return fmt.Errorf("error while restoring data backup: %w", err)
+	}
+
+	// the validator may have signed blocks with the upgrade binary, restoring
+	// the signing state of the backup would allow to sign them again
+	privValidatorState := filepath.Join(failedDir, privValidatorStateFilename)
+	if _, err := os.Stat(privValidatorState); err == nil {
+		if err := copy.Copy(privValidatorState, filepath.Join(dataDir, privValidatorStateFilename)); err != nil {
+			return fmt.Errorf("error while keeping the validator signing state: %w", err)
+		}
+	}
+
+	logger.Info("data backup restored", "backup", status.BackupDir, "failed upgrade data", failedDir)
+	return nil
+}
+
+// recordUpgrade records the upgrade which binary was just switched as pending.
+func (l Launcher) recordUpgrade(p upgradetypes.Plan, previousLink, backupDir string) error {
+	checksum, err := fileChecksum(l.cfg.UpgradeBin(p.Name))
+	if err != nil {
+		return err
+	}
+
+	return l.cfg.writeUpgradeStatus(UpgradeStatus{
+		Name:           p.Name,
+		Height:         p.Height,
+		State:          UpgradeStatePending,
+		PreviousLink:   previousLink,
+		BackupDir:      backupDir,
+		BinaryChecksum: checksum,
+	})
+}
+
+// recordCrash records a crash of the binary of a pending upgrade, and rolls the
+// upgrade back if it crashed too many times within the crash window.
+// It returns true if the upgrade was rolled back.
+func (l Launcher) recordCrash() (bool, error) {
+	status, err := l.cfg.UpgradeStatus()
+	if err != nil || status.State != UpgradeStatePending {
+		return false, err
+	}
+
+	now := time.Now().UTC()
+	crashes := []time.Time{now}
+	for _, crash := range status.Crashes {
+		if now.Sub(crash) < l.cfg.rollbackCrashWindow() {
+			crashes = append(crashes, crash)
+		}
+	}
+	status.Crashes = crashes
+
+	if l.cfg.RollbackMaxCrashes > 0 && len(crashes) >= l.cfg.RollbackMaxCrashes {
+		if err := l.cfg.writeUpgradeStatus(status); err != nil {
+			return false, err
+		}
+		return true, l.rollback(fmt.Sprintf("crashed %d times within %s", len(crashes), l.cfg.rollbackCrashWindow()))
+	}
+
+	l.logger.Warn("upgrade binary crashed", "upgrade", status.Name, "crashes", len(crashes), "max crashes", l.cfg.RollbackMaxCrashes)
+	return false, l.cfg.writeUpgradeStatus(status)
+}
+
+// rollback rolls back the pending upgrade and emits its failure.
+func (l Launcher) rollback(reason string) error {
+	status, err := l.cfg.UpgradeStatus()
+	if err != nil {
+		return err
+	}
+
+	if err := Rollback(l.logger, l.cfg, reason); err != nil {
+		return err
+	}
+
+	l.emit(UpgradeFailed, upgradetypes.Plan{Name: status.Name, Height: status.Height}, fmt.Errorf("upgrade rolled back: %s", reason))
+	return nil
+}
+
+// waitForFixedBinary blocks while the upgrade is a failed upgrade whose binary
+// was not replaced since its rollback.
+func (l Launcher) waitForFixedBinary(p upgradetypes.Plan) {
+	status, err := l.cfg.UpgradeStatus()
+	if err != nil || status.State != UpgradeStateFailed || status.Name != p.Name {
+		return
+	}
+
+	logged := false
+	for {
+		checksum, err := fileChecksum(l.cfg.UpgradeBin(p.Name))
+		if err != nil || checksum != status.BinaryChecksum {
+			l.logger.Info("binary of the failed upgrade was replaced, retrying the upgrade", "upgrade", p.Name)
+			return
+		}
+
+		if !logged {
+			l.logger.Error("upgrade was rolled back, waiting for its binary to be replaced", "upgrade", p.Name, "reason", status.Reason, "binary", l.cfg.UpgradeBin(p.Name))
+			logged = true
+		}
+		time.Sleep(l.cfg.PollInterval)
+	}
+}
+
+// upgradeMonitor monitors the health of the binary of a pending upgrade.
+type upgradeMonitor struct {
+	cancel context.CancelFunc
+	done   chan bool
+}
+
+// monitorUpgrade monitors the binary of a pending upgrade: the upgrade is marked
+// as succeeded once a block at the upgrade height is committed, or the process
+// is killed if no block was committed within the block timeout.
+// Without block timeout, the upgrade is also marked as succeeded once its binary
+// ran for the crash window, even if no block could be queried, so that a later
+// crash does not roll back an upgrade which is running.
+func (l Launcher) monitorUpgrade(status UpgradeStatus, cmd *exec.Cmd) *upgradeMonitor {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &upgradeMonitor{cancel: cancel, done: make(chan bool, 1)}
+
+	go func() {
+		m.done <- l.waitForUpgradeBlock(ctx, status, cmd)
+	}()
+
+	return m
+}
+
+// stop stops the monitor and returns true if the process was killed because no
+// block was committed within the block timeout.
+func (m *upgradeMonitor) stop() bool {
+	m.cancel()
+	return <-m.done
+}
+
+func (l Launcher) waitForUpgradeBlock(ctx context.Context, status UpgradeStatus, cmd *exec.Cmd) (timedOut bool) {
+	var timeout, crashWindow <-chan time.Time
+	if l.cfg.RollbackBlockTimeout > 0 {
+		timer := time.NewTimer(l.cfg.RollbackBlockTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	} else {
+		timer := time.NewTimer(l.cfg.rollbackCrashWindow())
+		defer timer.Stop()
+		crashWindow = timer.C
+	}
+
+	var client cmtservice.ServiceClient
+	conn, err := grpc.NewClient(l.cfg.GRPCAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		l.logger.Warn("failed to create gRPC client, cannot query the blocks of the upgrade", "error", err)
+	} else {
+		defer conn.Close()
+		client = cmtservice.NewServiceClient(conn)
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timeout:
+			l.logger.Error("no block committed by the upgrade binary, killing it", "upgrade", status.Name, "timeout", l.cfg.RollbackBlockTimeout)
+			_ = cmd.Process.Kill()
+			return true
+		case <-crashWindow:
+			l.recordUpgradeSuccess(status, "no crash within "+l.cfg.rollbackCrashWindow().String())
+			return false
+		case <-ticker.C:
+			if client == nil {
+				continue
+			}
+			reqCtx, cancel := context.WithTimeout(ctx, time.Second)
+			resp, err := client.GetLatestBlock(reqCtx, &cmtservice.GetLatestBlockRequest{})
+			cancel()
+			if err != nil || resp.SdkBlock == nil || resp.SdkBlock.Header.Height < status.Height {
+				continue
+			}
+
+			l.recordUpgradeSuccess(status, fmt.Sprintf("block %d committed", resp.SdkBlock.Header.Height))
+			return false
+		}
+	}
+}
+
+// recordUpgradeSuccess marks the pending upgrade as succeeded.
+func (l Launcher) recordUpgradeSuccess(status UpgradeStatus, reason string) {
+	status.State = UpgradeStateSucceeded
+	status.Crashes = nil
+	if err := l.cfg.writeUpgradeStatus(status); err != nil {
+		l.logger.Error("failed to record upgrade success", "upgrade", status.Name, "error", err)
+		return
+	}
+	l.logger.Info("upgrade succeeded", "upgrade", status.Name, "reason", reason)
+}
+
+// currentLinkTarget returns the target of the current link, genesis if none.
+func (cfg *Config) currentLinkTarget() string {
+	target, err := os.Readlink(filepath.Join(cfg.Root(), currentLink))
+	if err != nil {
+		return genesisDir
+	}
+	return target
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,165 @@
+//go:build linux || darwin
+
+package cosmovisor_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+// upgradeWithBackup runs the genesis binary of testdata/rollback up to the
+// chain2 upgrade, and returns the launcher.
+func upgradeWithBackup(t *testing.T, cfg *cosmovisor.Config) cosmovisor.Launcher {
+	t.Helper()
+
+	launcher, err := cosmovisor.NewLauncher(log.NewTestLogger(t), cfg)
+	require.NoError(t, err)
+
+	stdin, _ := os.Open(os.DevNull)
+	stdout, stderr := newBuffer(), newBuffer()
+	doUpgrade, err := launcher.Run([]string{"start", cfg.UpgradeInfoFilePath()}, stdin, stdout, stderr)
+	require.NoError(t, err)
+	require.True(t, doUpgrade)
+
+	status, err := cfg.UpgradeStatus()
+	require.NoError(t, err)
+	require.Equal(t, "chain2", status.Name)
+	require.Equal(t, int64(49), status.Height)
+	require.Equal(t, cosmovisor.UpgradeStatePending, status.State)
+	require.Equal(t, "genesis", status.PreviousLink)
+	require.DirExists(t, status.BackupDir)
+	require.FileExists(t, filepath.Join(status.BackupDir, "upgrade-info.json"))
+
+	return launcher
+}
+
+func requireRolledBack(t *testing.T, cfg *cosmovisor.Config, reason string) {
+	t.Helper()
+
+	status, err := cfg.UpgradeStatus()
+	require.NoError(t, err)
+	require.Equal(t, cosmovisor.UpgradeStateFailed, status.State)
+	require.Equal(t, reason, status.Reason)
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	genesisBin, err := filepath.EvalSymlinks(cfg.GenesisBin())
+	require.NoError(t, err)
+	require.Equal(t, genesisBin, currentBin)
+
+	// the data backup is restored, keeping the validator signing state
+	require.FileExists(t, cfg.UpgradeInfoFilePath())
+	privValidatorState, err := os.ReadFile(filepath.Join(cfg.Home, "data", "priv_validator_state.json"))
+	require.NoError(t, err)
+	require.Equal(t, "{\"height\":\"49\"}\n", string(privValidatorState))
+	require.DirExists(t, filepath.Join(cfg.Home, "data-failed-chain2"))
+}
+
+func TestRollbackOnCrashes(t *testing.T) {
+	cfg := prepareConfig(t, fmt.Sprintf("%s/%s", workDir, "testdata/rollback"), cosmovisor.Config{
+		Name:               "dummyd",
+		PollInterval:       15,
+		DataBackupPath:     t.TempDir(),
+		GRPCAddress:        "localhost:1",
+		RollbackMaxCrashes: 2,
+	})
+
+	launcher := upgradeWithBackup(t, cfg)
+
+	stdin, _ := os.Open(os.DevNull)
+	stdout, stderr := newBuffer(), newBuffer()
+	args := []string{"start", filepath.Join(cfg.Home, "data", "priv_validator_state.json")}
+
+	// first crash is recorded
+	_, err := launcher.Run(args, stdin, stdout, stderr)
+	require.ErrorContains(t, err, "exit status 2")
+	status, err := cfg.UpgradeStatus()
+	require.NoError(t, err)
+	require.Equal(t, cosmovisor.UpgradeStatePending, status.State)
+	require.Len(t, status.Crashes, 1)
+
+	// second crash rolls back the upgrade
+	_, err = launcher.Run(args, stdin, stdout, stderr)
+	require.ErrorContains(t, err, "upgrade failed and was rolled back")
+	requireRolledBack(t, cfg, "crashed 2 times within 10m0s")
+
+	// the upgrade cannot be rolled back twice
+	err = cosmovisor.Rollback(log.NewNopLogger(), cfg, "manual rollback")
+	require.ErrorContains(t, err, "was already rolled back")
+}
+
+func TestRollbackOnBlockTimeout(t *testing.T) {
+	cfg := prepareConfig(t, fmt.Sprintf("%s/%s", workDir, "testdata/rollback"), cosmovisor.Config{
+		Name:                 "dummyd",
+		PollInterval:         15,
+		DataBackupPath:       t.TempDir(),
+		GRPCAddress:          "localhost:1",
+		RollbackBlockTimeout: time.Second,
+	})
+
+	launcher := upgradeWithBackup(t, cfg)
+
+	stdin, _ := os.Open(os.DevNull)
+	stdout, stderr := newBuffer(), newBuffer()
+	args := []string{"hang", filepath.Join(cfg.Home, "data", "priv_validator_state.json")}
+
+	start := time.Now()
+	_, err := launcher.Run(args, stdin, stdout, stderr)
+	require.ErrorContains(t, err, "upgrade failed and was rolled back")
+	require.Less(t, time.Since(start), 10*time.Second)
+	requireRolledBack(t, cfg, "no block committed within 1s")
+}
+
+func TestNoRollbackAfterCrashWindow(t *testing.T) {
+	cfg := prepareConfig(t, fmt.Sprintf("%s/%s", workDir, "testdata/rollback"), cosmovisor.Config{
+		Name:                "dummyd",
+		PollInterval:        15,
+		DataBackupPath:      t.TempDir(),
+		GRPCAddress:         "localhost:1",
+		RollbackMaxCrashes:  1,
+		RollbackCrashWindow: time.Second,
+	})
+
+	launcher := upgradeWithBackup(t, cfg)
+
+	stdin, _ := os.Open(os.DevNull)
+	stdout, stderr := newBuffer(), newBuffer()
+	args := []string{"crash-late", filepath.Join(cfg.Home, "data", "priv_validator_state.json")}
+
+	// no block can be queried, but the binary ran for the crash window hence
+	// its crash does not roll back the upgrade
+	_, err := launcher.Run(args, stdin, stdout, stderr)
+	require.ErrorContains(t, err, "exit status 2")
+	status, err := cfg.UpgradeStatus()
+	require.NoError(t, err)
+	require.Equal(t, cosmovisor.UpgradeStateSucceeded, status.State)
+	require.Empty(t, status.Crashes)
+	require.NoDirExists(t, filepath.Join(cfg.Home, "data-failed-chain2"))
+}
+
+func TestManualRollback(t *testing.T) {
+	cfg := prepareConfig(t, fmt.Sprintf("%s/%s", workDir, "testdata/rollback"), cosmovisor.Config{
+		Name:           "dummyd",
+		PollInterval:   15,
+		DataBackupPath: t.TempDir(),
+	})
+
+	err := cosmovisor.Rollback(log.NewNopLogger(), cfg, "manual rollback")
+	require.ErrorContains(t, err, "no upgrade to roll back")
+
+	upgradeWithBackup(t, cfg)
+
+	err = os.WriteFile(filepath.Join(cfg.Home, "data", "priv_validator_state.json"), []byte("{\"height\":\"49\"}\n"), 0o600)
+	require.NoError(t, err)
+
+	require.NoError(t, cosmovisor.Rollback(log.NewNopLogger(), cfg, "manual rollback"))
+	requireRolledBack(t, cfg, "manual rollback")
+}
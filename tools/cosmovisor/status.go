@@ -0,0 +1,214 @@
+package cosmovisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// Status is the status of cosmovisor and of the app it runs.
+type Status struct {
+	DaemonName      string           `json:"daemon_name"`
+	CurrentBinary   string           `json:"current_binary"`
+	CurrentUpgrade  string           `json:"current_upgrade,omitempty"`
+	PendingUpgrades []PendingUpgrade `json:"pending_upgrades"`
+	App             AppStatus        `json:"app"`
+	LastEvent       *EventStatus     `json:"last_event,omitempty"`
+	Backup          *BackupStatus    `json:"backup,omitempty"`
+	LastUpgrade     *UpgradeStatus   `json:"last_upgrade,omitempty"`
+}
+
+// PendingUpgrade is an upgrade not performed yet, read from the upgrade-info.json
+// file written by the app or from the batch upgrade file.
+type PendingUpgrade struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info,omitempty"`
+	Source string `json:"source"`
+}
+
+// AppStatus is the status of the app process.
+type AppStatus struct {
+	Running   bool       `json:"running"`
+	PID       int        `json:"pid,omitempty"`
+	Binary    string     `json:"binary,omitempty"`
+	Args      []string   `json:"args,omitempty"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// Starts is the number of times the app was started by this cosmovisor process.
+	Starts int `json:"starts"`
+	// LastExitReason is the reason of the last exit of the app: upgrade, error or exit.
+	LastExitReason string     `json:"last_exit_reason,omitempty"`
+	LastExitError  string     `json:"last_exit_error,omitempty"`
+	LastExitAt     *time.Time `json:"last_exit_at,omitempty"`
+}
+
+// EventStatus is the last event of the upgrade process.
+type EventStatus struct {
+	Event   UpgradeEvent `json:"event"`
+	Upgrade string       `json:"upgrade"`
+	Height  int64        `json:"height"`
+	Error   string       `json:"error,omitempty"`
+	At      time.Time    `json:"at"`
+}
+
+// BackupStatus is the status of the last data backup.
+type BackupStatus struct {
+	Skipped     bool       `json:"skipped"`
+	Dir         string     `json:"dir,omitempty"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// statusTracker tracks the status of the app process and of the upgrade
+// process. It is shared by the copies of a Launcher and safe for concurrent use.
+type statusTracker struct {
+	mu     sync.Mutex
+	app    AppStatus
+	event  *EventStatus
+	backup *BackupStatus
+}
+
+func (s *statusTracker) appStarted(bin string, args []string, pid int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	s.app.Running = true
+	s.app.PID = pid
+	s.app.Binary = bin
+	s.app.Args = args
+	s.app.StartedAt = &now
+	s.app.Starts++
+}
+
+func (s *statusTracker) appExited(needsUpdate bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	s.app.Running = false
+	s.app.PID = 0
+	s.app.LastExitAt = &now
+	s.app.LastExitError = ""
+	switch {
+	case needsUpdate:
+		s.app.LastExitReason = "upgrade"
+	case err != nil:
+		s.app.LastExitReason = "error"
+		s.app.LastExitError = err.Error()
+	default:
+		s.app.LastExitReason = "exit"
+	}
+}
+
+func (s *statusTracker) setEvent(event UpgradeEvent, p upgradetypes.Plan, cause error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.event = &EventStatus{Event: event, Upgrade: p.Name, Height: p.Height, At: time.Now().UTC()}
+	if cause != nil {
+		s.event.Error = cause.Error()
+	}
+}
+
+func (s *statusTracker) setBackup(backup BackupStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.backup = &backup
+}
+
+// Status returns the status of cosmovisor and of the app it runs.
+func (l Launcher) Status() Status {
+	l.status.mu.Lock()
+	status := Status{DaemonName: l.cfg.Name, App: l.status.app}
+	if l.status.event != nil {
+		event := *l.status.event
+		status.LastEvent = &event
+	}
+	if l.status.backup != nil {
+		backup := *l.status.backup
+		status.Backup = &backup
+	}
+	l.status.mu.Unlock()
+
+	status.CurrentBinary = l.cfg.currentLinkTarget()
+	// the upgrade info of the current link is read without Config.UpgradeInfo,
+	// which caches it and is not safe for concurrent use
+	if current, err := parseUpgradeInfoFile(filepath.Join(l.cfg.Root(), currentLink, upgradetypes.UpgradeInfoFilename), l.cfg.DisableRecase); err == nil {
+		status.CurrentUpgrade = current.Name
+	}
+
+	status.PendingUpgrades = []PendingUpgrade{}
+	if info, err := parseUpgradeInfoFile(l.cfg.UpgradeInfoFilePath(), l.cfg.DisableRecase); err == nil && !strings.EqualFold(info.Name, status.CurrentUpgrade) {
+		status.PendingUpgrades = append(status.PendingUpgrades, PendingUpgrade{Name: info.Name, Height: info.Height, Info: info.Info, Source: upgradetypes.UpgradeInfoFilename})
+	}
+	if batch, err := loadBatchUpgradeFile(l.cfg); err == nil {
+		for _, p := range batch {
+			status.PendingUpgrades = append(status.PendingUpgrades, PendingUpgrade{Name: p.Name, Height: p.Height, Info: p.Info, Source: upgradetypes.UpgradeInfoFilename + ".batch"})
+		}
+	}
+
+	if upgrade, err := l.cfg.UpgradeStatus(); err == nil {
+		status.LastUpgrade = &upgrade
+	}
+
+	return status
+}
+
+// StatusHandler returns the handler of the status endpoint, serving the status
+// as JSON on GET /status.
+func (l Launcher) StatusHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+		if err := e.Encode(l.Status()); err != nil {
+			l.logger.Error("failed to encode status", "error", err)
+		}
+	})
+	return mux
+}
+
+// ServeStatus serves the status endpoint on the configured status address
+// until the context is done.
+func (l Launcher) ServeStatus(ctx context.Context) error {
+	listener, err := net.Listen("tcp", l.cfg.StatusAddress)
+	if err != nil {
+		return err
+	}
+
+	srv := &http.Server{Handler: l.StatusHandler(), ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	l.logger.Info("serving status", "address", listener.Addr().String())
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// recordBackup records the result of the data backup taken at the given time.
+func (l Launcher) recordBackup(startedAt time.Time, dir string, err error) {
+	backup := BackupStatus{Skipped: l.cfg.UnsafeSkipBackup, Dir: dir, StartedAt: startedAt.UTC()}
+	if err != nil {
+		backup.Error = err.Error()
+	} else if !l.cfg.UnsafeSkipBackup {
+		completedAt := time.Now().UTC()
+		backup.CompletedAt = &completedAt
+	}
+	l.status.setBackup(backup)
+}
@@ -0,0 +1,102 @@
+//go:build linux || darwin
+
+package cosmovisor_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func getStatus(t *testing.T, launcher cosmovisor.Launcher) cosmovisor.Status {
+	t.Helper()
+
+	srv := httptest.NewServer(launcher.StatusHandler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/status")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var status cosmovisor.Status
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+	return status
+}
+
+func TestStatus(t *testing.T) {
+	cfg := prepareConfig(t, fmt.Sprintf("%s/%s", workDir, "testdata/validate"), cosmovisor.Config{
+		Name:             "dummyd",
+		PollInterval:     15,
+		UnsafeSkipBackup: true,
+	})
+
+	launcher, err := cosmovisor.NewLauncher(log.NewTestLogger(t), cfg)
+	require.NoError(t, err)
+
+	status := getStatus(t, launcher)
+	require.Equal(t, "dummyd", status.DaemonName)
+	require.Equal(t, "genesis", status.CurrentBinary)
+	require.Empty(t, status.PendingUpgrades)
+	require.False(t, status.App.Running)
+	require.Zero(t, status.App.Starts)
+	require.Nil(t, status.LastEvent)
+	require.Nil(t, status.Backup)
+
+	// pending upgrades are read from the upgrade info and batch files
+	require.NoError(t, os.WriteFile(cfg.UpgradeInfoBatchFilePath(), []byte(`[{"name":"chain3","height":60},{"name":"chain4","height":70,"info":"{}"}]`), 0o600))
+	require.NoError(t, os.WriteFile(cfg.UpgradeInfoFilePath(), []byte(`{"name":"chain2","height":49}`), 0o600))
+
+	status = getStatus(t, launcher)
+	require.Equal(t, []cosmovisor.PendingUpgrade{
+		{Name: "chain2", Height: 49, Source: "upgrade-info.json"},
+		{Name: "chain3", Height: 60, Source: "upgrade-info.json.batch"},
+		{Name: "chain4", Height: 70, Info: "{}", Source: "upgrade-info.json.batch"},
+	}, status.PendingUpgrades)
+	require.NoError(t, os.Remove(cfg.UpgradeInfoBatchFilePath()))
+	require.NoError(t, os.Remove(cfg.UpgradeInfoFilePath()))
+
+	// the app status, backup status and last event are updated by the upgrade
+	stdin, _ := os.Open(os.DevNull)
+	stdout, stderr := newBuffer(), newBuffer()
+	args := []string{"foo", "bar", "1234", cfg.UpgradeInfoFilePath()}
+	doUpgrade, err := launcher.Run(args, stdin, stdout, stderr)
+	require.NoError(t, err)
+	require.True(t, doUpgrade)
+
+	status = getStatus(t, launcher)
+	require.Equal(t, "upgrades/chain2", status.CurrentBinary)
+	require.Equal(t, "chain2", status.CurrentUpgrade)
+	require.Empty(t, status.PendingUpgrades)
+
+	require.False(t, status.App.Running)
+	require.Equal(t, 1, status.App.Starts)
+	require.Equal(t, args, status.App.Args)
+	require.Equal(t, "upgrade", status.App.LastExitReason)
+	require.NotNil(t, status.App.LastExitAt)
+
+	require.NotNil(t, status.LastEvent)
+	require.Equal(t, cosmovisor.UpgradeCompleted, status.LastEvent.Event)
+	require.Equal(t, "chain2", status.LastEvent.Upgrade)
+	require.Equal(t, int64(49), status.LastEvent.Height)
+
+	require.NotNil(t, status.Backup)
+	require.True(t, status.Backup.Skipped)
+	require.Nil(t, status.Backup.CompletedAt)
+
+	// the exit of the app without upgrade is reported
+	_, err = launcher.Run([]string{"second", "run"}, stdin, stdout, stderr)
+	require.NoError(t, err)
+	status = getStatus(t, launcher)
+	require.Equal(t, 2, status.App.Starts)
+	require.Equal(t, "exit", status.App.LastExitReason)
+}
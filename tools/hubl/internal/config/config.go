@@ -24,6 +24,7 @@ type Config struct {
 type ChainConfig struct {
 	GRPCEndpoints  []GRPCEndpoint `toml:"trusted-grpc-endpoints"`
 	AddressPrefix  string         `toml:"address-prefix"`
+	ChainID        string         `toml:"chain-id"`
 	KeyringBackend string         `toml:"keyring-backend"`
 }
 
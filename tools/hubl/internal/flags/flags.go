@@ -8,6 +8,16 @@ const (
 	FlagOutput   = "output"
 
 	FlagKeyringBackend = "keyring-backend"
+
+	FlagChainID        = "chain-id"
+	FlagAccountNumber  = "account-number"
+	FlagSequence       = "sequence"
+	FlagFees           = "fees"
+	FlagGas            = "gas"
+	FlagMemo           = "memo"
+	FlagTimeoutHeight  = "timeout-height"
+	FlagOutputDocument = "output-document"
+	FlagBroadcastMode  = "broadcast-mode"
 )
 
 const (
@@ -15,4 +25,9 @@ const (
 	OutputFormatJSON = "json"
 
 	DefaultKeyringBackend = "os"
+
+	DefaultGasLimit = 200000
+
+	BroadcastSync  = "sync"
+	BroadcastAsync = "async"
 )
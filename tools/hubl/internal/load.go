@@ -18,6 +18,7 @@ import (
 
 	authv1betav1 "cosmossdk.io/api/cosmos/auth/v1beta1"
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	cmtv1beta1 "cosmossdk.io/api/cosmos/base/tendermint/v1beta1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"cosmossdk.io/tools/hubl/internal/config"
 )
@@ -199,3 +200,18 @@ func getAddressPrefix(ctx context.Context, conn grpc.ClientConnInterface) (strin
 
 	return resp.Bech32Prefix, nil
 }
+
+// getChainID returns the chain id of the chain.
+func getChainID(ctx context.Context, conn grpc.ClientConnInterface) (string, error) {
+	cmtClient := cmtv1beta1.NewServiceClient(conn)
+	resp, err := cmtClient.GetNodeInfo(ctx, &cmtv1beta1.GetNodeInfoRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	if resp.DefaultNodeInfo == nil || resp.DefaultNodeInfo.Network == "" {
+		return "", errors.New("chain id is not set")
+	}
+
+	return resp.DefaultNodeInfo.Network, nil
+}
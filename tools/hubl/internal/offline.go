@@ -0,0 +1,409 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/tools/hubl/internal/flags"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/direct"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkkeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// OfflineTxCmd returns the command building and signing transactions without
+// connecting to the chain. Its sub-commands mirror the AutoCLI tx commands of
+// the chain, built from the cached file descriptors and AutoCLI options.
+func OfflineTxCmd(chainInfo *ChainInfo, builder *flag.Builder, kr sdkkeyring.Keyring) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offline",
+		Short: fmt.Sprintf("Build and sign %s transactions offline", chainInfo.Chain),
+		Long: `Build and sign a transaction without connecting to the chain, given the account number and sequence of the signer.
+The signed transaction is written as JSON to the output document, and can be broadcasted later with the broadcast command.`,
+	}
+
+	moduleNames := make([]string, 0, len(chainInfo.ModuleOptions))
+	for moduleName := range chainInfo.ModuleOptions {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	signer := &offlineSigner{chainInfo: chainInfo, builder: builder, keyring: kr}
+	for _, moduleName := range moduleNames {
+		modOpts := chainInfo.ModuleOptions[moduleName]
+		if modOpts == nil || modOpts.Tx == nil {
+			continue
+		}
+
+		moduleCmd := &cobra.Command{
+			Use:   moduleName,
+			Short: fmt.Sprintf("Offline transactions commands for the %s module", moduleName),
+		}
+		if err := signer.addServiceCommands(moduleCmd, modOpts.Tx); err != nil {
+			// only the commands of this module are unavailable
+			moduleCmd.RunE = func(cmd *cobra.Command, args []string) error {
+				return fmt.Errorf("error while loading AutoCLI data for %s: %w", moduleName, err)
+			}
+		}
+
+		cmd.AddCommand(moduleCmd)
+	}
+
+	return cmd
+}
+
+// offlineSigner builds offline transactions commands and signs their messages.
+type offlineSigner struct {
+	chainInfo *ChainInfo
+	builder   *flag.Builder
+	keyring   sdkkeyring.Keyring
+}
+
+// addServiceCommands adds a command for each method of the Msg service, and
+// recursively of its sub-services, following their AutoCLI options.
+func (s *offlineSigner) addServiceCommands(cmd *cobra.Command, cmdDescriptor *autocliv1.ServiceCommandDescriptor) error {
+	for cmdName, subCmdDescriptor := range cmdDescriptor.SubCommands {
+		subCmd := &cobra.Command{
+			Use:   cmdName,
+			Short: fmt.Sprintf("Offline transactions commands for the %s service", subCmdDescriptor.Service),
+		}
+		if err := s.addServiceCommands(subCmd, subCmdDescriptor); err != nil {
+			return err
+		}
+
+		cmd.AddCommand(subCmd)
+	}
+
+	if cmdDescriptor.Service == "" {
+		return nil
+	}
+
+	descriptor, err := s.chainInfo.ProtoFiles.FindDescriptorByName(protoreflect.FullName(cmdDescriptor.Service))
+	if err != nil {
+		return fmt.Errorf("can't find service %s: %w", cmdDescriptor.Service, err)
+	}
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return fmt.Errorf("%s is not a service", cmdDescriptor.Service)
+	}
+
+	rpcOptMap := map[protoreflect.Name]*autocliv1.RpcCommandOptions{}
+	for _, option := range cmdDescriptor.RpcCommandOptions {
+		rpcOptMap[protoreflect.Name(option.RpcMethod)] = option
+	}
+
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		methodOpts := &autocliv1.RpcCommandOptions{}
+		if opts, ok := rpcOptMap[method.Name()]; ok {
+			// the options are cloned as they are modified by the flag builder
+			methodOpts = proto.Clone(opts).(*autocliv1.RpcCommandOptions)
+		}
+
+		if methodOpts.Skip {
+			continue
+		}
+
+		methodCmd, err := s.methodCommand(method, methodOpts)
+		if err != nil {
+			return err
+		}
+
+		cmd.AddCommand(methodCmd)
+	}
+
+	return nil
+}
+
+// methodCommand returns the command building the message of the method and
+// signing it in a transaction.
+func (s *offlineSigner) methodCommand(method protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
+	use := options.Use
+	if use == "" {
+		use = protoNameToCliName(method.Name())
+	}
+
+	short := options.Short
+	if short == "" {
+		short = fmt.Sprintf("Sign a %s message offline", method.Input().FullName())
+	}
+
+	cmd := &cobra.Command{
+		Use:          use,
+		Short:        short,
+		Long:         options.Long,
+		Example:      options.Example,
+		Aliases:      options.Alias,
+		SilenceUsage: true,
+	}
+
+	inputType, err := dynamicTypeResolver{s.chainInfo}.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	binder, err := s.builder.AddMessageFlags(context.Background(), cmd.Flags(), inputType, options)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Args = binder.CobraArgs
+
+	cmd.Flags().String(flags.FlagChainID, s.chainInfo.Config.ChainID, "the chain id of the chain")
+	cmd.Flags().Uint64(flags.FlagAccountNumber, 0, "the account number of the signer")
+	cmd.Flags().Uint64(flags.FlagSequence, 0, "the sequence of the signer")
+	cmd.Flags().String(flags.FlagFees, "", "fees to pay along with the transaction, e.g. 10uatom")
+	cmd.Flags().Uint64(flags.FlagGas, flags.DefaultGasLimit, "gas limit of the transaction")
+	cmd.Flags().String(flags.FlagMemo, "", "memo of the transaction")
+	cmd.Flags().Uint64(flags.FlagTimeoutHeight, 0, "block height after which the transaction is not valid anymore")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "write the signed transaction to the given file instead of the standard output")
+	required := []string{flags.FlagAccountNumber, flags.FlagSequence}
+	if binder.SignerInfo.IsFlag {
+		required = append(required, binder.SignerInfo.FieldName)
+	}
+	for _, required := range required {
+		if err := cmd.MarkFlagRequired(required); err != nil {
+			return nil, err
+		}
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		msg, err := binder.BuildMessage(args)
+		if err != nil {
+			return err
+		}
+
+		txRaw, err := s.sign(cmd, msg)
+		if err != nil {
+			return err
+		}
+
+		bz, err := protojson.MarshalOptions{Indent: "  "}.Marshal(txRaw)
+		if err != nil {
+			return err
+		}
+
+		output, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+		if output == "" {
+			cmd.Println(string(bz))
+			return nil
+		}
+
+		if err := os.WriteFile(output, bz, 0o600); err != nil {
+			return err
+		}
+
+		cmd.Printf("Signed transaction written to %s\n", output)
+		return nil
+	}
+
+	return cmd, nil
+}
+
+// sign builds a transaction holding the message and signs it in SIGN_MODE_DIRECT
+// with the key of the message signer.
+func (s *offlineSigner) sign(cmd *cobra.Command, msg protoreflect.Message) (*txv1beta1.TxRaw, error) {
+	chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+	accountNumber, _ := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+	sequence, _ := cmd.Flags().GetUint64(flags.FlagSequence)
+	gas, _ := cmd.Flags().GetUint64(flags.FlagGas)
+	memo, _ := cmd.Flags().GetString(flags.FlagMemo)
+	timeoutHeight, _ := cmd.Flags().GetUint64(flags.FlagTimeoutHeight)
+	feesStr, _ := cmd.Flags().GetString(flags.FlagFees)
+	if chainID == "" {
+		return nil, fmt.Errorf("the chain id is unknown, set it with the --%s flag", flags.FlagChainID)
+	}
+
+	fees, err := sdk.ParseCoinsNormalized(feesStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fees: %w", err)
+	}
+
+	record, err := s.signerKey(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalOpts := proto.MarshalOptions{Deterministic: true}
+	msgBytes, err := marshalOpts.Marshal(msg.Interface())
+	if err != nil {
+		return nil, err
+	}
+	msgAny := &anypb.Any{TypeUrl: "/" + string(msg.Descriptor().FullName()), Value: msgBytes}
+
+	body := &txv1beta1.TxBody{
+		Messages:      []*anypb.Any{msgAny},
+		Memo:          memo,
+		TimeoutHeight: timeoutHeight,
+	}
+
+	authInfo := &txv1beta1.AuthInfo{
+		SignerInfos: []*txv1beta1.SignerInfo{
+			{
+				PublicKey: &anypb.Any{TypeUrl: pubKeyAny.TypeUrl, Value: pubKeyAny.Value},
+				ModeInfo: &txv1beta1.ModeInfo{
+					Sum: &txv1beta1.ModeInfo_Single_{Single: &txv1beta1.ModeInfo_Single{Mode: signingv1beta1.SignMode_SIGN_MODE_DIRECT}},
+				},
+				Sequence: sequence,
+			},
+		},
+		Fee: &txv1beta1.Fee{GasLimit: gas},
+	}
+	for _, fee := range fees {
+		authInfo.Fee.Amount = append(authInfo.Fee.Amount, &basev1beta1.Coin{Denom: fee.Denom, Amount: fee.Amount.String()})
+	}
+
+	bodyBytes, err := marshalOpts.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	authInfoBytes, err := marshalOpts.Marshal(authInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes, err := direct.SignModeHandler{}.GetSignBytes(
+		cmd.Context(),
+		signing.SignerData{ChainID: chainID, AccountNumber: accountNumber, Sequence: sequence},
+		signing.TxData{Body: body, AuthInfo: authInfo, BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, _, err := s.keyring.Sign(record.Name, signBytes, signingtypes.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txv1beta1.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{signature},
+	}, nil
+}
+
+// signerKey returns the keyring record of the signer of the message.
+func (s *offlineSigner) signerKey(msg protoreflect.Message) (*sdkkeyring.Record, error) {
+	signerField := msg.Descriptor().Fields().ByName(protoreflect.Name(flag.GetSignerFieldName(msg.Descriptor())))
+	if signerField == nil {
+		return nil, fmt.Errorf("no signer defined for %s", msg.Descriptor().FullName())
+	}
+
+	signer := msg.Get(signerField).String()
+	if signer == "" {
+		return nil, errors.New("the signer of the message is not set")
+	}
+
+	addressCodec := s.builder.AddressCodec
+	if scalarType, ok := flag.GetScalarType(signerField); ok {
+		switch scalarType {
+		case flag.ValidatorAddressStringScalarType:
+			addressCodec = s.builder.ValidatorAddressCodec
+		case flag.ConsensusAddressStringScalarType:
+			addressCodec = s.builder.ConsensusAddressCodec
+		}
+	}
+
+	addr, err := addressCodec.StringToBytes(signer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer %s: %w", signer, err)
+	}
+
+	record, err := s.keyring.KeyByAddress(sdk.AccAddress(addr))
+	if err != nil {
+		return nil, fmt.Errorf("signer %s not found in the keyring: %w", signer, err)
+	}
+
+	return record, nil
+}
+
+// BroadcastCmd returns the command broadcasting a transaction signed offline.
+func BroadcastCmd(chainInfo *ChainInfo) *cobra.Command {
+	var mode string
+
+	cmd := &cobra.Command{
+		Use:          "broadcast <signed-tx-file>",
+		Short:        fmt.Sprintf("Broadcast a transaction signed offline to %s", chainInfo.Chain),
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var broadcastMode txv1beta1.BroadcastMode
+			switch mode {
+			case flags.BroadcastSync:
+				broadcastMode = txv1beta1.BroadcastMode_BROADCAST_MODE_SYNC
+			case flags.BroadcastAsync:
+				broadcastMode = txv1beta1.BroadcastMode_BROADCAST_MODE_ASYNC
+			default:
+				return fmt.Errorf("invalid broadcast mode %q, expected %s or %s", mode, flags.BroadcastSync, flags.BroadcastAsync)
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var txRaw txv1beta1.TxRaw
+			if err := protojson.Unmarshal(bz, &txRaw); err != nil {
+				return fmt.Errorf("failed to parse signed transaction: %w", err)
+			}
+
+			txBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(&txRaw)
+			if err != nil {
+				return err
+			}
+
+			client, err := chainInfo.OpenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := txv1beta1.NewServiceClient(client).BroadcastTx(cmd.Context(), &txv1beta1.BroadcastTxRequest{
+				TxBytes: txBytes,
+				Mode:    broadcastMode,
+			})
+			if err != nil {
+				return err
+			}
+
+			out, err := protojson.MarshalOptions{Indent: "  "}.Marshal(res.TxResponse)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&mode, flags.FlagBroadcastMode, flags.BroadcastSync, fmt.Sprintf("transaction broadcasting mode (%s|%s)", flags.BroadcastSync, flags.BroadcastAsync))
+
+	return cmd
+}
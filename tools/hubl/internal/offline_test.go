@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	_ "cosmossdk.io/api/cosmos/bank/v1beta1"
+	abciv1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/tools/hubl/internal/config"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdkkeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const testChainID = "test-chain"
+
+// testRecipient is an address which is not in the keyring.
+var testRecipient, _ = address.NewBech32Codec("cosmos").BytesToString(bytes.Repeat([]byte{1}, 20))
+
+// txServer records the broadcasted transactions.
+type txServer struct {
+	txv1beta1.UnimplementedServiceServer
+	txs [][]byte
+}
+
+func (s *txServer) BroadcastTx(_ context.Context, req *txv1beta1.BroadcastTxRequest) (*txv1beta1.BroadcastTxResponse, error) {
+	s.txs = append(s.txs, req.TxBytes)
+	return &txv1beta1.BroadcastTxResponse{TxResponse: &abciv1beta1.TxResponse{Txhash: "ABCD"}}, nil
+}
+
+type offlineFixture struct {
+	chainInfo *ChainInfo
+	builder   *flag.Builder
+	keyring   sdkkeyring.Keyring
+	signer    *sdkkeyring.Record
+	server    *txServer
+}
+
+func newOfflineFixture(t *testing.T) offlineFixture {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	kr := sdkkeyring.NewInMemory(codec.NewProtoCodec(registry))
+	signer, _, err := kr.NewMnemonic("alice", sdkkeyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+	autoCLIKeyring, err := sdkkeyring.NewAutoCLIKeyring(kr)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &txServer{}
+	grpcServer := grpc.NewServer()
+	txv1beta1.RegisterServiceServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	chainInfo := NewChainInfo(t.TempDir(), "test", &config.ChainConfig{
+		ChainID:       testChainID,
+		GRPCEndpoints: []config.GRPCEndpoint{{Endpoint: lis.Addr().String(), Insecure: true}},
+	})
+	chainInfo.ProtoFiles = protoregistry.GlobalFiles
+	chainInfo.ModuleOptions = map[string]*autocliv1.ModuleOptions{
+		"bank": {
+			Tx: &autocliv1.ServiceCommandDescriptor{
+				Service: "cosmos.bank.v1beta1.Msg",
+				RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+					{
+						RpcMethod: "Send",
+						Use:       "send [from_address] [to_address] [amount]",
+						PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+							{ProtoField: "from_address"},
+							{ProtoField: "to_address"},
+							{ProtoField: "amount", Varargs: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return offlineFixture{
+		chainInfo: chainInfo,
+		builder: &flag.Builder{
+			TypeResolver:          &dynamicTypeResolver{chainInfo},
+			FileResolver:          chainInfo.ProtoFiles,
+			AddressCodec:          address.NewBech32Codec("cosmos"),
+			ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+			ConsensusAddressCodec: address.NewBech32Codec("cosmosvalcons"),
+			Keyring:               autoCLIKeyring,
+		},
+		keyring: kr,
+		signer:  signer,
+		server:  server,
+	}
+}
+
+func runCmd(cmd *cobra.Command, args ...string) (string, error) {
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestOfflineSignAndBroadcast(t *testing.T) {
+	f := newOfflineFixture(t)
+	signerAddr, err := f.signer.GetAddress()
+	require.NoError(t, err)
+
+	txFile := filepath.Join(t.TempDir(), "tx.json")
+	out, err := runCmd(OfflineTxCmd(f.chainInfo, f.builder, f.keyring),
+		"bank", "send", signerAddr.String(), testRecipient, "10stake",
+		"--account-number", "3", "--sequence", "7", "--fees", "5stake", "--memo", "offline",
+		"--output-document", txFile,
+	)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "Signed transaction written to "+txFile)
+
+	bz, err := os.ReadFile(txFile)
+	require.NoError(t, err)
+	var txRaw txv1beta1.TxRaw
+	require.NoError(t, protojson.Unmarshal(bz, &txRaw))
+
+	var body txv1beta1.TxBody
+	require.NoError(t, proto.Unmarshal(txRaw.BodyBytes, &body))
+	require.Equal(t, "offline", body.Memo)
+	require.Len(t, body.Messages, 1)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", body.Messages[0].TypeUrl)
+
+	var authInfo txv1beta1.AuthInfo
+	require.NoError(t, proto.Unmarshal(txRaw.AuthInfoBytes, &authInfo))
+	require.Len(t, authInfo.SignerInfos, 1)
+	require.Equal(t, uint64(7), authInfo.SignerInfos[0].Sequence)
+	require.Equal(t, "5", authInfo.Fee.Amount[0].Amount)
+
+	// the transaction is signed in SIGN_MODE_DIRECT by the key of the signer
+	signDoc, err := proto.MarshalOptions{Deterministic: true}.Marshal(&txv1beta1.SignDoc{
+		BodyBytes:     txRaw.BodyBytes,
+		AuthInfoBytes: txRaw.AuthInfoBytes,
+		ChainId:       testChainID,
+		AccountNumber: 3,
+	})
+	require.NoError(t, err)
+	pubKey, err := f.signer.GetPubKey()
+	require.NoError(t, err)
+	require.Len(t, txRaw.Signatures, 1)
+	require.True(t, pubKey.VerifySignature(signDoc, txRaw.Signatures[0]))
+
+	// the signed transaction is broadcasted as is
+	out, err = runCmd(BroadcastCmd(f.chainInfo), txFile)
+	require.NoError(t, err, out)
+	require.Contains(t, out, `"txhash": "ABCD"`)
+	require.Len(t, f.server.txs, 1)
+	txBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(&txRaw)
+	require.NoError(t, err)
+	require.Equal(t, txBytes, f.server.txs[0])
+
+	_, err = runCmd(BroadcastCmd(f.chainInfo), txFile, "--broadcast-mode", "block")
+	require.ErrorContains(t, err, `invalid broadcast mode "block"`)
+}
+
+func TestOfflineSignRequiredFlags(t *testing.T) {
+	f := newOfflineFixture(t)
+	signerAddr, err := f.signer.GetAddress()
+	require.NoError(t, err)
+
+	_, err = runCmd(OfflineTxCmd(f.chainInfo, f.builder, f.keyring),
+		"bank", "send", signerAddr.String(), testRecipient, "10stake", "--sequence", "7",
+	)
+	require.ErrorContains(t, err, `required flag(s) "account-number" not set`)
+
+	_, err = runCmd(OfflineTxCmd(f.chainInfo, f.builder, f.keyring),
+		"bank", "send", signerAddr.String(), testRecipient, "10stake", "--account-number", "3",
+	)
+	require.ErrorContains(t, err, `required flag(s) "sequence" not set`)
+}
+
+func TestOfflineSignerLookup(t *testing.T) {
+	f := newOfflineFixture(t)
+
+	// the signer must be in the keyring
+	_, err := runCmd(OfflineTxCmd(f.chainInfo, f.builder, f.keyring),
+		"bank", "send", testRecipient, testRecipient, "10stake", "--account-number", "3", "--sequence", "7",
+	)
+	require.ErrorContains(t, err, "signer "+testRecipient+" not found in the keyring")
+
+	_, err = runCmd(OfflineTxCmd(f.chainInfo, f.builder, f.keyring),
+		"bank", "send", "cosmos1invalid", testRecipient, "10stake", "--account-number", "3", "--sequence", "7",
+	)
+	require.ErrorContains(t, err, "invalid signer cosmos1invalid")
+}
@@ -121,6 +121,9 @@ func RemoteCommand(config *config.Config, configDir string) ([]*cobra.Command, e
 		// add chain specific keyring
 		chainCmd.AddCommand(KeyringCmd(chainInfo.Chain))
 
+		// add offline signing and broadcasting of transactions
+		chainCmd.AddCommand(OfflineTxCmd(chainInfo, &builder.Builder, kr), BroadcastCmd(chainInfo))
+
 		// add client context
 		clientCtx := client.Context{}.WithKeyring(kr)
 		chainCmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
@@ -195,8 +198,15 @@ func reconfigure(cmd *cobra.Command, cfg *config.Config, configDir, chain string
 		return err
 	}
 
+	// the chain id is only needed to sign transactions offline, and can be set with a flag
+	chainID, err := getChainID(context.Background(), client)
+	if err != nil {
+		cmd.Printf("Unable to fetch the chain id: %v\n", err)
+	}
+
 	chainConfig.KeyringBackend = flags.DefaultKeyringBackend
 	chainConfig.AddressPrefix = addressPrefix
+	chainConfig.ChainID = chainID
 	cfg.Chains[chain] = chainConfig
 
 	if err := config.Save(configDir, cfg); err != nil {
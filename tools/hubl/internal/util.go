@@ -3,6 +3,9 @@ package internal
 import (
 	"fmt"
 
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/tools/hubl/internal/config"
 
@@ -41,3 +44,8 @@ func getAddressPrefixFromConfig(cfg *config.Config, chainName string) (string, s
 
 	return "cosmos", "cosmosvaloper", "cosmosvalcons", nil
 }
+
+// protoNameToCliName returns the command name of a proto method, following AutoCLI
+func protoNameToCliName(name protoreflect.Name) string {
+	return strcase.ToKebab(string(name))
+}
@@ -340,14 +340,34 @@ func (c Context) Value(key interface{}) interface{} {
 // Store / Caching
 // ----------------------------------------------------------------------------
 
+// StoreGasMeter is a GasMeter able to attribute the gas consumed by the
+// accesses to a store to the store key, e.g. for profiling.
+type StoreGasMeter interface {
+	storetypes.GasMeter
+
+	// ForStore returns the gas meter charged by the accesses to the store of
+	// the given key.
+	ForStore(storeKey string) storetypes.GasMeter
+}
+
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), c.transientKVGasConfig)
+}
+
+// storeGasMeter returns the gas meter charged by the accesses to the store of
+// the given key.
+func (c Context) storeGasMeter(key storetypes.StoreKey) storetypes.GasMeter {
+	if meter, ok := c.gasMeter.(StoreGasMeter); ok {
+		return meter.ForStore(key.Name())
+	}
+
+	return c.gasMeter
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
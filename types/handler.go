@@ -1,5 +1,13 @@
 package types
 
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
 // AnteHandler authenticates transactions, before their internal messages are
 // executed. The provided ctx is expected to contain all relevant information
 // needed to process the transaction, e.g. fee payment information. If new data
@@ -51,7 +59,14 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 	for i := 0; i < len(chain); i++ {
 		ii := i
-		handlerChain[ii] = func(ctx Context, tx Tx, _ bool) (Context, error) {
+		name := fmt.Sprintf("%T", chain[ii])
+		handlerChain[ii] = func(ctx Context, tx Tx, _ bool) (newCtx Context, err error) {
+			spanCtx, span := telemetry.StartSpan(ctx.Context(), name, attribute.String(telemetry.AttributeKeyAnteDecorator, name))
+			defer func() { telemetry.EndSpan(span, err) }()
+			if span.IsRecording() {
+				ctx = ctx.WithContext(spanCtx)
+			}
+
 			return chain[ii].AnteHandle(ctx, tx, ctx.ExecMode() == ExecModeSimulate, handlerChain[ii+1])
 		}
 	}
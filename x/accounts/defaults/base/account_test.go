@@ -2,7 +2,12 @@ package base
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"testing"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
@@ -18,6 +23,8 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
@@ -267,3 +274,93 @@ func toAnyPb(t *testing.T, pm gogoproto.Message) *codectypes.Any {
 	require.NoError(t, err)
 	return pb
 }
+
+func TestAuthenticateWebAuthn(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	deps := makeMockDependencies(ss)
+	_, acc, err := NewAccount("base", signing.NewHandlerMap(directHandler{}), WithWebAuthnPubKey())(deps)
+	require.NoError(t, err)
+	baseAcc := acc.(Account)
+
+	privKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	pubKey := webauthn.NewPubKey(privKey.PubKey().(*secp256r1.PubKey), "example.com")
+
+	_, err = baseAcc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, &webauthn.PubKey{Key: pubKey.Key})})
+	require.Error(t, err, "a relying party is required")
+	_, err = baseAcc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, pubKey)})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	transaction := tx.Tx{
+		Body: &tx.TxBody{},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: pkAny,
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	bodyByte, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authByte, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+	signBytes, err := (&tx.SignDoc{
+		BodyBytes:     bodyByte,
+		AuthInfoBytes: authByte,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}).Marshal()
+	require.NoError(t, err)
+
+	// the assertion returned by the authenticator for the challenge of the sign bytes
+	rpIDHash := sha256.Sum256([]byte(pubKey.RpID))
+	authData := append(rpIDHash[:], 0x05, 0, 0, 0, 1)
+	clientData := fmt.Sprintf(`{"type":"webauthn.get","challenge":%q,"origin":"https://example.com"}`,
+		base64.RawURLEncoding.EncodeToString(webauthn.Challenge(signBytes)))
+	clientDataHash := sha256.Sum256([]byte(clientData))
+	hash := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, &privKey.Secret.PrivateKey, hash[:])
+	require.NoError(t, err)
+	assertion, err := (&webauthn.Assertion{
+		AuthenticatorData: authData,
+		ClientDataJSON:    []byte(clientData),
+		Signature:         sig,
+	}).Marshal()
+	require.NoError(t, err)
+
+	transaction.Signatures = [][]byte{assertion}
+	rawTx := tx.TxRaw{
+		BodyBytes:     bodyByte,
+		AuthInfoBytes: authByte,
+		Signatures:    transaction.Signatures,
+	}
+	_, err = baseAcc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{
+		RawTx:       &rawTx,
+		Tx:          &transaction,
+		SignerIndex: 0,
+	})
+	require.NoError(t, err)
+
+	// the assertion is bound to the sign bytes it was made for
+	transaction.AuthInfo.SignerInfos[0].Sequence = 1
+	rawTx.AuthInfoBytes, err = transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+	_, err = baseAcc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{
+		RawTx:       &rawTx,
+		Tx:          &transaction,
+		SignerIndex: 0,
+	})
+	require.Equal(t, errors.New("signature verification failed"), err)
+}
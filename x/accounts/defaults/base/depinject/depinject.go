@@ -22,6 +22,10 @@ func ProvideSecp256K1PubKey() base.Option {
 	return base.WithSecp256K1PubKey()
 }
 
+func ProvideWebAuthnPubKey() base.Option {
+	return base.WithWebAuthnPubKey()
+}
+
 func ProvideCustomPubkey[T any, PT base.PubKeyG[T]]() base.Option {
 	return base.WithPubKey[T, PT]()
 }
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/core/transaction"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 )
 
 // this file implements a general mechanism to plugin public keys to a baseaccount
@@ -37,6 +38,14 @@ func WithSecp256K1PubKey() Option {
 	})
 }
 
+// WithWebAuthnPubKey adds support for WebAuthn credentials, such as passkeys,
+// whose signatures are assertions of the hash of the sign bytes.
+func WithWebAuthnPubKey() Option {
+	return WithPubKeyWithValidationFunc(func(pt *webauthn.PubKey) error {
+		return pt.Validate()
+	})
+}
+
 func WithPubKey[T any, PT PubKeyG[T]]() Option {
 	return WithPubKeyWithValidationFunc[T, PT](func(_ PT) error {
 		return nil
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -22,6 +23,14 @@ type AccountKeeper interface {
 	GetEnvironment() appmodule.Environment
 }
 
+// UnorderedTxStore defines the expected store of the unordered transactions
+// included in blocks, which are tracked until their timeout to reject their
+// duplicates.
+type UnorderedTxStore interface {
+	ContainsUnorderedTx(ctx context.Context, txHash [32]byte, timeout time.Time) (bool, error)
+	AddUnorderedTx(ctx context.Context, txHash [32]byte, timeout time.Time) error
+}
+
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
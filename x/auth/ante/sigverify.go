@@ -17,10 +17,12 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -63,6 +65,10 @@ type AccountAbstractionKeeper interface {
 // signatures on ReCheckTx. It will also increase the sequence number, and consume
 // gas for signature verification.
 //
+// The signatures of the BLS12-381 single signers are verified at once against
+// their aggregate, so that a tx may carry a single aggregated signature for all
+// of them, see authsigning.AggregateSignatureVerifier.
+//
 // In cases where unordered or parallel transactions are desired, it is recommended
 // to set unordered=true with a reasonable timeout_height value, in which case
 // this nonce verification and increment will be skipped.
@@ -142,6 +148,23 @@ func (svd SigVerificationDecorator) VerifyIsOnCurve(pubKey cryptotypes.PubKey) e
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key is not on curve")
 		}
 
+	case *webauthn.PubKey:
+		if err := typedPubKey.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+
+	case *bls12_381.PubKey:
+		if err := typedPubKey.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "bls12_381 key is not on curve: %s", err)
+		}
+
+	case *bls12_381.MultiPubKey:
+		for _, key := range typedPubKey.PubKeys {
+			if err := (bls12_381.PubKey{Key: key}).Validate(); err != nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "some keys are not on curve")
+			}
+		}
+
 	case multisig.PubKey:
 		pubKeysObjects := typedPubKey.GetPubKeys()
 		ok := true
@@ -205,13 +228,18 @@ func (svd SigVerificationDecorator) ValidateTx(ctx context.Context, tx transacti
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid number of pubkeys; expected %d, got %d", len(signers), len(pubKeys))
 	}
 
+	aggregate := &authsigning.AggregateSignatureVerifier{}
 	for i := range signers {
-		err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i)
+		err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i, aggregate)
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := aggregate.Verify(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
 	eventMgr := svd.ak.GetEnvironment().EventService.EventManager(ctx)
 	events := [][]event.Attribute{}
 	for i, sig := range signatures {
@@ -241,7 +269,17 @@ func (svd SigVerificationDecorator) ValidateTx(ctx context.Context, tx transacti
 }
 
 // authenticate the authentication of the TX for a specific tx signer.
-func (svd SigVerificationDecorator) authenticate(ctx context.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, signerIndex int) error {
+// The signatures of BLS12-381 single signers are added to the aggregate, which must be verified once all the
+// signers are authenticated.
+func (svd SigVerificationDecorator) authenticate(
+	ctx context.Context,
+	tx authsigning.Tx,
+	signer []byte,
+	sig signing.SignatureV2,
+	txPubKey cryptotypes.PubKey,
+	signerIndex int,
+	aggregate *authsigning.AggregateSignatureVerifier,
+) error {
 	// first we check if it's an AA
 	if svd.aaKeeper != nil {
 		isAa, err := svd.aaKeeper.IsAbstractedAccount(ctx, signer)
@@ -282,7 +320,7 @@ func (svd SigVerificationDecorator) authenticate(ctx context.Context, tx authsig
 		return err
 	}
 
-	err = svd.verifySig(ctx, tx, acc, sig, newlyCreated)
+	err = svd.verifySig(ctx, tx, acc, sig, newlyCreated, aggregate)
 	if err != nil {
 		return err
 	}
@@ -316,8 +354,9 @@ func (svd SigVerificationDecorator) consumeSignatureGas(
 	return svd.sigGasConsumer(svd.ak.GetEnvironment().GasService.GasMeter(ctx), signature, svd.ak.GetParams(ctx))
 }
 
-// verifySig will verify the signature of the provided signer account.
-func (svd SigVerificationDecorator) verifySig(ctx context.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool) error {
+// verifySig will verify the signature of the provided signer account, or add it to the aggregate for BLS12-381
+// single signers.
+func (svd SigVerificationDecorator) verifySig(ctx context.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool, aggregate *authsigning.AggregateSignatureVerifier) error {
 	execMode := svd.ak.GetEnvironment().TransactionService.ExecMode(ctx)
 	if execMode == transaction.ExecModeCheck {
 		if sig.Sequence < acc.GetSequence() {
@@ -383,7 +422,14 @@ func (svd SigVerificationDecorator) verifySig(ctx context.Context, tx sdk.Tx, ac
 		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 	}
 	txData := adaptableTx.GetSigningTxData()
-	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+	var err error
+	blsPubKey, isBLS := pubKey.(*bls12_381.PubKey)
+	singleSig, isSingle := sig.Data.(*signing.SingleSignatureData)
+	if isBLS && isSingle {
+		err = aggregate.Add(ctx, blsPubKey, signerData, singleSig, svd.signModeHandler, txData)
+	} else {
+		err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+	}
 	if err != nil {
 		var errMsg string
 		if OnlyLegacyAminoSigners(sig.Data) {
@@ -545,6 +591,16 @@ func DefaultSigVerificationGasConsumer(meter gas.Meter, sig signing.SignatureV2,
 	case *secp256r1.PubKey:
 		return meter.Consume(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 
+	case *webauthn.PubKey:
+		return meter.Consume(params.SigVerifyCostSecp256r1(), "ante verify: webauthn")
+
+	case *bls12_381.PubKey:
+		return meter.Consume(params.SigVerifyCostBls12381(), "ante verify: bls12_381")
+
+	case *bls12_381.MultiPubKey:
+		// the signature of a MultiPubKey is verified at once, whatever the number of signers
+		return meter.Consume(params.SigVerifyCostBls12381(), "ante verify: bls12_381 multi")
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -633,6 +689,10 @@ func CountSubKeys(pub cryptotypes.PubKey) int {
 		return 0
 	}
 
+	if v, ok := pub.(*bls12_381.MultiPubKey); ok {
+		return len(v.PubKeys)
+	}
+
 	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
 	if !ok {
 		return 1
@@ -0,0 +1,183 @@
+//go:build bls12381
+
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func genBLSPrivKey(t *testing.T) cryptotypes.PrivKey {
+	t.Helper()
+
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	return &privKey
+}
+
+func TestSigVerificationBLSAggregate(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	privs := []cryptotypes.PrivKey{genBLSPrivKey(t), secp256k1.GenPrivKey(), genBLSPrivKey(t), genBLSPrivKey(t)}
+	blsIndexes := []int{0, 2, 3}
+	msgs := make([]sdk.Msg, len(privs))
+	accNums := make([]uint64, len(privs))
+	accSeqs := make([]uint64, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		require.NoError(t, acc.SetAccountNumber(uint64(i)+1000))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+		accNums[i] = acc.GetAccountNumber()
+	}
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	sigs, err := tx.GetSignaturesV2()
+	require.NoError(t, err)
+
+	signature := func(i int) []byte {
+		return sigs[i].Data.(*signing.SingleSignatureData).Signature
+	}
+	withSignatures := func(blsSigs ...[]byte) []signing.SignatureV2 {
+		newSigs := make([]signing.SignatureV2, len(sigs))
+		copy(newSigs, sigs)
+		for i, index := range blsIndexes {
+			newSigs[index].Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: blsSigs[i]}
+		}
+		return newSigs
+	}
+	aggregate, err := bls12_381.AggregateSignatures([][]byte{signature(0), signature(2), signature(3)})
+	require.NoError(t, err)
+	partialAggregate, err := bls12_381.AggregateSignatures([][]byte{signature(0), signature(2)})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		sigs      []signing.SignatureV2
+		shouldErr bool
+	}{
+		{"individual signatures", sigs, false},
+		{"single aggregated signature", withSignatures(aggregate, nil, nil), false},
+		{"aggregated signature carried by another signer", withSignatures(nil, nil, aggregate), false},
+		{"partially aggregated signatures", withSignatures(partialAggregate, nil, signature(3)), false},
+		{"missing signature", withSignatures(partialAggregate, nil, nil), true},
+		{"no signature", withSignatures(nil, nil, nil), true},
+		{"signatures of other signers", withSignatures(signature(2), signature(0), signature(3)), false},
+		{"duplicated signature", withSignatures(signature(0), signature(0), signature(3)), true},
+	}
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			require.NoError(t, suite.txBuilder.SetSignatures(tc.sigs...))
+			tx := suite.txBuilder.GetTx()
+
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			ctx = ctx.WithTxBytes(txBytes)
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSigVerificationBLSMultiPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	privs := []cryptotypes.PrivKey{genBLSPrivKey(t), genBLSPrivKey(t), genBLSPrivKey(t)}
+	pubKeys := make([]cryptotypes.PubKey, len(privs))
+	for i, priv := range privs {
+		pubKeys[i] = priv.PubKey()
+	}
+	multiPubKey, err := bls12_381.NewMultiPubKey(2, pubKeys)
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress(multiPubKey.Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multiPubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: 0,
+	}))
+
+	signBytes, err := authsign.GetSignBytesAdapter(suite.ctx, suite.clientCtx.TxConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, authsign.SignerData{
+		Address:       addr.String(),
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      0,
+		PubKey:        multiPubKey,
+	}, suite.txBuilder.GetTx())
+	require.NoError(t, err)
+
+	memberSigs := make(map[int][]byte)
+	for _, i := range []int{0, 2} {
+		memberSigs[i], err = privs[i].Sign(signBytes)
+		require.NoError(t, err)
+	}
+	sig, err := multiPubKey.AggregateSignatures(memberSigs)
+	require.NoError(t, err)
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multiPubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		Sequence: 0,
+	}))
+	tx := suite.txBuilder.GetTx()
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = ctx.WithTxBytes(txBytes)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, 3, ante.CountSubKeys(multiPubKey))
+
+	// a signature below the threshold is rejected
+	sig, err = multiPubKey.AggregateSignatures(map[int][]byte{0: memberSigs[0], 1: memberSigs[2]})
+	require.NoError(t, err)
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multiPubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		Sequence: 0,
+	}))
+	tx = suite.txBuilder.GetTx()
+	txBytes, err = suite.clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	ctx, _ = suite.ctx.CacheContext()
+	_, err = antehandler(ctx.WithTxBytes(txBytes), tx, false)
+	require.Error(t, err)
+}
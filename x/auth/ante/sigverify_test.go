@@ -15,10 +15,12 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -90,6 +92,28 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 			}},
 			false,
 		},
+		{
+			"PubKeyWebAuthn",
+			args{nil, webauthn.NewPubKey(skR1.PubKey().(*secp256r1.PubKey), "example.com"), params, func(mm *gastestutil.MockMeter) {
+				mm.EXPECT().Consume(p.SigVerifyCostSecp256r1(), "ante verify: webauthn").Times(1)
+			}},
+			false,
+		},
+		{
+			"PubKeyBls12381",
+			args{nil, &bls12_381.PubKey{Key: make([]byte, 96)}, params, func(mm *gastestutil.MockMeter) {
+				mm.EXPECT().Consume(p.SigVerifyCostBls12381(), "ante verify: bls12_381").Times(1)
+			}},
+			false,
+		},
+		{
+			"PubKeyBls12381Multi",
+			args{nil, &bls12_381.MultiPubKey{Threshold: 2, PubKeys: [][]byte{make([]byte, 96), make([]byte, 96), make([]byte, 96)}}, params, func(mm *gastestutil.MockMeter) {
+				// a single verification whatever the number of signers
+				mm.EXPECT().Consume(p.SigVerifyCostBls12381(), "ante verify: bls12_381 multi").Times(1)
+			}},
+			false,
+		},
 		{
 			"Multisig",
 			args{multisignature1, multisigKey1, params, func(mm *gastestutil.MockMeter) {
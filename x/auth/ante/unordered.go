@@ -46,20 +46,35 @@ var _ sdk.AnteDecorator = (*UnorderedTxDecorator)(nil)
 type UnorderedTxDecorator struct {
 	// maxTimeoutDuration defines the maximum TTL a transaction can define.
 	maxTimeoutDuration time.Duration
-	txManager          *unorderedtx.Manager
+	txStore            UnorderedTxStore
 	env                appmodulev2.Environment
 	sha256Cost         uint64
 }
 
+// NewUnorderedTxDecorator returns an UnorderedTxDecorator tracking the unordered
+// transactions in memory with the given manager.
 func NewUnorderedTxDecorator(
 	maxDuration time.Duration,
 	m *unorderedtx.Manager,
 	env appmodulev2.Environment,
 	gasCost uint64,
+) *UnorderedTxDecorator {
+	return NewUnorderedTxDecoratorWithStore(maxDuration, managerTxStore{m}, env, gasCost)
+}
+
+// NewUnorderedTxDecoratorWithStore returns an UnorderedTxDecorator tracking the
+// unordered transactions in the given store. With a store backed by the state,
+// such as the x/auth keeper, the tracked transactions survive restarts and are
+// restored by state sync.
+func NewUnorderedTxDecoratorWithStore(
+	maxDuration time.Duration,
+	store UnorderedTxStore,
+	env appmodulev2.Environment,
+	gasCost uint64,
 ) *UnorderedTxDecorator {
 	return &UnorderedTxDecorator{
 		maxTimeoutDuration: maxDuration,
-		txManager:          m,
+		txStore:            store,
 		env:                env,
 		sha256Cost:         gasCost,
 	}
@@ -130,20 +145,40 @@ func (d *UnorderedTxDecorator) ValidateTx(ctx context.Context, tx transaction.Tx
 	}
 
 	// check for duplicates
-	if d.txManager.Contains(txHash) {
-		return errorsmod.Wrap(
+	duplicate, err := d.txStore.ContainsUnorderedTx(ctx, txHash, timeoutTimestamp)
+	if err != nil {
+		return err
+	}
+	if duplicate {
+		return errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest,
 			"tx %X is duplicated",
+			txHash,
 		)
 	}
 	if d.env.TransactionService.ExecMode(ctx) == transaction.ExecModeFinalize {
-		// a new tx included in the block, add the hash to the unordered tx manager
-		d.txManager.Add(txHash, timeoutTimestamp)
+		// a new tx included in the block, add the hash to the unordered tx store
+		return d.txStore.AddUnorderedTx(ctx, txHash, timeoutTimestamp)
 	}
 
 	return nil
 }
 
+// managerTxStore is an UnorderedTxStore tracking the unordered transactions in
+// memory with an unordered tx manager.
+type managerTxStore struct {
+	m *unorderedtx.Manager
+}
+
+func (s managerTxStore) ContainsUnorderedTx(_ context.Context, txHash [32]byte, _ time.Time) (bool, error) {
+	return s.m.Contains(txHash), nil
+}
+
+func (s managerTxStore) AddUnorderedTx(_ context.Context, txHash [32]byte, timeout time.Time) error {
+	s.m.Add(txHash, timeout)
+	return nil
+}
+
 // TxIdentifier returns a unique identifier for a transaction that is intended to be unordered.
 func TxIdentifier(timeout uint64, tx sdk.Tx) ([32]byte, error) {
 	feetx := tx.(sdk.FeeTx)
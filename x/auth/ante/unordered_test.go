@@ -141,6 +141,35 @@ func TestUnorderedTxDecorator_UnorderedTx_ValidDeliverTx(t *testing.T) {
 	require.True(t, txm.Contains(bz))
 }
 
+func TestUnorderedTxDecorator_UnorderedTx_KeeperStore(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecoratorWithStore(unorderedtx.DefaultMaxTimeoutDuration, suite.accountKeeper.UnorderedTxStore(), suite.accountKeeper.GetEnvironment(), ante.DefaultSha256Cost))
+
+	now := time.Now()
+	timeout := now.Add(time.Minute)
+	tx, txBz := genUnorderedTx(t, true, timeout)
+	ctx := suite.ctx.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: now}).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// a checked tx is not recorded
+	_, err := chain(ctx.WithExecMode(sdk.ExecModeCheck), tx, false)
+	require.NoError(t, err)
+
+	_, err = chain(ctx.WithExecMode(sdk.ExecModeFinalize), tx, false)
+	require.NoError(t, err)
+
+	bz := [32]byte{}
+	copy(bz[:], txBz[:32])
+	found, err := suite.accountKeeper.UnorderedTxStore().ContainsUnorderedTx(ctx, bz, timeout)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, err = chain(ctx.WithExecMode(sdk.ExecModeCheck), tx, false)
+	require.ErrorContains(t, err, "is duplicated")
+	_, err = chain(ctx.WithExecMode(sdk.ExecModeFinalize), tx, false)
+	require.ErrorContains(t, err, "is duplicated")
+}
+
 func genUnorderedTx(t *testing.T, unordered bool, timestamp time.Time) (sdk.Tx, []byte) {
 	t.Helper()
 
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// GetAggregateSignaturesCommand returns the aggregate-signatures command.
+func GetAggregateSignaturesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-signatures <file> [<signature>...]",
+		Short: "Aggregate the BLS12-381 signatures of a transaction generated offline",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Aggregate the signatures of the BLS12-381 signers of a transaction into a single signature.
+
+Read the transaction from [file] and the signatures from the optional [signature] files, as
+output by the sign command with the --signature-only flag. A signature from a file replaces the
+signature of the same signer in the transaction, or is appended to the transaction signatures.
+
+The aggregated signature is set as the signature of the first BLS12-381 signer, the signatures of
+the other BLS12-381 signers are removed. The signatures of the other signers are left untouched.
+
+Example:
+$ %s tx aggregate-signatures transaction.json k1sig.json k2sig.json k3sig.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: makeAggregateSignaturesCmd(),
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+func makeAggregateSignaturesCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		txCfg := clientCtx.TxConfig
+		txBuilder, err := txCfg.WrapTxBuilder(parsedTx)
+		if err != nil {
+			return err
+		}
+
+		sigs, err := txBuilder.GetTx().GetSignaturesV2()
+		if err != nil {
+			return err
+		}
+
+		for _, file := range args[1:] {
+			fileSigs, err := unmarshalSignatureJSON(clientCtx, file)
+			if err != nil {
+				return err
+			}
+
+			sigs = mergeSignatures(sigs, fileSigs)
+		}
+
+		sigs, err = aggregateBLSSignatures(sigs)
+		if err != nil {
+			return err
+		}
+
+		if err := txBuilder.SetSignatures(sigs...); err != nil {
+			return err
+		}
+
+		json, err := marshalSignatureJSON(txCfg, txBuilder.GetTx(), false)
+		if err != nil {
+			return err
+		}
+
+		closeFunc, err := setOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+
+		defer closeFunc()
+
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+}
+
+// mergeSignatures replaces the signatures of sigs by the ones of newSigs with
+// the same public key, the other signatures of newSigs are appended.
+func mergeSignatures(sigs, newSigs []signingtypes.SignatureV2) []signingtypes.SignatureV2 {
+	for _, newSig := range newSigs {
+		found := false
+		for i, sig := range sigs {
+			if sig.PubKey != nil && sig.PubKey.Equals(newSig.PubKey) {
+				sigs[i] = newSig
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			sigs = append(sigs, newSig)
+		}
+	}
+
+	return sigs
+}
+
+// aggregateBLSSignatures aggregates the signatures of the BLS12-381 single
+// signers into the signature of the first one.
+func aggregateBLSSignatures(sigs []signingtypes.SignatureV2) ([]signingtypes.SignatureV2, error) {
+	first := -1
+	var blsSigs [][]byte
+	for i, sig := range sigs {
+		data, ok := sig.Data.(*signingtypes.SingleSignatureData)
+		if _, isBLS := sig.PubKey.(*bls12_381.PubKey); !isBLS || !ok {
+			continue
+		}
+
+		if first < 0 {
+			first = i
+		}
+		if len(data.Signature) > 0 {
+			blsSigs = append(blsSigs, data.Signature)
+		}
+		sigs[i].Data = &signingtypes.SingleSignatureData{SignMode: data.SignMode}
+	}
+
+	if first < 0 {
+		return nil, errors.New("no BLS12-381 signer in the transaction")
+	}
+
+	aggregate, err := bls12_381.AggregateSignatures(blsSigs)
+	if err != nil {
+		return nil, err
+	}
+	sigs[first].Data.(*signingtypes.SingleSignatureData).Signature = aggregate
+
+	return sigs, nil
+}
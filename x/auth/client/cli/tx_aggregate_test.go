@@ -0,0 +1,91 @@
+//go:build bls12381
+
+package cli_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+)
+
+func TestGetAggregateSignaturesCommand(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	txConfig := encodingConfig.TxConfig
+	clientCtx := client.Context{}.
+		WithTxConfig(txConfig).
+		WithCodec(encodingConfig.Codec)
+
+	secpPrivKey := secp256k1.GenPrivKey()
+	var blsPrivKeys []bls12_381.PrivKey
+	var blsSigs [][]byte
+	for i := 0; i < 3; i++ {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		sig, err := privKey.Sign([]byte{byte(i)})
+		require.NoError(t, err)
+		blsPrivKeys = append(blsPrivKeys, privKey)
+		blsSigs = append(blsSigs, sig)
+	}
+	signature := func(privKey interface{ PubKey() cryptotypes.PubKey }, sig []byte) signing.SignatureV2 {
+		return signing.SignatureV2{
+			PubKey: privKey.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		}
+	}
+
+	// the transaction carries the signatures of two signers, the last one
+	// is provided in a separate file
+	builder := txConfig.NewTxBuilder()
+	builder.SetGasLimit(50000)
+	builder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	require.NoError(t, builder.SetSignatures(
+		signature(secpPrivKey, []byte("secp256k1 signature")),
+		signature(blsPrivKeys[0], blsSigs[0]),
+		signature(blsPrivKeys[1], blsSigs[1]),
+	))
+	txJSON, err := txConfig.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txFile := testutil.WriteToNewTempFile(t, string(txJSON))
+
+	sigJSON, err := txConfig.MarshalSignatureJSON([]signing.SignatureV2{signature(blsPrivKeys[2], blsSigs[2])})
+	require.NoError(t, err)
+	sigFile := testutil.WriteToNewTempFile(t, string(sigJSON))
+
+	outputFile := filepath.Join(t.TempDir(), "tx.json")
+	cmd := cli.GetAggregateSignaturesCommand()
+	_ = testutil.ApplyMockIODiscardOutErr(cmd)
+	cmd.SetArgs([]string{txFile.Name(), sigFile.Name(), "--" + flags.FlagOutputDocument + "=" + outputFile})
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+	parsedTx, err := authclient.ReadTxFromFile(clientCtx, outputFile)
+	require.NoError(t, err)
+	txBuilder, err := txConfig.WrapTxBuilder(parsedTx)
+	require.NoError(t, err)
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 4)
+
+	aggregate, err := bls12_381.AggregateSignatures(blsSigs)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secp256k1 signature"), sigs[0].Data.(*signing.SingleSignatureData).Signature)
+	require.Equal(t, aggregate, sigs[1].Data.(*signing.SingleSignatureData).Signature)
+	require.Empty(t, sigs[2].Data.(*signing.SingleSignatureData).Signature)
+	require.Empty(t, sigs[3].Data.(*signing.SingleSignatureData).Signature)
+	require.True(t, sigs[3].PubKey.Equals(blsPrivKeys[2].PubKey()))
+}
@@ -1,9 +1,11 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -17,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,10 +55,14 @@ If the --skip-signature-verification flag is on, the command will not verify the
 signatures in the provided signature files. This is useful when the multisig
 account is a signer in a nested multisig scenario.
 
+If [name] is a BLS12-381 multisig key, the signatures are aggregated into a single
+signature. Such a key is added to the keyring with its public key, e.g.:
+$ %s keys add k1k2k3 --pubkey='{"@type":"/cosmos.crypto.bls12_381.MultiPubKey","threshold":2,"public_keys":[...]}'
+
 The current multisig implementation defaults to amino-json sign mode.
 The SIGN_MODE_DIRECT sign mode is not supported.'
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		RunE: makeMultiSignCmd(),
@@ -119,8 +126,19 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 		// the multisig key (useful for nested multisigs).
 		skipSigVerify, _ := cmd.Flags().GetBool(flagSkipSignatureVerification)
 
-		multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigPub, isLegacyMultisig := pubKey.(*kmultisig.LegacyAminoPubKey)
+		blsMultisigPub, isBLSMultisig := pubKey.(*bls12_381.MultiPubKey)
+		if !isLegacyMultisig && !isBLSMultisig {
+			return fmt.Errorf("%s is not a multisig key", name)
+		}
+
+		var (
+			multisigSig *signingtypes.MultiSignatureData
+			blsSigs     []signingtypes.SignatureV2
+		)
+		if isLegacyMultisig {
+			multisigSig = multisig.NewMultisig(len(multisigPub.PubKeys))
+		}
 		if !clientCtx.Offline {
 			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
 			if err != nil {
@@ -172,6 +190,11 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 					}
 				}
 
+				if isBLSMultisig {
+					blsSigs = append(blsSigs, sig)
+					continue
+				}
+
 				if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
 					return err
 				}
@@ -183,6 +206,13 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			Data:     multisigSig,
 			Sequence: txFactory.Sequence(),
 		}
+		if isBLSMultisig {
+			sigV2.PubKey = blsMultisigPub
+			sigV2.Data, err = blsMultiSignature(blsMultisigPub, blsSigs)
+			if err != nil {
+				return err
+			}
+		}
 
 		err = txBuilder.SetSignatures(sigV2)
 		if err != nil {
@@ -390,6 +420,38 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// blsMultiSignature aggregates the signatures of the members of a BLS12-381
+// multisig key, which must all use the same sign mode.
+func blsMultiSignature(pubKey *bls12_381.MultiPubKey, sigs []signingtypes.SignatureV2) (signingtypes.SignatureData, error) {
+	memberSigs := make(map[int][]byte, len(sigs))
+	signMode := signingtypes.SignMode_SIGN_MODE_UNSPECIFIED
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signingtypes.SingleSignatureData)
+		if !ok {
+			return nil, fmt.Errorf("expected %T, got %T", &signingtypes.SingleSignatureData{}, sig.Data)
+		}
+		if len(memberSigs) > 0 && data.SignMode != signMode {
+			return nil, errors.New("the signatures of a BLS12-381 multisig must use the same sign mode")
+		}
+		signMode = data.SignMode
+
+		index := slices.IndexFunc(pubKey.PubKeys, func(key []byte) bool {
+			return bytes.Equal(key, sig.PubKey.Bytes())
+		})
+		if _, isBLS := sig.PubKey.(*bls12_381.PubKey); !isBLS || index < 0 {
+			return nil, fmt.Errorf("%s is not a member of the multisig key", sig.PubKey)
+		}
+		memberSigs[index] = data.Signature
+	}
+
+	sig, err := pubKey.AggregateSignatures(memberSigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signingtypes.SingleSignatureData{SignMode: signMode, Signature: sig}, nil
+}
+
 func unmarshalSignatureJSON(clientCtx client.Context, filename string) (sigs []signingtypes.SignatureV2, err error) {
 	var bytes []byte
 	if bytes, err = os.ReadFile(filename); err != nil {
@@ -23,6 +23,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Provide(ProvideUnorderedTxStore),
 	)
 }
 
@@ -78,3 +79,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{AccountKeeper: k, Module: m}
 }
+
+// ProvideUnorderedTxStore provides the store of the unordered transactions
+// used by x/validate on chains without an unordered tx manager.
+func ProvideUnorderedTxStore(k keeper.AccountKeeper) ante.UnorderedTxStore {
+	return k.UnorderedTxStore()
+}
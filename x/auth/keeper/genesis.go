@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -37,6 +39,12 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, tx := range data.UnorderedTxs {
+		if err := ak.UnorderedTxs.Set(ctx, tx.TxHash, tx.Timeout, collections.NoValue{}); err != nil {
+			return err
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 	return nil
 }
@@ -54,5 +62,24 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	var unorderedTxs []types.UnorderedTx
+	err = ak.UnorderedTxs.Walk(ctx, nil, func(txHash []byte, _ collections.NoValue) (stop bool, err error) {
+		timeout, err := ak.UnorderedTxs.Expiry(ctx, txHash)
+		if err != nil {
+			return true, err
+		}
+		unorderedTxs = append(unorderedTxs, types.UnorderedTx{TxHash: txHash, Timeout: timeout})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.UnorderedTxs = unorderedTxs
+	return genState, nil
 }
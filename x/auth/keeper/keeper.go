@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -108,6 +109,8 @@ type AccountKeeper struct {
 	accountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// UnorderedTxs key: tx hash | expiry: timeout
+	UnorderedTxs collections.ExpiringMap[time.Time, []byte, collections.NoValue]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -141,6 +144,7 @@ func NewAccountKeeper(
 		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		accountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		UnorderedTxs:      collections.NewExpiringMap(sb, types.UnorderedTxsKeyPrefix, "unordered_txs", sdk.TimeKey, collections.BytesKey, collections.NoValue{}),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+)
+
+// UnorderedTxStore tracks the unordered transactions included in blocks until
+// their timeout, to reject their duplicates.
+type UnorderedTxStore struct {
+	txs collections.ExpiringMap[time.Time, []byte, collections.NoValue]
+}
+
+// UnorderedTxStore returns the store of the unordered transactions included in
+// blocks, used by chains without an unordered tx manager.
+func (ak AccountKeeper) UnorderedTxStore() UnorderedTxStore {
+	return UnorderedTxStore{txs: ak.UnorderedTxs}
+}
+
+// ContainsUnorderedTx returns true if the unordered transaction with the given
+// hash and timeout was included in a block and did not expire yet.
+func (s UnorderedTxStore) ContainsUnorderedTx(ctx context.Context, txHash [32]byte, timeout time.Time) (bool, error) {
+	expiry, err := s.txs.Expiry(ctx, txHash[:])
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return expiry.Equal(timeout), nil
+}
+
+// AddUnorderedTx records an unordered transaction included in a block, until
+// its timeout.
+func (s UnorderedTxStore) AddUnorderedTx(ctx context.Context, txHash [32]byte, timeout time.Time) error {
+	return s.txs.Set(ctx, txHash[:], timeout, collections.NoValue{})
+}
+
+// RemoveExpiredUnorderedTxs removes the unordered transactions whose timeout is
+// before the block time, as they cannot be included in a block anymore.
+// They are all removed at once, as their number is bounded by the unordered
+// transactions included in the blocks until their timeout.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx context.Context) error {
+	blockTime := ak.HeaderService.HeaderInfo(ctx).Time
+
+	_, err := ak.UnorderedTxs.PopExpired(ctx, blockTime.Add(-time.Nanosecond), 0)
+	return err
+}
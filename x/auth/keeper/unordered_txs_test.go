@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+)
+
+func (suite *KeeperTestSuite) TestUnorderedTxs() {
+	now := time.Now()
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: now})
+
+	txHash1 := [32]byte{1}
+	txHash2 := [32]byte{2}
+	timeout1 := now.Add(time.Minute)
+	timeout2 := now.Add(2 * time.Minute)
+
+	suite.Require().NoError(suite.accountKeeper.UnorderedTxStore().AddUnorderedTx(ctx, txHash1, timeout1))
+	suite.Require().NoError(suite.accountKeeper.UnorderedTxStore().AddUnorderedTx(ctx, txHash2, timeout2))
+
+	found, err := suite.accountKeeper.UnorderedTxStore().ContainsUnorderedTx(ctx, txHash1, timeout1)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	// the timeout is part of the key
+	found, err = suite.accountKeeper.UnorderedTxStore().ContainsUnorderedTx(ctx, txHash1, timeout2)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// a transaction expiring at the block time is kept
+	ctx = ctx.WithHeaderInfo(header.Info{Time: timeout1})
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+	found, err = suite.accountKeeper.UnorderedTxStore().ContainsUnorderedTx(ctx, txHash1, timeout1)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: timeout1.Add(time.Nanosecond)})
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+	found, err = suite.accountKeeper.UnorderedTxStore().ContainsUnorderedTx(ctx, txHash1, timeout1)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+	found, err = suite.accountKeeper.UnorderedTxStore().ContainsUnorderedTx(ctx, txHash2, timeout2)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestUnorderedTxsGenesis() {
+	now := time.Now().UTC()
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: now})
+
+	txHash := [32]byte{1}
+	timeout := now.Add(time.Minute)
+	suite.Require().NoError(suite.accountKeeper.UnorderedTxStore().AddUnorderedTx(ctx, txHash, timeout))
+
+	genState, err := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(genState.UnorderedTxs, 1)
+	suite.Require().Equal(txHash[:], genState.UnorderedTxs[0].TxHash)
+	suite.Require().True(timeout.Equal(genState.UnorderedTxs[0].Timeout))
+
+	suite.Require().NoError(suite.accountKeeper.UnorderedTxs.Remove(ctx, txHash[:]))
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(ctx, *genState))
+	found, err := suite.accountKeeper.UnorderedTxStore().ContainsUnorderedTx(ctx, txHash, timeout)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+}
@@ -39,6 +39,7 @@ var (
 	_ appmodulev2.HasGenesis    = AppModule{}
 	_ appmodulev2.AppModule     = AppModule{}
 	_ appmodulev2.HasMigrations = AppModule{}
+	_ appmodulev2.HasPreBlocker = AppModule{}
 )
 
 // AppModule implements an application module for the auth module.
@@ -155,6 +156,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// PreBlock removes the expired unordered transactions from the store.
+func (am AppModule) PreBlock(ctx context.Context) error {
+	return am.accountKeeper.RemoveExpiredUnorderedTxs(ctx)
+}
+
 // TxValidator implements appmodulev2.HasTxValidator.
 // It replaces auth ante handlers for server/v2
 func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
@@ -3,11 +3,14 @@ package signing
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	txsigning "cosmossdk.io/x/tx/signing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -37,6 +40,8 @@ func APISignModeToInternal(mode signingv1beta1.SignMode) (signing.SignMode, erro
 		return signing.SignMode_SIGN_MODE_TEXTUAL, nil
 	case signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX:
 		return signing.SignMode_SIGN_MODE_DIRECT_AUX, nil
+	case signingv1beta1.SignMode_SIGN_MODE_EIP_191:
+		return signing.SignMode_SIGN_MODE_EIP_191, nil
 	default:
 		return signing.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %s", mode)
 	}
@@ -53,6 +58,8 @@ func internalSignModeToAPI(mode signing.SignMode) (signingv1beta1.SignMode, erro
 		return signingv1beta1.SignMode_SIGN_MODE_TEXTUAL, nil
 	case signing.SignMode_SIGN_MODE_DIRECT_AUX:
 		return signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX, nil
+	case signing.SignMode_SIGN_MODE_EIP_191:
+		return signingv1beta1.SignMode_SIGN_MODE_EIP_191, nil
 	default:
 		return signingv1beta1.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %s", mode)
 	}
@@ -78,6 +85,9 @@ func VerifySignature(
 		if err != nil {
 			return err
 		}
+		if data.SignMode == signing.SignMode_SIGN_MODE_EIP_191 {
+			return verifyEthereumSignature(pubKey, signBytes, data.Signature)
+		}
 		if !pubKey.VerifySignature(signBytes, data.Signature) {
 			return fmt.Errorf("unable to verify single signer signature '%s' for signBytes '%s'", hex.EncodeToString(data.Signature), hex.EncodeToString(signBytes))
 		}
@@ -103,3 +113,81 @@ func VerifySignature(
 		return fmt.Errorf("unexpected SignatureData %T", signatureData)
 	}
 }
+
+// verifyEthereumSignature verifies a SIGN_MODE_EIP_191 signature, which is a
+// recoverable secp256k1 signature of the Keccak256 hash of the sign bytes, as
+// created by Ethereum wallets.
+func verifyEthereumSignature(pubKey cryptotypes.PubKey, signBytes, sig []byte) error {
+	secp256k1PubKey, ok := pubKey.(*secp256k1.PubKey)
+	if !ok {
+		return fmt.Errorf("%s requires a secp256k1 public key, got %T", signing.SignMode_SIGN_MODE_EIP_191, pubKey)
+	}
+	if !secp256k1PubKey.VerifyEthereumSignature(signBytes, sig) {
+		return fmt.Errorf("unable to verify single signer Ethereum signature '%s' for signBytes '%s'", hex.EncodeToString(sig), hex.EncodeToString(signBytes))
+	}
+	return nil
+}
+
+// AggregateSignatureVerifier verifies the signatures of the BLS12-381 single
+// signers of a transaction at once, against the aggregate of their signatures.
+//
+// Each signer signs its own sign bytes, so that a transaction may carry a
+// single aggregated signature for all its BLS12-381 signers: the aggregate is
+// set as the signature of one of them and the others leave their signature
+// empty. Signatures may also be partially aggregated or left individual, as
+// the verifier sums all the signatures it is given.
+type AggregateSignatureVerifier struct {
+	pubKeys    []*bls12_381.PubKey
+	signBytes  [][]byte
+	signatures [][]byte
+}
+
+// Add adds the signature of a BLS12-381 signer to the aggregate, it is only
+// verified by Verify.
+func (v *AggregateSignatureVerifier) Add(
+	ctx context.Context,
+	pubKey *bls12_381.PubKey,
+	signerData txsigning.SignerData,
+	data *signing.SingleSignatureData,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) error {
+	if data.SignMode == signing.SignMode_SIGN_MODE_EIP_191 {
+		return fmt.Errorf("%s requires a secp256k1 public key, got %T", signing.SignMode_SIGN_MODE_EIP_191, pubKey)
+	}
+	signMode, err := internalSignModeToAPI(data.SignMode)
+	if err != nil {
+		return err
+	}
+	signBytes, err := handler.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return err
+	}
+
+	v.pubKeys = append(v.pubKeys, pubKey)
+	v.signBytes = append(v.signBytes, signBytes)
+	if len(data.Signature) > 0 {
+		v.signatures = append(v.signatures, data.Signature)
+	}
+	return nil
+}
+
+// Verify verifies the aggregate of the signatures added to the verifier. It
+// succeeds if no signature was added.
+func (v *AggregateSignatureVerifier) Verify() error {
+	if len(v.pubKeys) == 0 {
+		return nil
+	}
+	if len(v.signatures) == 0 {
+		return errors.New("missing aggregated BLS12-381 signature")
+	}
+
+	sig, err := bls12_381.AggregateSignatures(v.signatures)
+	if err != nil {
+		return err
+	}
+	if !bls12_381.VerifyAggregateSignature(v.pubKeys, v.signBytes, sig) {
+		return fmt.Errorf("unable to verify aggregated BLS12-381 signature '%s' of %d signers", hex.EncodeToString(sig), len(v.pubKeys))
+	}
+	return nil
+}
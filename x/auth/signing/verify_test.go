@@ -0,0 +1,90 @@
+package signing_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	coretransaction "cosmossdk.io/core/transaction"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/eip191"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestVerifyEIP191Signature(t *testing.T) {
+	interfaceRegistry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	interfaceRegistry.RegisterImplementations((*coretransaction.Msg)(nil), &testdata.TestMsg{})
+	protoCodec := codec.NewProtoCodec(interfaceRegistry)
+	signingCtx := interfaceRegistry.SigningContext()
+	txConfig := tx.NewTxConfig(protoCodec, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), []signing.SignMode{signing.SignMode_SIGN_MODE_EIP_191})
+
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	addr, err := signingCtx.AddressCodec().BytesToString(pubKey.Address())
+	require.NoError(t, err)
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress(pubKey.Address()))))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_191},
+		Sequence: 3,
+	}))
+
+	signBytes, err := authsign.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_EIP_191, authsign.SignerData{
+		Address:       addr,
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      3,
+		PubKey:        pubKey,
+	}, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(signBytes, []byte(eip191.PersonalMessagePrefix)))
+
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	signerData := txsigning.SignerData{
+		Address:       addr,
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      3,
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}
+	txData := txBuilder.GetTx().(authsign.V2AdaptableTx).GetSigningTxData()
+
+	// an Ethereum signature of the sign bytes is valid
+	sig, err := privKey.SignEthereum(signBytes)
+	require.NoError(t, err)
+	err = authsign.VerifySignature(context.Background(), pubKey, signerData, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_191, Signature: sig}, txConfig.SignModeHandler(), txData)
+	require.NoError(t, err)
+
+	// a secp256k1 signature is not
+	sig, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	err = authsign.VerifySignature(context.Background(), pubKey, signerData, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_191, Signature: sig}, txConfig.SignModeHandler(), txData)
+	require.ErrorContains(t, err, "unable to verify single signer Ethereum signature")
+
+	// other keys are not supported
+	edPrivKey := ed25519.GenPrivKey()
+	sig, err = edPrivKey.Sign(signBytes)
+	require.NoError(t, err)
+	err = authsign.VerifySignature(context.Background(), edPrivKey.PubKey(), signerData, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_191, Signature: sig}, txConfig.SignModeHandler(), txData)
+	require.ErrorContains(t, err, "requires a secp256k1 public key")
+}
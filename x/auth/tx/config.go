@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
 	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/eip191"
 	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -65,13 +66,14 @@ var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 	// signingtypes.SignMode_SIGN_MODE_TEXTUAL is not enabled by default, as it requires a x/bank keeper or gRPC connection.
+	// signingtypes.SignMode_SIGN_MODE_EIP_191 is not enabled by default, it allows to sign with Ethereum wallets.
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 //
 // NOTE: Use NewTxConfigWithOptions to provide a custom signing handler in case the sign mode
-// is not supported by default (eg: SignMode_SIGN_MODE_EIP_191), or to enable SIGN_MODE_TEXTUAL.
+// is not supported by default, or to enable SIGN_MODE_TEXTUAL.
 //
 // We prefer to use depinject to provide client.TxConfig, but we permit this constructor usage. Within the SDK,
 // this constructor is primarily used in tests, but also sees usage in app chains like:
@@ -142,6 +144,13 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 				FileResolver: signingOpts.FileResolver,
 				TypeResolver: signingOpts.TypeResolver,
 			})
+		case signingtypes.SignMode_SIGN_MODE_EIP_191:
+			handlers[i] = eip191.NewSignModeHandler(eip191.SignModeHandlerOptions{
+				AminoJSON: aminojson.SignModeHandlerOptions{
+					FileResolver: signingOpts.FileResolver,
+					TypeResolver: signingOpts.TypeResolver,
+				},
+			})
 		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
 			handlers[i], err = textual.NewSignModeHandler(textual.SignModeOptions{
 				CoinMetadataQuerier: configOpts.TextualCoinMetadataQueryFn,
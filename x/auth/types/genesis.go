@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -73,7 +75,11 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return ValidateUnorderedTxs(data.UnorderedTxs)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -144,6 +150,24 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 	return nil
 }
 
+// ValidateUnorderedTxs validates an array of UnorderedTxs and checks for duplicates
+func ValidateUnorderedTxs(txs []UnorderedTx) error {
+	hashes := make(map[string]bool, len(txs))
+
+	for _, tx := range txs {
+		if len(tx.TxHash) != sha256.Size {
+			return fmt.Errorf("invalid unordered transaction hash length in genesis state; expected %d, got %d", sha256.Size, len(tx.TxHash))
+		}
+
+		hash := hex.EncodeToString(tx.TxHash)
+		if hashes[hash] {
+			return fmt.Errorf("duplicate unordered transaction found in genesis state; hash: %s", hash)
+		}
+		hashes[hash] = true
+	}
+	return nil
+}
+
 // GenesisAccountIterator implements genesis account iteration.
 type GenesisAccountIterator struct{}
 
@@ -8,16 +8,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +35,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*any.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// unordered_txs are the unordered transactions included in blocks which did
+	// not expire yet.
+	UnorderedTxs []UnorderedTx `protobuf:"bytes,3,rep,name=unordered_txs,json=unorderedTxs,proto3" json:"unordered_txs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,31 +87,102 @@ func (m *GenesisState) GetAccounts() []*any.Any {
 	return nil
 }
 
+func (m *GenesisState) GetUnorderedTxs() []UnorderedTx {
+	if m != nil {
+		return m.UnorderedTxs
+	}
+	return nil
+}
+
+// UnorderedTx defines an unordered transaction included in a block, tracked
+// until its timeout to reject its duplicates.
+type UnorderedTx struct {
+	// tx_hash is the hash identifying the transaction.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// timeout is the timeout timestamp of the transaction.
+	Timeout time.Time `protobuf:"bytes,2,opt,name=timeout,proto3,stdtime" json:"timeout"`
+}
+
+func (m *UnorderedTx) Reset()         { *m = UnorderedTx{} }
+func (m *UnorderedTx) String() string { return proto.CompactTextString(m) }
+func (*UnorderedTx) ProtoMessage()    {}
+func (*UnorderedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d897ccbce9822332, []int{1}
+}
+func (m *UnorderedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedTx.Merge(m, src)
+}
+func (m *UnorderedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedTx proto.InternalMessageInfo
+
+func (m *UnorderedTx) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *UnorderedTx) GetTimeout() time.Time {
+	if m != nil {
+		return m.Timeout
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
+	proto.RegisterType((*UnorderedTx)(nil), "cosmos.auth.v1beta1.UnorderedTx")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4f, 0xe2, 0x40,
+	0x14, 0xc7, 0x3b, 0xb0, 0x01, 0x76, 0x60, 0x0f, 0xdb, 0x25, 0xd9, 0x2e, 0x9b, 0x14, 0x96, 0x13,
+	0xbb, 0xc9, 0xce, 0x08, 0xde, 0x49, 0xac, 0x07, 0x4d, 0xbc, 0x98, 0x8a, 0x17, 0x2f, 0x64, 0x5a,
+	0x86, 0xb6, 0xd1, 0x76, 0x9a, 0xce, 0xd4, 0x94, 0x6f, 0xc1, 0xc7, 0xf0, 0xe8, 0xc7, 0xe0, 0x62,
+	0xc2, 0xd1, 0x93, 0x1a, 0x38, 0xf8, 0x35, 0x4c, 0x67, 0x5a, 0x35, 0xca, 0xa5, 0x9d, 0xbc, 0xf7,
+	0x7b, 0xf9, 0xff, 0xff, 0xef, 0xc1, 0x3f, 0x2e, 0xe3, 0x21, 0xe3, 0x98, 0xa4, 0xc2, 0xc7, 0xd7,
+	0x43, 0x87, 0x0a, 0x32, 0xc4, 0x1e, 0x8d, 0x28, 0x0f, 0x38, 0x8a, 0x13, 0x26, 0x98, 0xfe, 0x43,
+	0x21, 0x28, 0x47, 0x50, 0x81, 0x74, 0x7e, 0x79, 0x8c, 0x79, 0x57, 0x14, 0x4b, 0xc4, 0x49, 0xe7,
+	0x98, 0x44, 0x0b, 0xc5, 0x77, 0xba, 0x1f, 0x5b, 0x22, 0x08, 0x29, 0x17, 0x24, 0x8c, 0x0b, 0xa0,
+	0xed, 0x31, 0x8f, 0xc9, 0x27, 0xce, 0x5f, 0x45, 0xd5, 0xdc, 0xe5, 0x44, 0x6a, 0xaa, 0xfe, 0x77,
+	0x12, 0x06, 0x11, 0xc3, 0xf2, 0xab, 0x4a, 0xfd, 0x3b, 0x00, 0x5b, 0x47, 0xca, 0xeb, 0x99, 0x20,
+	0x82, 0xea, 0x63, 0x58, 0x8b, 0x49, 0x42, 0x42, 0x6e, 0x80, 0x1e, 0x18, 0x34, 0x47, 0xbf, 0xd1,
+	0x0e, 0xef, 0xe8, 0x54, 0x22, 0xd6, 0xd7, 0xd5, 0x43, 0x57, 0xbb, 0x79, 0xbe, 0xfd, 0x07, 0xec,
+	0x62, 0x4a, 0xdf, 0x83, 0x0d, 0xe2, 0xba, 0x2c, 0x8d, 0x04, 0x37, 0x2a, 0xbd, 0xea, 0xa0, 0x39,
+	0x6a, 0x23, 0x95, 0x06, 0x95, 0x69, 0xd0, 0x41, 0xb4, 0xb0, 0x5f, 0x29, 0xfd, 0x04, 0x7e, 0x4b,
+	0x23, 0x96, 0xcc, 0x68, 0x42, 0x67, 0x53, 0x91, 0x71, 0xa3, 0x2a, 0xc7, 0x7a, 0x3b, 0x85, 0xcf,
+	0x4b, 0x72, 0x92, 0x59, 0x5f, 0x72, 0x75, 0xbb, 0x95, 0xbe, 0x95, 0x78, 0x7f, 0x0e, 0x9b, 0xef,
+	0x10, 0xfd, 0x27, 0xac, 0x8b, 0x6c, 0xea, 0x13, 0xee, 0xcb, 0x38, 0x2d, 0xbb, 0x26, 0xb2, 0x63,
+	0xc2, 0x7d, 0x7d, 0x0c, 0xeb, 0xf9, 0x4e, 0x59, 0x2a, 0x8c, 0x8a, 0xcc, 0xd9, 0xf9, 0xe4, 0x72,
+	0x52, 0xee, 0xdc, 0x6a, 0xe4, 0x42, 0xcb, 0xc7, 0x2e, 0xb0, 0xcb, 0x21, 0xeb, 0x70, 0xb5, 0x31,
+	0xc1, 0x7a, 0x63, 0x82, 0xa7, 0x8d, 0x09, 0x96, 0x5b, 0x53, 0x5b, 0x6f, 0x4d, 0xed, 0x7e, 0x6b,
+	0x6a, 0x17, 0x7f, 0xbd, 0x40, 0xf8, 0xa9, 0x83, 0x5c, 0x16, 0xe2, 0xe2, 0x1e, 0xea, 0xf7, 0x9f,
+	0xcf, 0x2e, 0x71, 0xa6, 0x8e, 0x23, 0x16, 0x31, 0xe5, 0x4e, 0x4d, 0x6a, 0xed, 0xbf, 0x0c, 0x00,
+	0x81, 0xe8, 0x47, 0x89, 0x42, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +205,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnorderedTxs) > 0 {
+		for iNdEx := len(m.UnorderedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnorderedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +246,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnorderedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timeout):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -179,6 +309,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UnorderedTxs) > 0 {
+		for _, e := range m.UnorderedTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnorderedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timeout)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +435,157 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnorderedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnorderedTxs = append(m.UnorderedTxs, UnorderedTx{})
+			if err := m.UnorderedTxs[len(m.UnorderedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnorderedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnorderedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnorderedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
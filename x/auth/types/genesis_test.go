@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -53,6 +54,17 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateUnorderedTxs(t *testing.T) {
+	tx := types.UnorderedTx{TxHash: make([]byte, 32), Timeout: time.Now()}
+	require.NoError(t, types.ValidateUnorderedTxs([]types.UnorderedTx{tx}))
+
+	// duplicated transactions
+	require.Error(t, types.ValidateUnorderedTxs([]types.UnorderedTx{tx, tx}))
+
+	// invalid hash length
+	require.Error(t, types.ValidateUnorderedTxs([]types.UnorderedTx{{TxHash: []byte{1}, Timeout: time.Now()}}))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
 	cdc := encodingConfig.Codec
@@ -28,4 +28,7 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// UnorderedTxsKeyPrefix prefix for the unordered transactions store, by hash and timeout
+	UnorderedTxsKeyPrefix = collections.NewPrefix(3)
 )
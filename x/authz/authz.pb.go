@@ -6,11 +6,14 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -70,6 +73,149 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// CompositeAuthorization gives the grantee permissions to execute any of the
+// provided methods on behalf of the granter's account, within an execution count,
+// an aggregate spend limit and optional time-of-day and block height windows.
+// The limits are shared by all the methods and tracked across executions.
+type CompositeAuthorization struct {
+	// msg_type_urls are the Msgs, identified by their type URL, which can be executed.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// max_executions is the maximum number of messages that can be executed.
+	// If zero, the number of executions is not limited.
+	MaxExecutions uint64 `protobuf:"varint,2,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// executions is the number of messages executed so far.
+	Executions uint64 `protobuf:"varint,3,opt,name=executions,proto3" json:"executions,omitempty"`
+	// spend_limit is the maximum amount of coins that can be spent in aggregate.
+	// The amount spent by a message is the sum of all its coins, including the
+	// ones of nested messages. Messages embedding Any values are rejected.
+	// If empty, the spending is not limited.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spent is the amount of coins spent so far.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// time_windows are the times of day in which messages can be executed.
+	// If empty, messages can be executed at any time of day.
+	TimeWindows []TimeOfDayWindow `protobuf:"bytes,6,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows"`
+	// height_windows are the block heights at which messages can be executed.
+	// If empty, messages can be executed at any height.
+	HeightWindows []HeightWindow `protobuf:"bytes,7,rep,name=height_windows,json=heightWindows,proto3" json:"height_windows"`
+}
+
+func (m *CompositeAuthorization) Reset()         { *m = CompositeAuthorization{} }
+func (m *CompositeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CompositeAuthorization) ProtoMessage()    {}
+func (*CompositeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *CompositeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompositeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompositeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompositeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeAuthorization.Merge(m, src)
+}
+func (m *CompositeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompositeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompositeAuthorization proto.InternalMessageInfo
+
+// TimeOfDayWindow defines a UTC time of day window, as offsets from midnight.
+// A window whose end is before its start spans midnight.
+type TimeOfDayWindow struct {
+	// start is the offset from midnight at which the window opens (inclusive).
+	Start time.Duration `protobuf:"bytes,1,opt,name=start,proto3,stdduration" json:"start"`
+	// end is the offset from midnight at which the window closes (exclusive).
+	End time.Duration `protobuf:"bytes,2,opt,name=end,proto3,stdduration" json:"end"`
+}
+
+func (m *TimeOfDayWindow) Reset()         { *m = TimeOfDayWindow{} }
+func (m *TimeOfDayWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeOfDayWindow) ProtoMessage()    {}
+func (*TimeOfDayWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *TimeOfDayWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeOfDayWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeOfDayWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeOfDayWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeOfDayWindow.Merge(m, src)
+}
+func (m *TimeOfDayWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeOfDayWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeOfDayWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeOfDayWindow proto.InternalMessageInfo
+
+// HeightWindow defines an inclusive range of block heights.
+type HeightWindow struct {
+	// start_height is the first height of the window.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the window. If zero, the window has no end.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *HeightWindow) Reset()         { *m = HeightWindow{} }
+func (m *HeightWindow) String() string { return proto.CompactTextString(m) }
+func (*HeightWindow) ProtoMessage()    {}
+func (*HeightWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *HeightWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeightWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightWindow.Merge(m, src)
+}
+func (m *HeightWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightWindow proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +230,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +272,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +311,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +342,9 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*CompositeAuthorization)(nil), "cosmos.authz.v1beta1.CompositeAuthorization")
+	proto.RegisterType((*TimeOfDayWindow)(nil), "cosmos.authz.v1beta1.TimeOfDayWindow")
+	proto.RegisterType((*HeightWindow)(nil), "cosmos.authz.v1beta1.HeightWindow")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +353,57 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x75, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x72, 0x28, 0x3d, 0x24, 0x55, 0x0e, 0x68,
-	0x42, 0x6a, 0xac, 0x15, 0x4e, 0x9c, 0x68, 0x84, 0x34, 0xc1, 0x8d, 0x30, 0x2e, 0x5c, 0x2a, 0x67,
-	0x31, 0x9e, 0xb5, 0x3a, 0x8e, 0x6c, 0x07, 0x2d, 0xfb, 0x08, 0x9c, 0xf6, 0x19, 0xf8, 0x04, 0x20,
-	0xed, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0xf8, 0xd3, 0x1e, 0xf8, 0x1a, 0xa8, 0x76, 0x22, 0x1a,
-	0x5a, 0x89, 0x1e, 0xb8, 0x44, 0x7e, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e, 0xf9, 0x45, 0x81, 0xfd, 0x53,
-	0xa1, 0xb8, 0x50, 0x08, 0x97, 0xfa, 0xec, 0x12, 0xbd, 0x3b, 0x4a, 0x89, 0xc6, 0x47, 0xb6, 0x8a,
-	0x0a, 0x29, 0xb4, 0x70, 0x3d, 0xab, 0x88, 0xec, 0x5d, 0xad, 0xe8, 0xdd, 0xc3, 0x9c, 0xe5, 0x02,
-	0x99, 0xa7, 0x15, 0xf6, 0xee, 0x5b, 0xe1, 0xd8, 0x54, 0xa8, 0x76, 0xd9, 0x56, 0x40, 0x85, 0xa0,
-	0x13, 0x82, 0x4c, 0x95, 0x96, 0x6f, 0x91, 0x66, 0x9c, 0x28, 0x8d, 0x79, 0x51, 0x0b, 0x3c, 0x2a,
-	0xa8, 0xb0, 0xc6, 0xe5, 0xa9, 0x99, 0xf8, 0xb7, 0x0d, 0xe7, 0x95, 0x6d, 0x85, 0x1a, 0x7a, 0xc7,
-	0x24, 0x27, 0x92, 0x9d, 0x8e, 0x4a, 0x7d, 0x26, 0x24, 0xbb, 0xc4, 0x9a, 0x89, 0xdc, 0xbd, 0x0b,
-	0x3b, 0x5c, 0xd1, 0x2e, 0xe8, 0x83, 0xc3, 0xbd, 0x64, 0x79, 0x7c, 0xf2, 0xe2, 0xf3, 0xf5, 0x20,
-	0xdc, 0xf4, 0x0e, 0x51, 0xcb, 0xf9, 0xfe, 0xd7, 0xc7, 0x87, 0x81, 0x95, 0x0d, 0x54, 0x76, 0x8e,
-	0x36, 0x4d, 0x0f, 0x3f, 0x01, 0x78, 0xe3, 0x58, 0xe2, 0x5c, 0xbb, 0x29, 0x3c, 0xc0, 0xab, 0x2d,
-	0xb3, 0x71, 0x7f, 0xe8, 0x45, 0x36, 0x72, 0xd4, 0x44, 0x8e, 0x46, 0x79, 0x15, 0x3f, 0xd8, 0x2e,
-	0x42, 0xd2, 0x1e, 0xe9, 0x3e, 0x83, 0x90, 0x5c, 0x14, 0x4c, 0xda, 0x05, 0x3b, 0x66, 0x41, 0x6f,
-	0x6d, 0xc1, 0x49, 0x83, 0x32, 0xbe, 0x3d, 0xfd, 0x16, 0x80, 0xab, 0xef, 0x01, 0x48, 0x56, 0x7c,
-	0xe1, 0x87, 0x1d, 0xe8, 0x9a, 0xcc, 0x6d, 0x50, 0x43, 0x78, 0x8b, 0x2e, 0x6f, 0x89, 0xb4, 0xb0,
-	0xe2, 0xee, 0x97, 0xeb, 0x41, 0xf3, 0xad, 0x47, 0x59, 0x26, 0x89, 0x52, 0xaf, 0xb4, 0x64, 0x39,
-	0x4d, 0x1a, 0xe1, 0x1f, 0x0f, 0x31, 0x69, 0xb6, 0xf0, 0x90, 0x75, 0x50, 0x9d, 0xff, 0x0f, 0xea,
-	0x69, 0x0b, 0xd4, 0xee, 0x3f, 0x41, 0xed, 0xae, 0x41, 0x7a, 0x0c, 0xef, 0x18, 0x46, 0x2f, 0x4b,
-	0x52, 0x92, 0xe7, 0x9a, 0x70, 0x37, 0x84, 0x07, 0x5c, 0xd1, 0xb1, 0xae, 0x0a, 0x32, 0x2e, 0xe5,
-	0x44, 0x75, 0x41, 0xbf, 0x73, 0xb8, 0x97, 0xec, 0x73, 0x45, 0x4f, 0xaa, 0x82, 0xbc, 0x96, 0x13,
-	0x15, 0x0f, 0xa7, 0x3f, 0x7d, 0x67, 0x3a, 0xf7, 0xc1, 0x6c, 0xee, 0x83, 0x1f, 0x73, 0x1f, 0x5c,
-	0x2d, 0x7c, 0x67, 0xb6, 0xf0, 0x9d, 0xaf, 0x0b, 0xdf, 0x79, 0x53, 0x83, 0x51, 0xd9, 0x79, 0xc4,
-	0x04, 0xba, 0xb0, 0x3f, 0x55, 0x7a, 0xd3, 0xe4, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0x57, 0xde, 0x7c, 0x79, 0x03, 0x00, 0x00,
+	// 788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0x8d, 0xf3, 0xd1, 0x92, 0xc9, 0x07, 0x30, 0x8a, 0x90, 0x5b, 0x09, 0x27, 0xb5, 0x54, 0x14,
+	0x55, 0x8a, 0xdd, 0x0f, 0x56, 0x59, 0x20, 0x9a, 0x16, 0x0a, 0x08, 0x09, 0xe1, 0x06, 0x21, 0xb1,
+	0xc0, 0x72, 0xe2, 0xa9, 0x33, 0x6a, 0xec, 0x89, 0x3c, 0xe3, 0x36, 0xe9, 0x92, 0x25, 0x62, 0xd1,
+	0x25, 0x62, 0x07, 0x2b, 0xc4, 0xaa, 0x48, 0xfd, 0x11, 0x11, 0xab, 0xaa, 0x2b, 0x56, 0x2d, 0xb4,
+	0x8b, 0xfe, 0x8d, 0xa7, 0x99, 0x71, 0xf2, 0x9c, 0x26, 0xd2, 0xcb, 0x93, 0x9e, 0xde, 0xc6, 0xf2,
+	0xcc, 0x9c, 0x7b, 0xce, 0xbd, 0x67, 0xee, 0x1d, 0x50, 0xeb, 0x12, 0xea, 0x13, 0x6a, 0x3a, 0x11,
+	0xeb, 0x5d, 0x98, 0x67, 0x3b, 0x1d, 0xc4, 0x9c, 0x1d, 0xb9, 0x32, 0x06, 0x21, 0x61, 0x04, 0x56,
+	0x24, 0xc2, 0x90, 0x7b, 0x31, 0x62, 0xfd, 0x7d, 0xc7, 0xc7, 0x01, 0x31, 0xc5, 0x57, 0x02, 0xd7,
+	0xd7, 0x24, 0xd0, 0x16, 0x2b, 0x33, 0x8e, 0x92, 0x47, 0x55, 0x8f, 0x10, 0xaf, 0x8f, 0x4c, 0xb1,
+	0xea, 0x44, 0x27, 0x26, 0xc3, 0x3e, 0xa2, 0xcc, 0xf1, 0x07, 0x31, 0x40, 0x7b, 0x0e, 0x70, 0xa3,
+	0xd0, 0x61, 0x98, 0x04, 0xf1, 0x79, 0xc5, 0x23, 0x1e, 0x91, 0xc4, 0xfc, 0x6f, 0xa2, 0xf8, 0x3c,
+	0xca, 0x09, 0x46, 0x13, 0xc2, 0xb8, 0xae, 0x8e, 0x43, 0xd1, 0xb4, 0xac, 0x2e, 0xc1, 0x31, 0xa1,
+	0xce, 0x40, 0xe5, 0x08, 0x05, 0x28, 0xc4, 0xdd, 0xfd, 0x88, 0xf5, 0x48, 0x88, 0x2f, 0x84, 0x1c,
+	0x7c, 0x0f, 0x64, 0x7c, 0xea, 0xa9, 0x4a, 0x4d, 0xa9, 0xe7, 0x2d, 0xfe, 0xdb, 0xfc, 0xea, 0x9f,
+	0xeb, 0x86, 0xbe, 0xc8, 0x03, 0x63, 0x26, 0xf2, 0xe7, 0xa7, 0xab, 0xad, 0xaa, 0x84, 0x35, 0xa8,
+	0x7b, 0x6a, 0x2e, 0x62, 0xd7, 0x7f, 0xc9, 0x81, 0x0f, 0x0e, 0x88, 0x3f, 0x20, 0x14, 0x33, 0x34,
+	0x2b, 0xac, 0x83, 0x92, 0x4f, 0x3d, 0x9b, 0x8d, 0x06, 0xc8, 0x8e, 0xc2, 0x3e, 0x55, 0x95, 0x5a,
+	0xa6, 0x9e, 0xb7, 0x0a, 0x3e, 0xf5, 0xda, 0xa3, 0x01, 0xfa, 0x2e, 0xec, 0x53, 0xb8, 0x09, 0xca,
+	0xbe, 0x33, 0xb4, 0xd1, 0x10, 0x75, 0x23, 0x1e, 0x44, 0xd5, 0x74, 0x4d, 0xa9, 0x67, 0xad, 0x92,
+	0xef, 0x0c, 0x3f, 0x9b, 0x6e, 0x42, 0x0d, 0x80, 0x04, 0x24, 0x23, 0x20, 0x89, 0x1d, 0xf8, 0x93,
+	0x02, 0x0a, 0x74, 0x80, 0x02, 0xd7, 0xee, 0x63, 0x1f, 0x33, 0x35, 0x5b, 0xcb, 0xd4, 0x0b, 0xbb,
+	0x6b, 0x46, 0x5c, 0x24, 0xb7, 0x6c, 0x5a, 0xe3, 0x01, 0xc1, 0x41, 0xeb, 0xf3, 0xf1, 0x5d, 0x35,
+	0xf5, 0xd7, 0x7d, 0xb5, 0xee, 0x61, 0xd6, 0x8b, 0x3a, 0x46, 0x97, 0xf8, 0xf1, 0xfd, 0x9a, 0x89,
+	0x8a, 0x79, 0xea, 0x54, 0x04, 0xd0, 0xdf, 0x9e, 0xae, 0xb6, 0x8a, 0x7d, 0xe4, 0x39, 0xdd, 0x91,
+	0xcd, 0x4d, 0xa7, 0x7f, 0x3e, 0x5d, 0x6d, 0x29, 0x16, 0x10, 0xaa, 0x5f, 0x73, 0x51, 0x78, 0x0e,
+	0x72, 0x7c, 0xc5, 0xd4, 0xdc, 0xdb, 0x52, 0x97, 0x7a, 0xf0, 0x18, 0x14, 0x79, 0xf7, 0xd9, 0xe7,
+	0x38, 0x70, 0xc9, 0x39, 0x55, 0x57, 0x84, 0xfe, 0xa6, 0xb1, 0xf0, 0x8a, 0xdb, 0xd8, 0x47, 0xdf,
+	0x9c, 0x1c, 0x3a, 0xa3, 0xef, 0x05, 0xba, 0x95, 0xe7, 0xb9, 0x48, 0xba, 0x02, 0x67, 0x91, 0xdb,
+	0x14, 0xb6, 0x41, 0xb9, 0x87, 0xb0, 0xd7, 0x63, 0x53, 0xda, 0x55, 0x41, 0xab, 0x2f, 0xa6, 0xfd,
+	0x42, 0x60, 0xe7, 0x39, 0x4b, 0xbd, 0xc4, 0x01, 0x6d, 0xfe, 0xb8, 0x5c, 0xeb, 0xdd, 0x5e, 0x37,
+	0xca, 0x43, 0x39, 0xb3, 0xb5, 0xb3, 0x6d, 0x63, 0xcf, 0xd8, 0xe6, 0xcd, 0xb8, 0x91, 0x30, 0x67,
+	0x71, 0xcf, 0xe9, 0xbf, 0x2b, 0xe0, 0xdd, 0x67, 0x15, 0xc2, 0x4f, 0x40, 0x8e, 0x32, 0x27, 0x64,
+	0x62, 0x04, 0xf8, 0xbd, 0xc8, 0x19, 0x33, 0x26, 0x33, 0x66, 0x1c, 0xc6, 0x93, 0xd9, 0x2a, 0xf1,
+	0xbc, 0x7f, 0xbd, 0xaf, 0x2a, 0x13, 0x7b, 0x79, 0x18, 0x6c, 0x82, 0x0c, 0x0a, 0x5c, 0x35, 0xfd,
+	0x9a, 0xd1, 0x3c, 0xa8, 0x09, 0xe7, 0x2b, 0xd1, 0x5d, 0x50, 0x4c, 0xba, 0x05, 0x37, 0x40, 0x51,
+	0x08, 0xd9, 0xd2, 0x2a, 0x91, 0x66, 0xc6, 0x2a, 0x88, 0x3d, 0x09, 0x84, 0x1f, 0x02, 0xc0, 0x9b,
+	0x3b, 0x06, 0xa4, 0x05, 0x20, 0x8f, 0x02, 0x57, 0x1e, 0x2f, 0x54, 0xf9, 0x5b, 0x01, 0xb9, 0xa3,
+	0xd0, 0x09, 0x18, 0xec, 0x80, 0x92, 0x93, 0x34, 0x29, 0xf6, 0xa1, 0x32, 0x57, 0xc9, 0x7e, 0x30,
+	0x6a, 0x7d, 0xb4, 0xdc, 0x05, 0x59, 0xb3, 0x94, 0xf0, 0x90, 0x0f, 0xe8, 0x00, 0x4b, 0x27, 0x62,
+	0xab, 0xd6, 0xe7, 0x04, 0xda, 0x93, 0x37, 0xb2, 0xf5, 0xce, 0xf8, 0xae, 0xaa, 0x5c, 0xde, 0x57,
+	0x15, 0x2b, 0x11, 0xa7, 0xff, 0x91, 0x06, 0x50, 0xe4, 0x3c, 0xfb, 0x90, 0xec, 0x82, 0x55, 0x8f,
+	0xef, 0xa2, 0x50, 0xbe, 0x62, 0x2d, 0xf5, 0xf6, 0xba, 0x31, 0x79, 0xc4, 0xf7, 0x5d, 0x37, 0x44,
+	0x94, 0x1e, 0xb3, 0x10, 0x07, 0x9e, 0x35, 0x01, 0xbe, 0x8c, 0x41, 0x6a, 0x7a, 0xb9, 0x18, 0x34,
+	0x6f, 0x54, 0xe6, 0xcd, 0x1b, 0xf5, 0xe9, 0x8c, 0x51, 0xd9, 0x57, 0x1a, 0x95, 0x9d, 0x33, 0xe9,
+	0x63, 0x50, 0x16, 0x1e, 0x7d, 0x1b, 0xa1, 0x08, 0x7d, 0xc9, 0x90, 0xbf, 0xcc, 0x43, 0xdb, 0xda,
+	0x1d, 0xff, 0xaf, 0xa5, 0xc6, 0x0f, 0x9a, 0x72, 0xf3, 0xa0, 0x29, 0xff, 0x3d, 0x68, 0xca, 0xe5,
+	0xa3, 0x96, 0xba, 0x79, 0xd4, 0x52, 0xff, 0x3e, 0x6a, 0xa9, 0x1f, 0x62, 0x63, 0xa8, 0x7b, 0x6a,
+	0x60, 0x62, 0xc6, 0x9d, 0xd4, 0x59, 0x11, 0xf9, 0xec, 0xbd, 0x18, 0x00, 0xca, 0x69, 0x35, 0x68,
+	0x52, 0x07, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +436,176 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CompositeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompositeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompositeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeightWindows) > 0 {
+		for iNdEx := len(m.HeightWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeightWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TimeWindows) > 0 {
+		for iNdEx := len(m.TimeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Executions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeOfDayWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeOfDayWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeOfDayWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.End):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Start):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HeightWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -286,12 +627,12 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n3
+		i = encodeVarintAuthz(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -331,12 +672,12 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -425,6 +766,79 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *CompositeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxExecutions))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovAuthz(uint64(m.Executions))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.TimeWindows) > 0 {
+		for _, e := range m.TimeWindows {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.HeightWindows) > 0 {
+		for _, e := range m.HeightWindows {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimeOfDayWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Start)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.End)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *HeightWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovAuthz(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovAuthz(uint64(m.EndHeight))
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +984,466 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CompositeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompositeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompositeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeWindows = append(m.TimeWindows, TimeOfDayWindow{})
+			if err := m.TimeWindows[len(m.TimeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeightWindows = append(m.HeightWindows, HeightWindow{})
+			if err := m.HeightWindows[len(m.HeightWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeOfDayWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeOfDayWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeOfDayWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,9 +29,14 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagMsgTypes          = "msg-types"
+	FlagMaxExecutions     = "max-executions"
+	FlagTimeWindows       = "time-windows"
+	FlagHeightWindows     = "height-windows"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
+	composite             = "composite"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -95,12 +101,13 @@ func NewCmdExecAuthorization() *cobra.Command {
 // Migrating this command to AutoCLI is possible but would be CLI breaking.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"|\"composite\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 Examples:
  $ %[1]s tx authz grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
+ $ %[1]s tx authz grant cosmos1skjw.. composite --msg-types=/cosmos.bank.v1beta1.MsgSend,/cosmos.staking.v1beta1.MsgDelegate --max-executions=10 --spend-limit=1000stake --time-windows=09:00-17:00 --from=cosmos1sk..
 	`, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -229,6 +236,12 @@ Examples:
 					return err
 				}
 
+			case composite:
+				authorization, err = compositeAuthorization(cmd)
+				if err != nil {
+					return err
+				}
+
 			default:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
@@ -252,10 +265,110 @@ Examples:
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
+	cmd.Flags().StringSlice(FlagMsgTypes, []string{}, "The Msg method names for which we are creating a CompositeAuthorization separated by ,")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Maximum number of executions for Composite Authorization, zero (0) for no limit")
+	cmd.Flags().StringSlice(FlagTimeWindows, []string{}, "UTC time of day windows for Composite Authorization, as <HH:MM>-<HH:MM> separated by ,")
+	cmd.Flags().StringSlice(FlagHeightWindows, []string{}, "Block height windows for Composite Authorization, as <start>-[end] separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
 	return cmd
 }
 
+// compositeAuthorization builds a CompositeAuthorization from the command flags.
+func compositeAuthorization(cmd *cobra.Command) (*authz.CompositeAuthorization, error) {
+	msgTypes, err := cmd.Flags().GetStringSlice(FlagMsgTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	spendLimit, err := sdk.ParseCoinsNormalized(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization := authz.NewCompositeAuthorization(msgTypes, maxExecutions, spendLimit)
+
+	timeWindows, err := cmd.Flags().GetStringSlice(FlagTimeWindows)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range timeWindows {
+		window, err := parseTimeOfDayWindow(w)
+		if err != nil {
+			return nil, err
+		}
+		authorization.TimeWindows = append(authorization.TimeWindows, window)
+	}
+
+	heightWindows, err := cmd.Flags().GetStringSlice(FlagHeightWindows)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range heightWindows {
+		window, err := parseHeightWindow(w)
+		if err != nil {
+			return nil, err
+		}
+		authorization.HeightWindows = append(authorization.HeightWindows, window)
+	}
+
+	return authorization, authorization.ValidateBasic()
+}
+
+// parseTimeOfDayWindow parses a time of day window in the <HH:MM>-<HH:MM> format.
+func parseTimeOfDayWindow(s string) (authz.TimeOfDayWindow, error) {
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return authz.TimeOfDayWindow{}, fmt.Errorf("invalid time window %s, expected <HH:MM>-<HH:MM>", s)
+	}
+
+	var window authz.TimeOfDayWindow
+	for _, v := range []struct {
+		value string
+		d     *time.Duration
+	}{{start, &window.Start}, {end, &window.End}} {
+		t, err := time.Parse("15:04", strings.TrimSpace(v.value))
+		if err != nil {
+			return authz.TimeOfDayWindow{}, fmt.Errorf("invalid time window %s: %w", s, err)
+		}
+		*v.d = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+
+	return window, nil
+}
+
+// parseHeightWindow parses a height window in the <start>-[end] format.
+func parseHeightWindow(s string) (authz.HeightWindow, error) {
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return authz.HeightWindow{}, fmt.Errorf("invalid height window %s, expected <start>-[end]", s)
+	}
+
+	var (
+		window authz.HeightWindow
+		err    error
+	)
+	if window.StartHeight, err = strconv.ParseInt(strings.TrimSpace(start), 10, 64); err != nil {
+		return authz.HeightWindow{}, fmt.Errorf("invalid height window %s: %w", s, err)
+	}
+	if end = strings.TrimSpace(end); end != "" {
+		if window.EndHeight, err = strconv.ParseInt(end, 10, 64); err != nil {
+			return authz.HeightWindow{}, fmt.Errorf("invalid height window %s: %w", s, err)
+		}
+	}
+
+	return window, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -425,6 +425,52 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 			false,
 			"",
 		},
+		{
+			"Valid tx composite authorization",
+			[]string{
+				granteeAddr,
+				"composite",
+				fmt.Sprintf("--%s=%s,%s", cli.FlagMsgTypes, typeMsgVote, sdk.MsgTypeURL(&banktypes.MsgSend{})),
+				fmt.Sprintf("--%s=5", cli.FlagMaxExecutions),
+				fmt.Sprintf("--%s=100stake", cli.FlagSpendLimit),
+				fmt.Sprintf("--%s=09:00-17:00,22:00-02:00", cli.FlagTimeWindows),
+				fmt.Sprintf("--%s=100-", cli.FlagHeightWindows),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, fromAddr),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
+		{
+			"Invalid composite authorization time window",
+			[]string{
+				granteeAddr,
+				"composite",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgTypes, typeMsgVote),
+				fmt.Sprintf("--%s=9am-5pm", cli.FlagTimeWindows),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, fromAddr),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			},
+			true,
+			"invalid time window",
+		},
+		{
+			"Invalid composite authorization without msg types",
+			[]string{
+				granteeAddr,
+				"composite",
+				fmt.Sprintf("--%s=5", cli.FlagMaxExecutions),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, fromAddr),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			},
+			true,
+			"msg types cannot be empty",
+		},
 		{
 			"fail when granter = grantee",
 			[]string{
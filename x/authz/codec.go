@@ -19,6 +19,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 
 	registrar.RegisterInterface((*Authorization)(nil), nil)
 	registrar.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization")
+	registrar.RegisterConcrete(&CompositeAuthorization{}, "cosmos-sdk/CompositeAuthorization")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -37,6 +38,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&CompositeAuthorization{},
 		&bank.SendAuthorization{},
 		&staking.StakeAuthorization{},
 	)
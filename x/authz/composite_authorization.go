@@ -0,0 +1,372 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/core/appmodule"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TODO: Revisit this once we have proper gas fee framework.
+// Tracking issues https://github.com/cosmos/cosmos-sdk/issues/9054,
+// https://github.com/cosmos/cosmos-sdk/discussions/9072
+const gasCostPerIteration = uint64(20)
+
+// coinMessageName is the full name of the coin message, whose fields are summed to
+// compute the amount spent by a message.
+const coinMessageName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+
+// anyMessageName is the full name of the Any message, whose embedded messages are
+// not measured by the spend limit.
+const anyMessageName protoreflect.FullName = "google.protobuf.Any"
+
+// spendingFields are the fields of the messages whose coins are spent, keyed by message name.
+// The coins of the other fields of these messages, e.g. the outputs of a MsgMultiSend, are not
+// counted by the spend limit.
+var spendingFields = map[protoreflect.FullName][]protoreflect.Name{
+	"cosmos.bank.v1beta1.MsgMultiSend": {"inputs"},
+}
+
+// RegisterSpendingFields declares the fields of the message with the given name whose coins
+// are spent, so that the spend limit of a CompositeAuthorization only counts these fields,
+// e.g. the offered amount of a swap rather than its minimum output amount. It must be called
+// at initialization, before any message is executed.
+func RegisterSpendingFields(msgName string, fields ...string) {
+	names := make([]protoreflect.Name, len(fields))
+	for i, field := range fields {
+		names[i] = protoreflect.Name(field)
+	}
+	spendingFields[protoreflect.FullName(msgName)] = names
+}
+
+var _ Authorization = &CompositeAuthorization{}
+
+// NewCompositeAuthorization creates a new CompositeAuthorization object.
+func NewCompositeAuthorization(msgTypeURLs []string, maxExecutions uint64, spendLimit sdk.Coins) *CompositeAuthorization {
+	return &CompositeAuthorization{
+		MsgTypeUrls:   msgTypeURLs,
+		MaxExecutions: maxExecutions,
+		SpendLimit:    spendLimit,
+	}
+}
+
+// CompositeAuthorizationTypeURL is the type URL under which composite authorizations are stored.
+// A granter can thus give a single composite authorization to a grantee.
+var CompositeAuthorizationTypeURL = sdk.MsgTypeURL(&CompositeAuthorization{})
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+// As a composite authorization covers several messages, it returns the type URL of the
+// authorization itself rather than the one of a message.
+func (a CompositeAuthorization) MsgTypeURL() string {
+	return CompositeAuthorizationTypeURL
+}
+
+// Allows returns true if the authorization covers the given message type URL.
+func (a CompositeAuthorization) Allows(msgTypeURL string) bool {
+	for _, t := range a.MsgTypeUrls {
+		if t == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// Accept implements Authorization.Accept.
+func (a CompositeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	env, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("environment not set")
+	}
+	gasMeter := env.GasService.GasMeter(ctx)
+
+	typeURL := sdk.MsgTypeURL(msg)
+	if err := gasMeter.Consume(gasCostPerIteration*uint64(len(a.MsgTypeUrls)), "composite authorization"); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if !a.Allows(typeURL) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed", typeURL)
+	}
+
+	headerInfo := env.HeaderService.HeaderInfo(ctx)
+	if err := gasMeter.Consume(gasCostPerIteration*uint64(len(a.TimeWindows)+len(a.HeightWindows)), "composite authorization"); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if !a.inTimeWindows(headerInfo.Time) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("time %s is outside of the allowed time windows", headerInfo.Time.UTC().Format(time.TimeOnly))
+	}
+	if !a.inHeightWindows(headerInfo.Height) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("height %d is outside of the allowed height windows", headerInfo.Height)
+	}
+
+	if a.MaxExecutions > 0 && a.Executions >= a.MaxExecutions {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("maximum number of executions reached")
+	}
+	executions := a.Executions + 1
+
+	spent := a.Spent
+	if len(a.SpendLimit) > 0 {
+		amount, err := msgCoins(msg)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		spent = spent.Add(amount...)
+		if !spent.IsAllLTE(a.SpendLimit) {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than spend limit")
+		}
+	}
+
+	if (a.MaxExecutions > 0 && executions == a.MaxExecutions) ||
+		(len(a.SpendLimit) > 0 && a.SpendLimit.Sub(spent...).IsZero()) {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &CompositeAuthorization{
+		MsgTypeUrls:   a.MsgTypeUrls,
+		MaxExecutions: a.MaxExecutions,
+		Executions:    executions,
+		SpendLimit:    a.SpendLimit,
+		Spent:         spent,
+		TimeWindows:   a.TimeWindows,
+		HeightWindows: a.HeightWindows,
+	}}, nil
+}
+
+// inTimeWindows returns true if the time of day of t is within one of the time windows.
+func (a CompositeAuthorization) inTimeWindows(t time.Time) bool {
+	if len(a.TimeWindows) == 0 {
+		return true
+	}
+
+	t = t.UTC()
+	offset := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+	for _, w := range a.TimeWindows {
+		if w.Contains(offset) {
+			return true
+		}
+	}
+	return false
+}
+
+// inHeightWindows returns true if height is within one of the height windows.
+func (a CompositeAuthorization) inHeightWindows(height int64) bool {
+	if len(a.HeightWindows) == 0 {
+		return true
+	}
+
+	for _, w := range a.HeightWindows {
+		if w.Contains(height) {
+			return true
+		}
+	}
+	return false
+}
+
+// msgCoins returns the sum of the coins spent by the message. For the messages
+// whose spending fields are declared with RegisterSpendingFields, e.g. the inputs
+// of a MsgMultiSend, only the coins of these fields are counted. Otherwise, all
+// the coins of the message are counted, including the ones of its nested
+// messages, lists and maps. Messages embedding other messages in Any values are
+// rejected, as their spending cannot be measured.
+func msgCoins(msg sdk.Msg) (sdk.Coins, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(gogoproto.MessageName(msg)))
+	if err != nil {
+		return nil, err
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", desc.FullName())
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	content := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(bz, content); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins()
+	fields, ok := spendingFields[msgDesc.FullName()]
+	if !ok {
+		if err := addCoins(&coins, content); err != nil {
+			return nil, err
+		}
+		return coins, nil
+	}
+
+	for _, name := range fields {
+		fd := msgDesc.Fields().ByName(name)
+		if fd == nil {
+			return nil, fmt.Errorf("%s has no spending field %s", msgDesc.FullName(), name)
+		}
+		if !content.Has(fd) {
+			continue
+		}
+		if err := addFieldCoins(&coins, fd, content.Get(fd)); err != nil {
+			return nil, err
+		}
+	}
+	return coins, nil
+}
+
+// addCoins adds the coins of the given message and of its nested messages to coins.
+func addCoins(coins *sdk.Coins, m protoreflect.Message) error {
+	switch m.Descriptor().FullName() {
+	case coinMessageName:
+		coin, err := toCoin(m)
+		if err != nil {
+			return err
+		}
+		*coins = coins.Add(coin)
+		return nil
+	case anyMessageName:
+		return sdkerrors.ErrUnauthorized.Wrap("cannot measure the spending of a message with Any values")
+	}
+
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		err = addFieldCoins(coins, fd, v)
+		return err == nil
+	})
+	return err
+}
+
+// addFieldCoins adds the coins of the given field value, and of its nested messages, to coins.
+func addFieldCoins(coins *sdk.Coins, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	var err error
+	switch {
+	case fd.IsMap():
+		if fd.MapValue().Message() == nil {
+			return nil
+		}
+		v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+			err = addCoins(coins, v.Message())
+			return err == nil
+		})
+	case fd.Message() == nil:
+	case fd.IsList():
+		list := v.List()
+		for i := 0; i < list.Len() && err == nil; i++ {
+			err = addCoins(coins, list.Get(i).Message())
+		}
+	default:
+		err = addCoins(coins, v.Message())
+	}
+	return err
+}
+
+// toCoin converts a reflective coin message to a sdk.Coin.
+func toCoin(m protoreflect.Message) (sdk.Coin, error) {
+	fields := m.Descriptor().Fields()
+	denom := m.Get(fields.ByName("denom")).String()
+	amount, ok := math.NewIntFromString(m.Get(fields.ByName("amount")).String())
+	if !ok {
+		return sdk.Coin{}, sdkerrors.ErrInvalidCoins.Wrapf("invalid amount of %s", denom)
+	}
+
+	coin := sdk.Coin{Denom: denom, Amount: amount}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	return coin, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CompositeAuthorization) ValidateBasic() error {
+	if len(a.MsgTypeUrls) == 0 {
+		return errors.New("msg types cannot be empty")
+	}
+	seen := make(map[string]struct{}, len(a.MsgTypeUrls))
+	for _, t := range a.MsgTypeUrls {
+		if strings.TrimSpace(t) == "" {
+			return errors.New("msg type cannot be empty")
+		}
+		if _, ok := seen[t]; ok {
+			return fmt.Errorf("duplicate msg type %s", t)
+		}
+		seen[t] = struct{}{}
+	}
+
+	if a.MaxExecutions > 0 && a.Executions >= a.MaxExecutions {
+		return fmt.Errorf("executions %d must be lower than the maximum executions %d", a.Executions, a.MaxExecutions)
+	}
+
+	if err := a.SpendLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %s", err)
+	}
+	if err := a.Spent.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spent amount: %s", err)
+	}
+	if len(a.SpendLimit) > 0 && !a.Spent.IsAllLTE(a.SpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("spent amount cannot exceed the spend limit")
+	}
+	if len(a.SpendLimit) == 0 && !a.Spent.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrap("spent amount cannot be set without a spend limit")
+	}
+
+	for _, w := range a.TimeWindows {
+		if err := w.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, w := range a.HeightWindows {
+		if err := w.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains returns true if the offset from midnight is within the window.
+func (w TimeOfDayWindow) Contains(offset time.Duration) bool {
+	if w.Start <= w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	// the window spans midnight
+	return offset >= w.Start || offset < w.End
+}
+
+// ValidateBasic performs basic validation of the time of day window.
+func (w TimeOfDayWindow) ValidateBasic() error {
+	const day = 24 * time.Hour
+	if w.Start < 0 || w.Start >= day || w.End < 0 || w.End >= day {
+		return fmt.Errorf("time window %s-%s must be within a day", w.Start, w.End)
+	}
+	if w.Start == w.End {
+		return fmt.Errorf("time window %s-%s cannot be empty", w.Start, w.End)
+	}
+	return nil
+}
+
+// Contains returns true if the height is within the window.
+func (w HeightWindow) Contains(height int64) bool {
+	return height >= w.StartHeight && (w.EndHeight == 0 || height <= w.EndHeight)
+}
+
+// ValidateBasic performs basic validation of the height window.
+func (w HeightWindow) ValidateBasic() error {
+	if w.StartHeight < 0 || w.EndHeight < 0 {
+		return fmt.Errorf("height window %d-%d cannot be negative", w.StartHeight, w.EndHeight)
+	}
+	if w.EndHeight != 0 && w.EndHeight < w.StartHeight {
+		return fmt.Errorf("height window end %d is before its start %d", w.EndHeight, w.StartHeight)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	coregas "cosmossdk.io/core/gas"
+	coreheader "cosmossdk.io/core/header"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type headerService struct {
+	info coreheader.Info
+}
+
+func (h headerService) HeaderInfo(ctx context.Context) coreheader.Info {
+	return h.info
+}
+
+type mockGasService struct {
+	coregas.Service
+}
+
+func (m mockGasService) GasMeter(ctx context.Context) coregas.Meter {
+	return mockGasMeter{}
+}
+
+type mockGasMeter struct {
+	coregas.Meter
+}
+
+func (m mockGasMeter) Consume(amount coregas.Gas, descriptor string) error {
+	return nil
+}
+
+func TestCompositeAuthorizationAccept(t *testing.T) {
+	withHeader := func(info coreheader.Info) context.Context {
+		return context.WithValue(context.Background(), corecontext.EnvironmentContextKey, appmodulev2.Environment{
+			HeaderService: headerService{info},
+			GasService:    mockGasService{},
+		})
+	}
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := withHeader(coreheader.Info{Height: 10, Time: noon})
+
+	send := &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	delegate := &stakingtypes.MsgDelegate{Amount: sdk.NewInt64Coin("stake", 20)}
+	msgTypeURLs := []string{sdk.MsgTypeURL(send), sdk.MsgTypeURL(delegate)}
+
+	t.Log("verify messages of other types are rejected")
+	a := authz.NewCompositeAuthorization(msgTypeURLs[:1], 0, nil)
+	require.NoError(t, a.ValidateBasic())
+	_, err := a.Accept(ctx, delegate)
+	require.ErrorContains(t, err, "is not allowed")
+
+	t.Log("verify the executions and spending are tracked across message types")
+	a = authz.NewCompositeAuthorization(msgTypeURLs, 3, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, a.ValidateBasic())
+	resp, err := a.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	a = resp.Updated.(*authz.CompositeAuthorization)
+	require.Equal(t, uint64(1), a.Executions)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), a.Spent)
+
+	resp, err = a.Accept(ctx, delegate)
+	require.NoError(t, err)
+	a = resp.Updated.(*authz.CompositeAuthorization)
+	require.Equal(t, uint64(2), a.Executions)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), a.Spent)
+	require.NoError(t, a.ValidateBasic())
+
+	t.Log("verify the spend limit is enforced")
+	_, err = a.Accept(ctx, &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 71))})
+	require.ErrorContains(t, err, "requested amount is more than spend limit")
+	_, err = a.Accept(ctx, &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))})
+	require.ErrorContains(t, err, "requested amount is more than spend limit")
+
+	t.Log("verify only the coins of the spending fields of nested messages are counted")
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))}},
+		Outputs: []banktypes.Output{{Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))}},
+	}
+	multiSendAuthz := authz.NewCompositeAuthorization([]string{sdk.MsgTypeURL(multiSend)}, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	resp, err = multiSendAuthz.Accept(ctx, multiSend)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), resp.Updated.(*authz.CompositeAuthorization).Spent)
+
+	t.Log("verify messages embedding Any values are rejected with a spend limit")
+	exec := authz.NewMsgExec("grantee", []sdk.Msg{send})
+	_, err = authz.NewCompositeAuthorization([]string{sdk.MsgTypeURL(&exec)}, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Accept(ctx, &exec)
+	require.ErrorContains(t, err, "cannot measure the spending")
+
+	t.Log("verify the authorization is deleted once the executions are used up")
+	resp, err = a.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	t.Log("verify the authorization is deleted once the spend limit is used up")
+	a = authz.NewCompositeAuthorization(msgTypeURLs, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))
+	resp, err = a.Accept(ctx, delegate)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+
+	t.Log("verify the time of day windows are enforced")
+	a = authz.NewCompositeAuthorization(msgTypeURLs, 0, nil)
+	a.TimeWindows = []authz.TimeOfDayWindow{{Start: 22 * time.Hour, End: 2 * time.Hour}}
+	require.NoError(t, a.ValidateBasic())
+	_, err = a.Accept(ctx, send)
+	require.ErrorContains(t, err, "outside of the allowed time windows")
+	resp, err = a.Accept(withHeader(coreheader.Info{Height: 10, Time: noon.Add(11 * time.Hour)}), send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	_, err = a.Accept(withHeader(coreheader.Info{Height: 10, Time: noon.Add(13 * time.Hour)}), send)
+	require.NoError(t, err)
+
+	t.Log("verify the height windows are enforced")
+	a = authz.NewCompositeAuthorization(msgTypeURLs, 0, nil)
+	a.HeightWindows = []authz.HeightWindow{{StartHeight: 1, EndHeight: 5}, {StartHeight: 20}}
+	require.NoError(t, a.ValidateBasic())
+	_, err = a.Accept(ctx, send)
+	require.ErrorContains(t, err, "outside of the allowed height windows")
+	_, err = a.Accept(withHeader(coreheader.Info{Height: 5, Time: noon}), send)
+	require.NoError(t, err)
+	_, err = a.Accept(withHeader(coreheader.Info{Height: 1000, Time: noon}), send)
+	require.NoError(t, err)
+}
+
+func TestRegisterSpendingFields(t *testing.T) {
+	ctx := context.WithValue(context.Background(), corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		HeaderService: headerService{coreheader.Info{Height: 10}},
+		GasService:    mockGasService{},
+	})
+
+	// a redelegation moves the delegated coins without spending them
+	authz.RegisterSpendingFields("cosmos.staking.v1beta1.MsgBeginRedelegate")
+	redelegate := &stakingtypes.MsgBeginRedelegate{Amount: sdk.NewInt64Coin("stake", 200)}
+	a := authz.NewCompositeAuthorization([]string{sdk.MsgTypeURL(redelegate)}, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	resp, err := a.Accept(ctx, redelegate)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Updated.(*authz.CompositeAuthorization).Spent.IsZero())
+
+	authz.RegisterSpendingFields("cosmos.staking.v1beta1.MsgBeginRedelegate", "unknown")
+	_, err = a.Accept(ctx, redelegate)
+	require.ErrorContains(t, err, "has no spending field unknown")
+
+	authz.RegisterSpendingFields("cosmos.staking.v1beta1.MsgBeginRedelegate", "amount")
+	_, err = a.Accept(ctx, redelegate)
+	require.ErrorContains(t, err, "requested amount is more than spend limit")
+}
+
+func TestCompositeAuthorizationValidateBasic(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	stake10 := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name   string
+		a      authz.CompositeAuthorization
+		errMsg string
+	}{
+		{"valid", authz.CompositeAuthorization{MsgTypeUrls: []string{sendURL}, MaxExecutions: 2, Executions: 1}, ""},
+		{"no msg types", authz.CompositeAuthorization{}, "msg types cannot be empty"},
+		{"duplicate msg types", authz.CompositeAuthorization{MsgTypeUrls: []string{sendURL, sendURL}}, "duplicate msg type"},
+		{"executions used up", authz.CompositeAuthorization{MsgTypeUrls: []string{sendURL}, MaxExecutions: 1, Executions: 1}, "must be lower than the maximum executions"},
+		{"spent over limit", authz.CompositeAuthorization{MsgTypeUrls: []string{sendURL}, SpendLimit: stake10, Spent: stake10.Add(stake10...)}, "cannot exceed the spend limit"},
+		{"spent without limit", authz.CompositeAuthorization{MsgTypeUrls: []string{sendURL}, Spent: stake10}, "without a spend limit"},
+		{"empty time window", authz.CompositeAuthorization{MsgTypeUrls: []string{sendURL}, TimeWindows: []authz.TimeOfDayWindow{{Start: time.Hour, End: time.Hour}}}, "cannot be empty"},
+		{"time window over a day", authz.CompositeAuthorization{MsgTypeUrls: []string{sendURL}, TimeWindows: []authz.TimeOfDayWindow{{End: 25 * time.Hour}}}, "must be within a day"},
+		{"inverted height window", authz.CompositeAuthorization{MsgTypeUrls: []string{sendURL}, HeightWindows: []authz.HeightWindow{{StartHeight: 10, EndHeight: 5}}}, "is before its start"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.a.ValidateBasic()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -106,6 +106,11 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
 
 			grant, found := k.getGrant(ctx, skey)
+			if !found {
+				// fallback to the composite authorization of the granter, which
+				// covers several message types under a single grant
+				grant, found = k.getGrant(ctx, grantStoreKey(grantee, granter, authz.CompositeAuthorizationTypeURL))
+			}
 			if !found {
 				return nil, errorsmod.Wrapf(authz.ErrNoAuthorizationFound,
 					"failed to get grant with given granter: %s, grantee: %s & msgType: %s ", sdk.AccAddress(granter), grantee, sdk.MsgTypeURL(msg))
@@ -129,7 +134,7 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			}
 
 			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+				err = k.DeleteGrant(ctx, grantee, granter, authorization.MsgTypeURL())
 			} else if resp.Updated != nil {
 				updated, ok := resp.Updated.(authz.Authorization)
 				if !ok {
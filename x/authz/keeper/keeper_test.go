@@ -394,6 +394,57 @@ func (s *TestSuite) TestDispatchAction() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionComposite() {
+	addrs := s.addrs
+	require := s.Require()
+	now := s.ctx.HeaderInfo().Time
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	granterStrAddr, err := s.addrCdc.BytesToString(addrs[0])
+	require.NoError(err)
+	recipientStrAddr, err := s.addrCdc.BytesToString(addrs[2])
+	require.NoError(err)
+
+	send := &banktypes.MsgSend{
+		Amount:      coins10,
+		FromAddress: granterStrAddr,
+		ToAddress:   recipientStrAddr,
+	}
+
+	a := authz.NewCompositeAuthorization([]string{bankSendAuthMsgType}, 3, coins100)
+	e := now.AddDate(0, 1, 0)
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, &e))
+
+	s.T().Log("verify the composite authorization is used and tracked across executions")
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send, send})
+	require.NoError(err)
+
+	authorization, _ := s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, authz.CompositeAuthorizationTypeURL)
+	require.NotNil(authorization)
+	composite := authorization.(*authz.CompositeAuthorization)
+	require.Equal(uint64(2), composite.Executions)
+	require.Equal(coins10.Add(coins10...), composite.Spent)
+
+	s.T().Log("verify a grant for the message type takes precedence over the composite authorization")
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(coins1000, nil, s.addrCdc), &e))
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.NoError(err)
+	require.NoError(s.authzKeeper.DeleteGrant(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+
+	authorization, _ = s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, authz.CompositeAuthorizationTypeURL)
+	require.Equal(uint64(2), authorization.(*authz.CompositeAuthorization).Executions)
+
+	s.T().Log("verify the composite authorization is removed once its executions are used up")
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.NoError(err)
+
+	authorization, _ = s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, authz.CompositeAuthorizationTypeURL)
+	require.Nil(authorization)
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.ErrorContains(err, "authorization not found")
+}
+
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
 func (s *TestSuite) TestDispatchedEvents() {
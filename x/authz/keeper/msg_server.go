@@ -39,15 +39,21 @@ func (k Keeper) Grant(ctx context.Context, msg *authz.MsgGrant) (*authz.MsgGrant
 		return nil, err
 	}
 
-	t := authorization.MsgTypeURL()
-	if err := k.MsgRouterService.CanInvoke(ctx, t); err != nil {
-		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist", t)
+	msgTypeURLs := []string{authorization.MsgTypeURL()}
+	if composite, ok := authorization.(*authz.CompositeAuthorization); ok {
+		msgTypeURLs = composite.MsgTypeUrls
 	}
 
-	// Disable granting other accounts with grant permission.
-	// Preventing user from accidentally authorizing their entire account to a different account.
-	if t == sdk.MsgTypeURL(&authz.MsgGrant{}) {
-		return nil, sdkerrors.ErrInvalidType.Wrap("authz msgGrant is not allowed")
+	for _, t := range msgTypeURLs {
+		if err := k.MsgRouterService.CanInvoke(ctx, t); err != nil {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist", t)
+		}
+
+		// Disable granting other accounts with grant permission.
+		// Preventing user from accidentally authorizing their entire account to a different account.
+		if t == sdk.MsgTypeURL(&authz.MsgGrant{}) {
+			return nil, sdkerrors.ErrInvalidType.Wrap("authz msgGrant is not allowed")
+		}
 	}
 
 	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
@@ -201,6 +201,49 @@ func (suite *TestSuite) TestGrant() {
 			expErr: true,
 			errMsg: "authz msgGrant is not allowed",
 		},
+		{
+			name: "valid composite grant",
+			malleate: func() *authz.MsgGrant {
+				a := authz.NewCompositeAuthorization([]string{bankSendAuthMsgType, "/cosmos.bank.v1beta1.MsgUpdateParams"}, 10, coins)
+				grant, err := authz.NewGrant(curBlockTime, a, &oneYear)
+				suite.Require().NoError(err)
+				return &authz.MsgGrant{
+					Granter: granterStrAddr,
+					Grantee: granteeStrAddr,
+					Grant:   grant,
+				}
+			},
+		},
+		{
+			name: "invalid composite grant with unknown msg type",
+			malleate: func() *authz.MsgGrant {
+				a := authz.NewCompositeAuthorization([]string{bankSendAuthMsgType, "/cosmos.unknown.v1.MsgUnknown"}, 10, coins)
+				grant, err := authz.NewGrant(curBlockTime, a, &oneYear)
+				suite.Require().NoError(err)
+				return &authz.MsgGrant{
+					Granter: granterStrAddr,
+					Grantee: granteeStrAddr,
+					Grant:   grant,
+				}
+			},
+			expErr: true,
+			errMsg: "/cosmos.unknown.v1.MsgUnknown doesn't exist",
+		},
+		{
+			name: "invalid composite grant with msg grant",
+			malleate: func() *authz.MsgGrant {
+				a := authz.NewCompositeAuthorization([]string{bankSendAuthMsgType, "/cosmos.authz.v1beta1.MsgGrant"}, 10, coins)
+				grant, err := authz.NewGrant(curBlockTime, a, &oneYear)
+				suite.Require().NoError(err)
+				return &authz.MsgGrant{
+					Granter: granterStrAddr,
+					Grantee: granteeStrAddr,
+					Grant:   grant,
+				}
+			},
+			expErr: true,
+			errMsg: "authz msgGrant is not allowed",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -24,6 +24,10 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagMsgFilter   = "msg-filter"
+	FlagMaxGasPerTx = "max-gas-per-tx"
+	FlagPeriodTxs   = "period-max-txs"
+	FlagTxPeriod    = "tx-period"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -60,8 +64,10 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
-				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --max-gas-per-tx 200000 --period-max-txs 10 --tx-period 86400
+	--msg-filter "/cosmos.bank.v1beta1.MsgSend:to_address=cosmos1skjw...,cosmos1skjw..." --msg-filter "/cosmos.gov.v1beta1.MsgVote"
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -171,6 +177,11 @@ Examples:
 				}
 			}
 
+			grant, err = fieldAllowance(cmd, grant)
+			if err != nil {
+				return err
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granterStr, args[1])
 			if err != nil {
 				return err
@@ -186,10 +197,92 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringArray(FlagMsgFilter, []string{}, "Message field filter, formatted as <msg_type_url>[:<field>=<value>,...], all the messages must match a filter")
+	cmd.Flags().Uint64(FlagMaxGasPerTx, 0, "Maximum gas limit of a transaction using the allowance")
+	cmd.Flags().Uint64(FlagPeriodTxs, 0, "Maximum number of transactions using the allowance in a tx period, or in total if no tx period is set")
+	cmd.Flags().Int64(FlagTxPeriod, 0, "tx period specifies the time duration(in seconds) after which the transaction count is reset (ex: 86400)")
 
 	return cmd
 }
 
+// fieldAllowance wraps the given allowance in a message field allowance when any
+// of the message field flags is set.
+func fieldAllowance(cmd *cobra.Command, grant feegrant.FeeAllowanceI) (feegrant.FeeAllowanceI, error) {
+	filterArgs, err := cmd.Flags().GetStringArray(FlagMsgFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	maxGas, err := cmd.Flags().GetUint64(FlagMaxGasPerTx)
+	if err != nil {
+		return nil, err
+	}
+
+	maxTxs, err := cmd.Flags().GetUint64(FlagPeriodTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	txPeriod, err := cmd.Flags().GetInt64(FlagTxPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filterArgs) == 0 {
+		if maxGas > 0 || maxTxs > 0 || txPeriod > 0 {
+			return nil, fmt.Errorf("--%s is required to limit the gas or transactions of an allowance", FlagMsgFilter)
+		}
+		return grant, nil
+	}
+
+	if _, ok := grant.(*feegrant.AllowedMsgAllowance); ok {
+		return nil, fmt.Errorf("--%s and --%s cannot be used together", FlagAllowedMsgs, FlagMsgFilter)
+	}
+
+	if txPeriod < 0 {
+		return nil, errors.New("tx period cannot be negative")
+	}
+
+	filters := make([]feegrant.MsgFieldFilter, 0, len(filterArgs))
+	for _, arg := range filterArgs {
+		filter, err := parseMsgFieldFilter(arg)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	allowance, err := feegrant.NewMsgFieldAllowance(grant, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance.MaxGasPerTx = maxGas
+	allowance.PeriodMaxTxs = maxTxs
+	allowance.Period = getPeriod(txPeriod)
+
+	return allowance, nil
+}
+
+// parseMsgFieldFilter parses a message field filter formatted as <msg_type_url>[:<field>=<value>,...].
+func parseMsgFieldFilter(arg string) (feegrant.MsgFieldFilter, error) {
+	typeURL, fieldValues, hasField := strings.Cut(arg, ":")
+	filter := feegrant.MsgFieldFilter{MsgTypeUrl: strings.TrimSpace(typeURL)}
+	if hasField {
+		field, values, ok := strings.Cut(fieldValues, "=")
+		if !ok {
+			return filter, fmt.Errorf("invalid message filter %q, expected <msg_type_url>:<field>=<value>,...", arg)
+		}
+
+		filter.Field = strings.TrimSpace(field)
+		for _, value := range strings.Split(values, ",") {
+			filter.AllowedValues = append(filter.AllowedValues, strings.TrimSpace(value))
+		}
+	}
+
+	return filter, filter.ValidateBasic()
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
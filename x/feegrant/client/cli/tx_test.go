@@ -15,6 +15,7 @@ import (
 	v1beta1 "cosmossdk.io/api/cosmos/gov/v1beta1"
 	"cosmossdk.io/core/address"
 	sdkmath "cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/feegrant/client/cli"
 	"cosmossdk.io/x/feegrant/module"
@@ -690,6 +691,77 @@ func (s *CLITestSuite) TestFilteredFeeAllowance() {
 	}
 }
 
+func (s *CLITestSuite) TestMsgFieldFeeAllowance() {
+	granterAddr, err := s.baseCtx.AddressCodec.BytesToString(s.addedGranter)
+	s.Require().NoError(err)
+	granteeAddr := "cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl"
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))).String()),
+		fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "1000stake"),
+	}
+	sendFilter := fmt.Sprintf("--%s=%s:to_address=%s", cli.FlagMsgFilter, sdk.MsgTypeURL(&banktypes.MsgSend{}), granterAddr)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"valid field fee grant",
+			append([]string{
+				granterAddr, granteeAddr, sendFilter,
+				fmt.Sprintf("--%s=%s", cli.FlagMsgFilter, sdk.MsgTypeURL(&v1.MsgVote{})),
+				fmt.Sprintf("--%s=%d", cli.FlagMaxGasPerTx, 200000),
+				fmt.Sprintf("--%s=%d", cli.FlagPeriodTxs, 10),
+				fmt.Sprintf("--%s=%d", cli.FlagTxPeriod, 86400),
+			}, commonFlags...),
+			"",
+		},
+		{
+			"invalid filter",
+			append([]string{
+				granterAddr, granteeAddr,
+				fmt.Sprintf("--%s=%s:to_address", cli.FlagMsgFilter, sdk.MsgTypeURL(&banktypes.MsgSend{})),
+			}, commonFlags...),
+			"invalid message filter",
+		},
+		{
+			"limits without filter",
+			append([]string{
+				granterAddr, granteeAddr,
+				fmt.Sprintf("--%s=%d", cli.FlagMaxGasPerTx, 200000),
+			}, commonFlags...),
+			"--msg-filter is required",
+		},
+		{
+			"filter with allowed messages",
+			append([]string{
+				granterAddr, granteeAddr, sendFilter,
+				fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, sdk.MsgTypeURL(&v1.MsgVote{})),
+			}, commonFlags...),
+			"cannot be used together",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdFeeGrant()
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				msg := &sdk.TxResponse{}
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), msg), out.String())
+			}
+		})
+	}
+}
+
 // msgVote votes for a proposal
 func (s *CLITestSuite) msgVote(clientCtx client.Context, from, id, vote string, extraArgs ...string) error {
 	commonArgs := []string{
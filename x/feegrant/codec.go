@@ -18,6 +18,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance")
 	registrar.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance")
 	registrar.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance")
+	registrar.RegisterConcrete(&MsgFieldAllowance{}, "cosmos-sdk/MsgFieldAllowance")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -33,6 +34,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&MsgFieldAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -219,6 +219,130 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// MsgFieldAllowance creates allowance only for messages matching field filters,
+// with optional limits on the gas of each transaction and on the number of
+// transactions per period.
+type MsgFieldAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// filters are the message field filters. A message is allowed when its type
+	// has at least one filter and it matches all the filters of its type.
+	Filters []MsgFieldFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters"`
+	// max_gas_per_tx is the maximum gas limit of a transaction using the allowance.
+	// If it is zero, there is no gas limit.
+	MaxGasPerTx uint64 `protobuf:"varint,3,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// period_max_txs is the maximum number of transactions using the allowance in
+	// a period. If it is zero, there is no transaction limit.
+	PeriodMaxTxs uint64 `protobuf:"varint,4,opt,name=period_max_txs,json=periodMaxTxs,proto3" json:"period_max_txs,omitempty"`
+	// period specifies the time duration after which the transaction count is reset.
+	// If it is zero, period_max_txs limits the total number of transactions.
+	Period time.Duration `protobuf:"bytes,5,opt,name=period,proto3,stdduration" json:"period"`
+	// period_txs is the number of transactions that used the allowance in the current period.
+	PeriodTxs uint64 `protobuf:"varint,6,opt,name=period_txs,json=periodTxs,proto3" json:"period_txs,omitempty"`
+	// period_reset is the time at which the current period ends and a new one begins.
+	PeriodReset time.Time `protobuf:"bytes,7,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *MsgFieldAllowance) Reset()         { *m = MsgFieldAllowance{} }
+func (m *MsgFieldAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgFieldAllowance) ProtoMessage()    {}
+func (*MsgFieldAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *MsgFieldAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFieldAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFieldAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFieldAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFieldAllowance.Merge(m, src)
+}
+func (m *MsgFieldAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFieldAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFieldAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFieldAllowance proto.InternalMessageInfo
+
+// MsgFieldFilter restricts the messages of a type to the ones whose field has
+// one of the allowed values.
+type MsgFieldFilter struct {
+	// msg_type_url is the type url of the messages the filter applies to.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// field is the name of the checked message field, nested fields being separated
+	// by dots. If it is empty, all the messages of the type are allowed.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// allowed_values are the allowed values of the field. All the values of a
+	// repeated field must be allowed.
+	AllowedValues []string `protobuf:"bytes,3,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+}
+
+func (m *MsgFieldFilter) Reset()         { *m = MsgFieldFilter{} }
+func (m *MsgFieldFilter) String() string { return proto.CompactTextString(m) }
+func (*MsgFieldFilter) ProtoMessage()    {}
+func (*MsgFieldFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *MsgFieldFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFieldFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFieldFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFieldFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFieldFilter.Merge(m, src)
+}
+func (m *MsgFieldFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFieldFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFieldFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFieldFilter proto.InternalMessageInfo
+
+func (m *MsgFieldFilter) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFieldFilter) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MsgFieldFilter) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +357,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +411,8 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*MsgFieldAllowance)(nil), "cosmos.feegrant.v1beta1.MsgFieldAllowance")
+	proto.RegisterType((*MsgFieldFilter)(nil), "cosmos.feegrant.v1beta1.MsgFieldFilter")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,49 +421,61 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0x34, 0x49, 0xab, 0x4c, 0xba, 0x61, 0x6b, 0x82, 0x70, 0x2a, 0x70, 0xa2, 0xc0, 0x42,
+	0xb6, 0x52, 0xec, 0x6d, 0xf7, 0x96, 0xd3, 0xd6, 0x8b, 0x52, 0x40, 0x8d, 0x54, 0x79, 0x03, 0x07,
+	0x24, 0x64, 0x4d, 0xec, 0x89, 0xb1, 0xd6, 0xf6, 0x58, 0x1e, 0xa7, 0x38, 0x57, 0x0e, 0x08, 0xc1,
+	0x81, 0x1e, 0x11, 0x17, 0xf6, 0x88, 0x38, 0xf5, 0xd0, 0x1f, 0xb1, 0xe2, 0x80, 0x56, 0x7b, 0x82,
+	0x0b, 0x45, 0xed, 0xa1, 0x67, 0xfe, 0x01, 0xf2, 0xcc, 0x38, 0x71, 0x93, 0x5d, 0x68, 0x01, 0xf5,
+	0x92, 0x78, 0xde, 0xbc, 0xf7, 0xbe, 0xef, 0x9b, 0xf9, 0x9e, 0x13, 0xf8, 0x8e, 0x45, 0xa8, 0x4f,
+	0xa8, 0x36, 0xc6, 0xd8, 0x89, 0x50, 0x10, 0x6b, 0x87, 0xdb, 0x23, 0x1c, 0xa3, 0xed, 0x59, 0x40,
+	0x0d, 0x23, 0x12, 0x13, 0xe9, 0x75, 0x9e, 0xa7, 0xce, 0xc2, 0x22, 0x6f, 0xb3, 0xee, 0x10, 0x87,
+	0xb0, 0x1c, 0x2d, 0x7d, 0xe2, 0xe9, 0x9b, 0x0d, 0x87, 0x10, 0xc7, 0xc3, 0x1a, 0x5b, 0x8d, 0x26,
+	0x63, 0x0d, 0x05, 0xd3, 0x6c, 0x8b, 0x77, 0x32, 0x79, 0x8d, 0x68, 0xcb, 0xb7, 0x14, 0x41, 0x66,
+	0x84, 0x28, 0x9e, 0x11, 0xb1, 0x88, 0x1b, 0x88, 0xfd, 0x0d, 0xe4, 0xbb, 0x01, 0xd1, 0xd8, 0xa7,
+	0x08, 0x35, 0x17, 0x81, 0x62, 0xd7, 0xc7, 0x34, 0x46, 0x7e, 0x98, 0xf5, 0x5c, 0x4c, 0xb0, 0x27,
+	0x11, 0x8a, 0x5d, 0x22, 0x7a, 0xb6, 0x9f, 0xac, 0xc0, 0x9a, 0x8e, 0xa8, 0x6b, 0xed, 0x7a, 0x1e,
+	0xf9, 0x1c, 0x05, 0x16, 0x96, 0xbe, 0x00, 0xb0, 0x4a, 0x43, 0x1c, 0xd8, 0xa6, 0xe7, 0xfa, 0x6e,
+	0x2c, 0x83, 0x56, 0xb1, 0x53, 0xdd, 0x69, 0xa8, 0x82, 0x6b, 0xca, 0x2e, 0x93, 0xaf, 0x3e, 0x24,
+	0x6e, 0xa0, 0xf7, 0x9f, 0xfe, 0xde, 0x2c, 0xfc, 0x74, 0xda, 0xec, 0x38, 0x6e, 0xfc, 0xd9, 0x64,
+	0xa4, 0x5a, 0xc4, 0x17, 0xc2, 0xc4, 0x57, 0x97, 0xda, 0x8f, 0xb5, 0x78, 0x1a, 0x62, 0xca, 0x0a,
+	0xe8, 0xf7, 0x17, 0xc7, 0x5b, 0xeb, 0x1e, 0x76, 0x90, 0x35, 0x35, 0x53, 0x7d, 0xf4, 0xc7, 0x8b,
+	0xe3, 0x2d, 0x60, 0x40, 0x86, 0xba, 0x9f, 0x82, 0x4a, 0x0f, 0x20, 0xc4, 0x49, 0xe8, 0x72, 0xae,
+	0xf2, 0x4a, 0x0b, 0x74, 0xaa, 0x3b, 0x9b, 0x2a, 0x17, 0xa3, 0x66, 0x62, 0xd4, 0x61, 0xa6, 0x56,
+	0x2f, 0x1d, 0x9d, 0x36, 0x81, 0x91, 0xab, 0xe9, 0xed, 0xfd, 0x7c, 0xd2, 0xbd, 0xf3, 0x92, 0x6b,
+	0x53, 0xfb, 0x18, 0xcf, 0x04, 0x7f, 0xf0, 0xf5, 0xc5, 0xf1, 0x56, 0x23, 0xc7, 0xf4, 0xf2, 0x79,
+	0xb4, 0x7f, 0x2b, 0xc1, 0x8d, 0x03, 0x1c, 0xb9, 0xc4, 0xce, 0x9f, 0xd2, 0xfb, 0xb0, 0x3c, 0x4a,
+	0xf3, 0x64, 0xc0, 0xb8, 0xbd, 0xab, 0xbe, 0x0c, 0xea, 0x72, 0x37, 0xbd, 0x92, 0x1e, 0x16, 0xd7,
+	0xcb, 0x1b, 0x48, 0x0f, 0xe0, 0x6a, 0xc8, 0xda, 0x0b, 0x99, 0x8d, 0x25, 0x99, 0xef, 0x89, 0x3b,
+	0xd3, 0x6f, 0xa5, 0xc5, 0xdf, 0x9d, 0x36, 0x01, 0x6f, 0x20, 0xea, 0xa4, 0x6f, 0x01, 0x94, 0xf8,
+	0xa3, 0x99, 0xbf, 0xb8, 0xe2, 0x4d, 0x5d, 0xdc, 0x6d, 0x0e, 0xfe, 0x68, 0x7e, 0x7d, 0xdf, 0x00,
+	0x28, 0x82, 0xa6, 0x85, 0x02, 0xce, 0x4a, 0x2e, 0xdd, 0x14, 0x9f, 0x1a, 0x87, 0x7e, 0x88, 0x02,
+	0x46, 0x49, 0xda, 0x87, 0xeb, 0x82, 0x4c, 0x84, 0x29, 0x8e, 0xe5, 0xf2, 0x3f, 0xda, 0x89, 0x1d,
+	0xf4, 0xd1, 0xec, 0xa0, 0xab, 0xbc, 0xdc, 0x48, 0xab, 0x7b, 0x1f, 0x5e, 0xcb, 0x58, 0x6f, 0xe4,
+	0x98, 0x2f, 0xb9, 0xa8, 0xfd, 0x27, 0x80, 0xaf, 0xb2, 0x15, 0xb6, 0x07, 0xd4, 0x99, 0xbb, 0xeb,
+	0x53, 0x58, 0x41, 0xd9, 0x42, 0x38, 0xac, 0xbe, 0x44, 0x77, 0x37, 0x98, 0xea, 0x77, 0xaf, 0x4c,
+	0xc6, 0x98, 0x77, 0x94, 0xee, 0xc2, 0xdb, 0x88, 0xa3, 0x9a, 0x3e, 0xa6, 0x14, 0x39, 0x98, 0xca,
+	0x2b, 0xad, 0x62, 0xa7, 0x62, 0xbc, 0x22, 0xe2, 0x03, 0x11, 0xee, 0x1d, 0x7c, 0xf5, 0xa4, 0x59,
+	0xb8, 0x96, 0x62, 0x25, 0xa7, 0xf8, 0x05, 0xda, 0xda, 0x3f, 0x94, 0xe0, 0xc6, 0x80, 0x3a, 0x7d,
+	0x17, 0x7b, 0xf6, 0x8d, 0x29, 0xde, 0x87, 0x6b, 0x63, 0xd7, 0x8b, 0x71, 0xc4, 0x85, 0xfe, 0xdd,
+	0xc0, 0x66, 0xdc, 0xfa, 0x2c, 0x3f, 0x3f, 0xb0, 0x59, 0x0b, 0xe9, 0x2d, 0x58, 0xf3, 0x51, 0x62,
+	0x3a, 0x88, 0x9a, 0x21, 0x8e, 0xcc, 0x38, 0x91, 0x8b, 0x2d, 0xd0, 0x29, 0x19, 0x55, 0x1f, 0x25,
+	0x7b, 0x88, 0x1e, 0xe0, 0x68, 0x98, 0x48, 0x6f, 0x43, 0xe1, 0x43, 0x33, 0xcd, 0x8d, 0x13, 0x2a,
+	0x97, 0x58, 0x92, 0xf0, 0xe2, 0x00, 0x25, 0xc3, 0x84, 0xe6, 0xa6, 0xbf, 0xfc, 0x2f, 0xa7, 0xff,
+	0x4d, 0x08, 0x05, 0x4e, 0x8a, 0xb1, 0xca, 0x30, 0x2a, 0x3c, 0x92, 0x02, 0x2c, 0x9a, 0x7f, 0xed,
+	0x3f, 0x99, 0xdf, 0xba, 0x96, 0x1d, 0x9e, 0x9f, 0x74, 0x37, 0x92, 0xd9, 0x6f, 0x69, 0xeb, 0xf0,
+	0x9e, 0x7a, 0x5f, 0xbd, 0xb7, 0x38, 0x15, 0x4b, 0x5e, 0x68, 0x7f, 0x09, 0x60, 0xed, 0xf2, 0x2d,
+	0x48, 0x2d, 0xb8, 0xee, 0x53, 0xc7, 0x4c, 0x67, 0xdf, 0x9c, 0x44, 0x1e, 0x73, 0x48, 0xc5, 0x80,
+	0x3e, 0x75, 0x86, 0xd3, 0x10, 0x7f, 0x14, 0x79, 0x52, 0x1d, 0x96, 0xc7, 0x69, 0x01, 0x7b, 0x8b,
+	0x56, 0x0c, 0xbe, 0x90, 0xee, 0xc0, 0x5a, 0xe6, 0xf4, 0x43, 0xe4, 0x4d, 0x30, 0x65, 0x6f, 0xc5,
+	0x8a, 0x71, 0x4b, 0x44, 0x3f, 0x66, 0xc1, 0xde, 0x6b, 0x2f, 0x64, 0xda, 0xfe, 0x05, 0xc0, 0xf2,
+	0x5e, 0x1a, 0x90, 0x76, 0xe0, 0x1a, 0xdb, 0xc1, 0x11, 0x87, 0xd6, 0xe5, 0xe7, 0x27, 0xdd, 0xba,
+	0x38, 0x84, 0x5d, 0xdb, 0x8e, 0x30, 0xa5, 0x8f, 0xe2, 0xc8, 0x0d, 0x1c, 0x23, 0x4b, 0x9c, 0xd7,
+	0x60, 0x79, 0xe5, 0x6a, 0x35, 0x0b, 0x63, 0x50, 0xfc, 0xbf, 0xc7, 0x40, 0xdf, 0x7e, 0x7a, 0xa6,
+	0x80, 0x67, 0x67, 0x0a, 0xf8, 0xe3, 0x4c, 0x01, 0x47, 0xe7, 0x4a, 0xe1, 0xd9, 0xb9, 0x52, 0xf8,
+	0xf5, 0x5c, 0x29, 0x7c, 0x22, 0xfe, 0xe1, 0x50, 0xfb, 0xb1, 0xea, 0x12, 0x6d, 0x7e, 0x14, 0xa3,
+	0x55, 0x06, 0x7b, 0xff, 0xaf, 0x01, 0x00, 0x12, 0x50, 0x49, 0x9b, 0x2b, 0x09, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -508,6 +646,132 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFieldAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFieldAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFieldAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	if m.PeriodTxs != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.PeriodTxs))
+		i--
+		dAtA[i] = 0x30
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if m.PeriodMaxTxs != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.PeriodMaxTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFieldFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFieldFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFieldFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -633,6 +897,61 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *MsgFieldAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGasPerTx))
+	}
+	if m.PeriodMaxTxs != 0 {
+		n += 1 + sovFeegrant(uint64(m.PeriodMaxTxs))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if m.PeriodTxs != 0 {
+		n += 1 + sovFeegrant(uint64(m.PeriodTxs))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
+func (m *MsgFieldFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1115,6 +1434,395 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFieldAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFieldAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFieldAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, MsgFieldFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMaxTxs", wireType)
+			}
+			m.PeriodMaxTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodMaxTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTxs", wireType)
+			}
+			m.PeriodTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFieldFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFieldFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFieldFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
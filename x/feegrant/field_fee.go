@@ -0,0 +1,328 @@
+package feegrant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/core/appmodule"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                        = (*MsgFieldAllowance)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*MsgFieldAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *MsgFieldAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewMsgFieldAllowance creates new message field fee allowance.
+func NewMsgFieldAllowance(allowance FeeAllowanceI, filters []MsgFieldFilter) (*MsgFieldAllowance, error) {
+	msg, ok := allowance.(gogoproto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgFieldAllowance{
+		Allowance: any,
+		Filters:   filters,
+	}, nil
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *MsgFieldAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *MsgFieldAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	newAllowance, err := types.NewAnyWithValue(allowance.(gogoproto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	a.Allowance = newAllowance
+
+	return nil
+}
+
+// Accept method checks that the messages match the field filters and that the
+// transaction is within the gas and transaction count limits, before deducting the
+// fee from the wrapped allowance.
+func (a *MsgFieldAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	environment, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return false, errors.New("environment not set")
+	}
+
+	if err := a.checkMsgs(ctx, environment, msgs); err != nil {
+		return false, err
+	}
+
+	if a.MaxGasPerTx > 0 && environment.TransactionService.ExecMode(ctx) != transaction.ExecModeSimulate {
+		if limit := environment.GasService.GasMeter(ctx).Limit(); limit > a.MaxGasPerTx {
+			return false, errorsmod.Wrapf(ErrFeeLimitExceeded, "gas limit %d is greater than the allowed %d", limit, a.MaxGasPerTx)
+		}
+	}
+
+	if a.PeriodMaxTxs > 0 {
+		a.tryResetPeriod(environment.HeaderService.HeaderInfo(ctx).Time)
+		if a.PeriodTxs >= a.PeriodMaxTxs {
+			return false, errorsmod.Wrap(ErrFeeLimitExceeded, "period transaction limit")
+		}
+		a.PeriodTxs++
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// tryResetPeriod resets the transaction count once the PeriodReset has been hit.
+// Like PeriodicAllowance, the next reset steps from the previous one when within one
+// period, and from the block time otherwise. A zero period never resets the count.
+func (a *MsgFieldAllowance) tryResetPeriod(blockTime time.Time) {
+	if a.Period == 0 || blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodTxs = 0
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// checkMsgs checks that all the messages match the filters of their type.
+func (a *MsgFieldAllowance) checkMsgs(ctx context.Context, environment appmodule.Environment, msgs []sdk.Msg) error {
+	gasMeter := environment.GasService.GasMeter(ctx)
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+
+		var (
+			filtered bool
+			refl     protoreflect.Message
+		)
+		for _, filter := range a.Filters {
+			if err := gasMeter.Consume(gasCostPerIteration, "check msg fields"); err != nil {
+				return err
+			}
+			if filter.MsgTypeUrl != typeURL {
+				continue
+			}
+			filtered = true
+
+			if filter.Field == "" {
+				continue
+			}
+
+			if refl == nil {
+				var err error
+				if refl, err = reflectMsg(msg); err != nil {
+					return err
+				}
+			}
+
+			allowed, err := filter.allows(refl)
+			if err != nil {
+				return err
+			}
+			if !allowed {
+				return errorsmod.Wrapf(ErrMessageNotAllowed, "%s field %s is not allowed", typeURL, filter.Field)
+			}
+		}
+
+		if !filtered {
+			return errorsmod.Wrapf(ErrMessageNotAllowed, "%s does not exist in allowed messages", typeURL)
+		}
+	}
+
+	return nil
+}
+
+// reflectMsg returns a reflective view of the given gogoproto message.
+func reflectMsg(msg sdk.Msg) (protoreflect.Message, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(gogoproto.MessageName(msg)))
+	if err != nil {
+		return nil, err
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", desc.FullName())
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	refl := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(bz, refl); err != nil {
+		return nil, err
+	}
+
+	return refl, nil
+}
+
+// allows returns true if all the values of the filtered field of the message are allowed.
+func (f MsgFieldFilter) allows(refl protoreflect.Message) (bool, error) {
+	path := strings.Split(f.Field, ".")
+	for _, name := range path[:len(path)-1] {
+		fd := refl.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return false, errorsmod.Wrapf(ErrMessageNotAllowed, "%s is not a message field of %s", name, refl.Descriptor().FullName())
+		}
+		refl = refl.Get(fd).Message()
+	}
+
+	name := path[len(path)-1]
+	fd := refl.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || fd.Message() != nil || fd.IsMap() {
+		return false, errorsmod.Wrapf(ErrMessageNotAllowed, "%s is not a scalar field of %s", name, refl.Descriptor().FullName())
+	}
+
+	value := refl.Get(fd)
+	if !fd.IsList() {
+		return f.isAllowed(fieldValueString(fd, value)), nil
+	}
+
+	list := value.List()
+	for i := 0; i < list.Len(); i++ {
+		if !f.isAllowed(fieldValueString(fd, list.Get(i))) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (f MsgFieldFilter) isAllowed(value string) bool {
+	for _, allowed := range f.AllowedValues {
+		if allowed == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldValueString formats a scalar field value, enums being formatted by name.
+func fieldValueString(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	if fd.Kind() == protoreflect.EnumKind {
+		if ev := fd.Enum().Values().ByNumber(value.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+	}
+
+	return value.String()
+}
+
+// ValidateBasic performs basic validation of the message field filter.
+func (f MsgFieldFilter) ValidateBasic() error {
+	if strings.TrimSpace(f.MsgTypeUrl) == "" {
+		return errorsmod.Wrap(ErrNoMessages, "filter message type url shouldn't be empty")
+	}
+
+	if f.Field == "" {
+		if len(f.AllowedValues) > 0 {
+			return errorsmod.Wrapf(ErrNoMessages, "filter of %s has allowed values but no field", f.MsgTypeUrl)
+		}
+		return nil
+	}
+
+	for _, name := range strings.Split(f.Field, ".") {
+		if !protoreflect.Name(name).IsValid() {
+			return errorsmod.Wrapf(ErrNoMessages, "invalid field %q in filter of %s", f.Field, f.MsgTypeUrl)
+		}
+	}
+	if len(f.AllowedValues) == 0 {
+		return errorsmod.Wrapf(ErrNoMessages, "filter of %s field %s has no allowed values", f.MsgTypeUrl, f.Field)
+	}
+
+	return nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *MsgFieldAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.Filters) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "message filters shouldn't be empty")
+	}
+	for _, filter := range a.Filters {
+		if err := filter.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if a.Period < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "negative clock step")
+	}
+	if a.PeriodMaxTxs > 0 && a.PeriodTxs > a.PeriodMaxTxs {
+		return errorsmod.Wrapf(ErrFeeLimitExceeded, "period transactions %d are greater than the period limit %d", a.PeriodTxs, a.PeriodMaxTxs)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the MsgFieldAllowance.
+func (a *MsgFieldAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// UpdatePeriodReset update "PeriodReset" of the MsgFieldAllowance and of the wrapped allowance.
+func (a *MsgFieldAllowance) UpdatePeriodReset(validTime time.Time) error {
+	a.PeriodReset = validTime.Add(a.Period)
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	return allowance.UpdatePeriodReset(validTime)
+}
@@ -0,0 +1,225 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/transaction"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/module"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMsgFieldAllowanceAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	now := time.Now()
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: now})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	sendToDapp := &banktypes.MsgSend{FromAddress: "grantee", ToAddress: "dapp"}
+	sendToOther := &banktypes.MsgSend{FromAddress: "grantee", ToAddress: "other"}
+	multiSend := &banktypes.MsgMultiSend{}
+
+	cases := map[string]struct {
+		filters     []feegrant.MsgFieldFilter
+		maxGas      uint64
+		gasLimit    uint64
+		execMode    transaction.ExecMode
+		msgs        []sdk.Msg
+		expectedErr string
+	}{
+		"allowed field value": {
+			filters: []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL, Field: "to_address", AllowedValues: []string{"dapp"}}},
+			msgs:    []sdk.Msg{sendToDapp},
+		},
+		"disallowed field value": {
+			filters:     []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL, Field: "to_address", AllowedValues: []string{"dapp"}}},
+			msgs:        []sdk.Msg{sendToDapp, sendToOther},
+			expectedErr: "field to_address is not allowed",
+		},
+		"all filters of a type must match": {
+			filters: []feegrant.MsgFieldFilter{
+				{MsgTypeUrl: sendTypeURL, Field: "to_address", AllowedValues: []string{"dapp"}},
+				{MsgTypeUrl: sendTypeURL, Field: "from_address", AllowedValues: []string{"someone"}},
+			},
+			msgs:        []sdk.Msg{sendToDapp},
+			expectedErr: "field from_address is not allowed",
+		},
+		"type without field filter": {
+			filters: []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL}},
+			msgs:    []sdk.Msg{sendToOther},
+		},
+		"unfiltered type": {
+			filters:     []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL}},
+			msgs:        []sdk.Msg{multiSend},
+			expectedErr: "does not exist in allowed messages",
+		},
+		"unknown field": {
+			filters:     []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL, Field: "recipient", AllowedValues: []string{"dapp"}}},
+			msgs:        []sdk.Msg{sendToDapp},
+			expectedErr: "recipient is not a scalar field",
+		},
+		"gas within limit": {
+			filters:  []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL}},
+			maxGas:   200000,
+			gasLimit: 100000,
+			msgs:     []sdk.Msg{sendToDapp},
+		},
+		"gas over limit": {
+			filters:     []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL}},
+			maxGas:      200000,
+			gasLimit:    300000,
+			msgs:        []sdk.Msg{sendToDapp},
+			expectedErr: "gas limit 300000 is greater than the allowed 200000",
+		},
+		"gas not limited in simulation": {
+			filters:  []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL}},
+			maxGas:   200000,
+			gasLimit: 300000,
+			execMode: transaction.ExecModeSimulate,
+			msgs:     []sdk.Msg{sendToDapp},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewMsgFieldAllowance(&feegrant.BasicAllowance{SpendLimit: atom}, tc.filters)
+			require.NoError(t, err)
+			allowance.MaxGasPerTx = tc.maxGas
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+				HeaderService:      mockHeaderService{},
+				GasService:         mockLimitGasService{limit: tc.gasLimit},
+				TransactionService: mockTransactionService{execMode: tc.execMode},
+			}), smallAtom, tc.msgs)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+
+			basic, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, atom.Sub(smallAtom...), basic.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestMsgFieldAllowancePeriodMaxTxs(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{})
+	now := time.Now().UTC()
+
+	allowance, err := feegrant.NewMsgFieldAllowance(&feegrant.BasicAllowance{}, []feegrant.MsgFieldFilter{
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+	})
+	require.NoError(t, err)
+	allowance.PeriodMaxTxs = 2
+	allowance.Period = time.Hour
+	require.NoError(t, allowance.UpdatePeriodReset(now))
+
+	accept := func(blockTime time.Time) error {
+		ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: blockTime})
+		_, err := allowance.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+			HeaderService: mockHeaderService{},
+			GasService:    mockGasService{},
+		}), nil, []sdk.Msg{&banktypes.MsgSend{}})
+		if err != nil {
+			return err
+		}
+
+		// mimic the save & load process of the keeper
+		grant, err := feegrant.NewGrant("granter", "grantee", allowance)
+		require.NoError(t, err)
+		bz, err := encCfg.Codec.Marshal(&grant)
+		require.NoError(t, err)
+		var loaded feegrant.Grant
+		require.NoError(t, encCfg.Codec.Unmarshal(bz, &loaded))
+		loadedAllowance, err := loaded.GetGrant()
+		require.NoError(t, err)
+		allowance = loadedAllowance.(*feegrant.MsgFieldAllowance)
+		return nil
+	}
+
+	require.NoError(t, accept(now))
+	require.NoError(t, accept(now.Add(time.Minute)))
+	require.ErrorContains(t, accept(now.Add(2*time.Minute)), "period transaction limit")
+	require.Equal(t, uint64(2), allowance.PeriodTxs)
+
+	// the transaction count is reset after the period
+	require.NoError(t, accept(now.Add(time.Hour)))
+	require.Equal(t, uint64(1), allowance.PeriodTxs)
+	require.Equal(t, now.Add(2*time.Hour), allowance.PeriodReset)
+}
+
+func TestMsgFieldAllowanceValidateBasic(t *testing.T) {
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := map[string]struct {
+		filters     []feegrant.MsgFieldFilter
+		period      time.Duration
+		expectedErr string
+	}{
+		"valid": {
+			filters: []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL, Field: "to_address", AllowedValues: []string{"dapp"}}},
+		},
+		"no filters": {
+			expectedErr: "message filters shouldn't be empty",
+		},
+		"empty type url": {
+			filters:     []feegrant.MsgFieldFilter{{Field: "to_address", AllowedValues: []string{"dapp"}}},
+			expectedErr: "type url shouldn't be empty",
+		},
+		"field without values": {
+			filters:     []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL, Field: "to_address"}},
+			expectedErr: "has no allowed values",
+		},
+		"values without field": {
+			filters:     []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL, AllowedValues: []string{"dapp"}}},
+			expectedErr: "has allowed values but no field",
+		},
+		"invalid field": {
+			filters:     []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL, Field: "to..address", AllowedValues: []string{"dapp"}}},
+			expectedErr: "invalid field",
+		},
+		"negative period": {
+			filters:     []feegrant.MsgFieldFilter{{MsgTypeUrl: sendTypeURL}},
+			period:      -time.Hour,
+			expectedErr: "negative clock step",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewMsgFieldAllowance(&feegrant.BasicAllowance{}, tc.filters)
+			require.NoError(t, err)
+			allowance.Period = tc.period
+
+			err = allowance.ValidateBasic()
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
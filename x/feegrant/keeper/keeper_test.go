@@ -9,6 +9,7 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/feegrant/keeper"
 	"cosmossdk.io/x/feegrant/module"
@@ -291,6 +292,45 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestUseGrantedFeeMsgField() {
+	allowance, err := feegrant.NewMsgFieldAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, []feegrant.MsgFieldFilter{
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Field: "to_address", AllowedValues: []string{suite.encodedAddrs[3]}},
+	})
+	suite.Require().NoError(err)
+	allowance.PeriodMaxTxs = 1
+
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[2], allowance)
+	suite.Require().NoError(err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	sendToOther := &banktypes.MsgSend{FromAddress: suite.encodedAddrs[2], ToAddress: suite.encodedAddrs[4]}
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[2], fee, []sdk.Msg{sendToOther})
+	suite.Require().ErrorIs(err, feegrant.ErrMessageNotAllowed)
+
+	sendToDapp := &banktypes.MsgSend{FromAddress: suite.encodedAddrs[2], ToAddress: suite.encodedAddrs[3]}
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[2], fee, []sdk.Msg{sendToDapp})
+	suite.Require().NoError(err)
+
+	// the updated allowance is stored
+	loaded, err := suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), loaded.(*feegrant.MsgFieldAllowance).PeriodTxs)
+
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[2], fee, []sdk.Msg{sendToDapp})
+	suite.Require().ErrorContains(err, "period transaction limit")
+
+	// the allowance survives a genesis export and import
+	genesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(feegrant.ValidateGenesis(*genesis))
+	suite.SetupTest()
+	suite.Require().NoError(suite.feegrantKeeper.InitGenesis(suite.ctx, genesis))
+	imported, err := suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().NoError(err)
+	suite.Require().Equal(loaded.(*feegrant.MsgFieldAllowance).Filters, imported.(*feegrant.MsgFieldAllowance).Filters)
+	suite.Require().Equal(uint64(1), imported.(*feegrant.MsgFieldAllowance).PeriodTxs)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
@@ -5,6 +5,7 @@ import (
 
 	coregas "cosmossdk.io/core/gas"
 	coreheader "cosmossdk.io/core/header"
+	"cosmossdk.io/core/transaction"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -30,3 +31,29 @@ type mockGasMeter struct {
 func (m mockGasMeter) Consume(_ coregas.Gas, _ string) error {
 	return nil
 }
+
+type mockLimitGasService struct {
+	coregas.Service
+	limit coregas.Gas
+}
+
+func (m mockLimitGasService) GasMeter(_ context.Context) coregas.Meter {
+	return mockLimitGasMeter{limit: m.limit}
+}
+
+type mockLimitGasMeter struct {
+	mockGasMeter
+	limit coregas.Gas
+}
+
+func (m mockLimitGasMeter) Limit() coregas.Gas {
+	return m.limit
+}
+
+type mockTransactionService struct {
+	execMode transaction.ExecMode
+}
+
+func (m mockTransactionService) ExecMode(_ context.Context) transaction.ExecMode {
+	return m.execMode
+}
@@ -0,0 +1,57 @@
+package feemarket
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	feemarketv1 "cosmossdk.io/api/cosmos/feemarket/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: feemarketv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current fee market parameters",
+				},
+				{
+					RpcMethod: "State",
+					Use:       "state",
+					Short:     "Query the current fee market state",
+				},
+				{
+					RpcMethod: "BaseFee",
+					Use:       "base-fee",
+					Short:     "Query the base fee per unit of gas of the next block",
+				},
+				{
+					RpcMethod:      "EstimateFee",
+					Use:            "estimate-fee [gas-limit]",
+					Short:          "Estimate the fee of a transaction with the given gas limit",
+					Long:           "Estimate the fee of a transaction with the given gas limit, paying the given tip per unit of gas, to be included in one of the next blocks given by the --blocks flag.",
+					Example:        fmt.Sprintf(`%s query feemarket estimate-fee 200000 --tip 0.001 --blocks 3`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gas_limit"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: feemarketv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal <params>",
+					Short:          "Submit a proposal to update feemarket module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update feemarket module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query feemarket params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx feemarket update-params-proposal '{ "fee_denom": "stake", ... }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package feemarket
+
+import (
+	modulev1 "cosmossdk.io/api/cosmos/feemarket/module/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/x/feemarket/keeper"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config      *modulev1.Module
+	Environment appmodule.Environment
+	Cdc         codec.Codec
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+	PoolKeeper    types.PoolKeeper `optional:"true"`
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	FeeMarketKeeper *keeper.Keeper
+	Module          appmodule.AppModule
+	// TxFeeChecker enforces the base fee in the fee deduction of x/validate.
+	TxFeeChecker ante.TxFeeChecker
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	feeCollectorName := in.Config.FeeCollectorName
+	if feeCollectorName == "" {
+		feeCollectorName = authtypes.FeeCollectorName
+	}
+
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	as, err := in.AccountKeeper.AddressCodec().BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc,
+		in.Environment,
+		in.AccountKeeper,
+		in.BankKeeper,
+		in.PoolKeeper,
+		feeCollectorName,
+		as,
+	)
+
+	m := NewAppModule(in.Cdc, k)
+
+	return ModuleOutputs{FeeMarketKeeper: k, Module: m, TxFeeChecker: k.CheckTxFee}
+}
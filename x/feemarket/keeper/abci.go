@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/gas"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// blockGasService is implemented by the gas services tracking the gas consumed
+// by the transactions of the block, as the ones of baseapp and server/v2.
+type blockGasService interface {
+	BlockGasMeter(context.Context) gas.Meter
+}
+
+// EndBlocker burns or sends to the community pool the base fees paid by the
+// transactions of the block, and sets the base fee of the next block from the
+// gas consumed by the transactions of the block.
+func (k *Keeper) EndBlocker(ctx context.Context) error {
+	start := telemetry.Now()
+	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
+
+	gasService, ok := k.GasService.(blockGasService)
+	if !ok {
+		return types.ErrNoBlockGasMeter
+	}
+	blockGas := gasService.BlockGasMeter(ctx).Consumed()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !state.BlockBaseFees.IsZero() {
+		switch params.BaseFeeDestination {
+		case types.DestinationProtocolPool:
+			if k.poolKeeper == nil {
+				return types.ErrNoProtocolPool
+			}
+			if err := k.poolKeeper.FundCommunityPool(ctx, state.BlockBaseFees, k.accountKeeper.GetModuleAddress(k.feeCollectorName)); err != nil {
+				return errorsmod.Wrap(err, "failed to fund the community pool with the base fees")
+			}
+		default:
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, state.BlockBaseFees); err != nil {
+				return errorsmod.Wrap(err, "failed to collect the base fees")
+			}
+			if err := k.bankKeeper.BurnCoins(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), state.BlockBaseFees); err != nil {
+				return errorsmod.Wrap(err, "failed to burn the base fees")
+			}
+		}
+	}
+
+	next := types.NewState(params.NextBaseFee(state.BaseFee, blockGas))
+	if err := k.State.Set(ctx, next); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBaseFee,
+		event.NewAttribute(types.AttributeKeyBaseFee, next.BaseFee.String()),
+		event.NewAttribute(types.AttributeKeyBlockGas, strconv.FormatUint(blockGas, 10)),
+		event.NewAttribute(types.AttributeKeyBlockBaseFees, state.BlockBaseFees.String()),
+	)
+}
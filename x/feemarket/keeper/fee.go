@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// priorityScale is the scale of the tx priority, the priority tip per unit of
+// gas in millionths of the fee denom, so that the tips of fee denoms with a
+// small unit, whose gas prices are fractional, still order transactions.
+const priorityScale = 1_000_000
+
+// CheckTxFee implements the x/auth/ante TxFeeChecker of the fee market. It
+// checks that the fee of the transaction pays the base fee for its gas limit,
+// and returns the priority of the transaction, the part of its gas price above
+// the base fee, or priority tip. The whole fee is deducted.
+//
+// When the transaction is included in a block, its base fee is added to the
+// ones of the block, to burn or route them at the end of the block.
+// The fees of the genesis transactions are not checked.
+func (k *Keeper) CheckTxFee(ctx context.Context, tx transaction.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	if k.HeaderService.HeaderInfo(ctx).Height == 0 {
+		return fee, 0, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+	gasLimit := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gas))
+	baseFee := sdk.NewCoin(params.FeeDenom, state.BaseFee.Mul(gasLimit).Ceil().TruncateInt())
+
+	paid := fee.AmountOf(params.FeeDenom)
+	if paid.LT(baseFee.Amount) {
+		return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, baseFee)
+	}
+
+	if k.TransactionService.ExecMode(ctx) == transaction.ExecModeFinalize {
+		state.BlockBaseFees = state.BlockBaseFees.Add(baseFee)
+		if err := k.State.Set(ctx, state); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	tip := sdkmath.LegacyNewDecFromInt(paid).Quo(gasLimit).Sub(state.BaseFee).MulInt64(priorityScale)
+	priority := int64(math.MaxInt64)
+	if tipInt := tip.TruncateInt(); tipInt.IsInt64() {
+		priority = tipInt.Int64()
+	}
+
+	return fee, priority, nil
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxEstimateFeeBlocks is the maximum number of blocks of a fee estimation.
+const MaxEstimateFeeBlocks = 100
+
+var _ types.QueryServer = queryServer{}
+
+func NewQueryServerImpl(k *Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k *Keeper
+}
+
+// Params returns params of the feemarket module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// State returns the state of the feemarket module.
+func (q queryServer) State(ctx context.Context, _ *types.QueryStateRequest) (*types.QueryStateResponse, error) {
+	state, err := q.k.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStateResponse{State: state}, nil
+}
+
+// BaseFee returns the base fee per unit of gas of the next block.
+func (q queryServer) BaseFee(ctx context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	baseFee, err := q.k.BaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBaseFeeResponse{BaseFee: baseFee}, nil
+}
+
+// EstimateFee returns the fee a transaction with the given gas limit must pay
+// to be included in any of the requested next blocks, with the requested tip.
+func (q queryServer) EstimateFee(ctx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.GasLimit == 0 {
+		return nil, status.Error(codes.InvalidArgument, "gas limit must be positive")
+	}
+
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = 1
+	}
+	if blocks > MaxEstimateFeeBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "blocks must be between 1 and %d", MaxEstimateFeeBlocks)
+	}
+
+	tip := req.Tip
+	if tip.IsNil() {
+		tip = math.LegacyZeroDec()
+	}
+	if tip.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "tip cannot be negative")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := q.k.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the base fee of the next block is known, the following ones can increase
+	// by at most the max change rate at each block.
+	baseFee := params.MaxBaseFee(state.BaseFee, uint64(blocks-1))
+	gasPrice := baseFee.Add(tip)
+	fee := gasPrice.MulInt(math.NewIntFromUint64(req.GasLimit)).Ceil().TruncateInt()
+
+	return &types.QueryEstimateFeeResponse{
+		BaseFee:  sdk.NewDecCoinFromDec(params.FeeDenom, baseFee),
+		GasPrice: sdk.NewDecCoinFromDec(params.FeeDenom, gasPrice),
+		Fee:      sdk.NewCoin(params.FeeDenom, fee),
+	}, nil
+}
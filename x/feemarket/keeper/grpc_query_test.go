@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestBaseFee() {
+	res, err := s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(1, 1)), res.BaseFee)
+}
+
+func (s *KeeperTestSuite) TestEstimateFee() {
+	testCases := []struct {
+		name     string
+		req      *types.QueryEstimateFeeRequest
+		baseFee  string
+		gasPrice string
+		fee      int64
+		expErr   string
+	}{
+		{
+			name:     "next block",
+			req:      &types.QueryEstimateFeeRequest{GasLimit: 100_001},
+			baseFee:  "0.1",
+			gasPrice: "0.1",
+			fee:      10_001,
+		},
+		{
+			name:     "with tip",
+			req:      &types.QueryEstimateFeeRequest{GasLimit: 100_000, Tip: math.LegacyNewDecWithPrec(1, 2)},
+			baseFee:  "0.1",
+			gasPrice: "0.11",
+			fee:      11_000,
+		},
+		{
+			name:     "three blocks",
+			req:      &types.QueryEstimateFeeRequest{GasLimit: 100_000, Blocks: 3},
+			baseFee:  "0.12656250",
+			gasPrice: "0.12656250",
+			fee:      12_657,
+		},
+		{
+			name:   "zero gas limit",
+			req:    &types.QueryEstimateFeeRequest{},
+			expErr: "gas limit must be positive",
+		},
+		{
+			name:   "too many blocks",
+			req:    &types.QueryEstimateFeeRequest{GasLimit: 100_000, Blocks: 101},
+			expErr: "blocks must be between 1 and 100",
+		},
+		{
+			name:   "negative tip",
+			req:    &types.QueryEstimateFeeRequest{GasLimit: 100_000, Tip: math.LegacyNewDec(-1)},
+			expErr: "tip cannot be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.queryServer.EstimateFee(s.ctx, tc.req)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr(tc.baseFee)), res.BaseFee)
+			s.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr(tc.gasPrice)), res.GasPrice)
+			s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.fee), res.Fee)
+		})
+	}
+}
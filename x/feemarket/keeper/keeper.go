@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	appmodule.Environment
+
+	cdc              codec.BinaryCodec
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	poolKeeper       types.PoolKeeper
+	feeCollectorName string
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	State  collections.Item[types.State]
+}
+
+// NewKeeper creates a new feemarket Keeper instance. The pool keeper can be
+// nil, in which case the base fees cannot be sent to the community pool.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	pk types.PoolKeeper,
+	feeCollectorName string,
+	authority string,
+) *Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		Environment:      env,
+		cdc:              cdc,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		poolKeeper:       pk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		State:            collections.NewItem(sb, types.StateKey, "state", codec.CollValue[types.State](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return &k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// BaseFee returns the base fee per unit of gas of the current block.
+func (k *Keeper) BaseFee(ctx context.Context) (sdk.DecCoin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(params.FeeDenom, state.BaseFee), nil
+}
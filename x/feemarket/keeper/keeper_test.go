@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/keeper"
+	feemarkettestutil "cosmossdk.io/x/feemarket/testutil"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+var (
+	feeCollectorAddr = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	moduleAddr       = authtypes.NewModuleAddress(types.ModuleName)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx             sdk.Context
+	txConfig        client.TxConfig
+	feeMarketKeeper *keeper.Keeper
+	queryServer     types.QueryServer
+	msgServer       types.MsgServer
+	accountKeeper   *feemarkettestutil.MockAccountKeeper
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+	poolKeeper      *feemarkettestutil.MockPoolKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+	s.txConfig = encCfg.TxConfig
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = feemarkettestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+	s.poolKeeper = feemarkettestutil.NewMockPoolKeeper(ctrl)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollectorAddr).AnyTimes()
+
+	s.feeMarketKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		s.accountKeeper,
+		s.bankKeeper,
+		s.poolKeeper,
+		authtypes.FeeCollectorName,
+		govModuleNameStr,
+	)
+
+	params := types.DefaultParams()
+	params.MinBaseFee = math.LegacyNewDecWithPrec(1, 2)
+	params.TargetBlockGas = 1_000_000
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.State.Set(s.ctx, types.NewState(math.LegacyNewDecWithPrec(1, 1))))
+
+	s.queryServer = keeper.NewQueryServerImpl(s.feeMarketKeeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.feeMarketKeeper)
+}
+
+func (s *KeeperTestSuite) newTx(gas uint64, fee sdk.Coins) sdk.Tx {
+	txBuilder := s.txConfig.NewTxBuilder()
+	txBuilder.SetGasLimit(gas)
+	txBuilder.SetFeeAmount(fee)
+	return txBuilder.GetTx()
+}
+
+func (s *KeeperTestSuite) TestCheckTxFee() {
+	// the base fee is 0.1stake per unit of gas
+	testCases := []struct {
+		name     string
+		gas      uint64
+		fee      sdk.Coins
+		priority int64
+		expErr   error
+	}{
+		{
+			name:     "exact base fee",
+			gas:      100_000,
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000)),
+			priority: 0,
+		},
+		{
+			name:     "with tip",
+			gas:      100_000,
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_005)),
+			priority: 50,
+		},
+		{
+			name:   "insufficient fee",
+			gas:    100_000,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 9_999)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:   "zero gas",
+			gas:    0,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000)),
+			expErr: sdkerrors.ErrInvalidGasLimit,
+		},
+		{
+			name:   "wrong denom",
+			gas:    100_000,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 10_000)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithExecMode(sdk.ExecModeCheck)
+			fee, priority, err := s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(tc.gas, tc.fee))
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.fee, fee)
+			s.Require().Equal(tc.priority, priority)
+		})
+	}
+
+	// checked transactions are not added to the block
+	state, err := s.feeMarketKeeper.State.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(state.BlockBaseFees.IsZero())
+}
+
+func (s *KeeperTestSuite) TestCheckTxFeeFinalize() {
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+	_, _, err := s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(100_000, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20_000))))
+	s.Require().NoError(err)
+	_, _, err = s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(50_001, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 6_000))))
+	s.Require().NoError(err)
+
+	// the base fees are rounded up, the tips are not part of them
+	state, err := s.feeMarketKeeper.State.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15_001)), state.BlockBaseFees)
+
+	// the fees of genesis transactions are not checked
+	_, _, err = s.feeMarketKeeper.CheckTxFee(ctx.WithHeaderInfo(header.Info{}), s.newTx(100_000, nil))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize).WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200_000))
+
+	// a block consuming 1.5 times the target gas increases the base fee by half
+	// the max change rate, whatever the gas limits of its transactions
+	_, _, err := s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(2_000_000, fee))
+	s.Require().NoError(err)
+	ctx.BlockGasMeter().ConsumeGas(1_500_000, "block gas")
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(ctx, []byte(moduleAddr), fee).Return(nil)
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(ctx))
+
+	state, err := s.feeMarketKeeper.State.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.10625"), state.BaseFee)
+	s.Require().True(state.BlockBaseFees.IsZero())
+
+	// the base fees can fund the community pool
+	params, err := s.feeMarketKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.BaseFeeDestination = types.DestinationProtocolPool
+	s.Require().NoError(s.feeMarketKeeper.Params.Set(ctx, params))
+
+	ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	_, _, err = s.feeMarketKeeper.CheckTxFee(ctx, s.newTx(1_000_000, fee))
+	s.Require().NoError(err)
+	ctx.BlockGasMeter().ConsumeGas(1_000_000, "block gas")
+
+	baseFees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 106_250))
+	s.poolKeeper.EXPECT().FundCommunityPool(ctx, baseFees, []byte(feeCollectorAddr)).Return(nil)
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(ctx))
+
+	// the base fee stays the same at the target
+	state, err = s.feeMarketKeeper.State.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.10625"), state.BaseFee)
+	s.Require().True(state.BlockBaseFees.IsZero())
+
+	// an empty block decreases the base fee by the max change rate, without sending any base fee
+	ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(ctx))
+	state, err = s.feeMarketKeeper.State.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.09296875"), state.BaseFee)
+}
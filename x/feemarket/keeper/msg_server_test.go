@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+				Params:    types.DefaultParams(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params: types.Params{
+					FeeDenom:           "stake",
+					MinBaseFee:         types.DefaultParams().MinBaseFee,
+					TargetBlockGas:     0,
+					MaxChangeRate:      types.DefaultParams().MaxChangeRate,
+					BaseFeeDestination: types.DestinationBurn,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params:    types.DefaultParams(),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidSigner   = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrNoProtocolPool  = errors.Register(ModuleName, 2, "no protocol pool keeper to fund the community pool with the base fees")
+	ErrNoBlockGasMeter = errors.Register(ModuleName, 3, "the gas service does not provide the block gas meter")
+)
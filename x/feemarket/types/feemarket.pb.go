@@ -0,0 +1,792 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1/feemarket.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BaseFeeDestination defines where the base fees paid by the transactions of
+// a block are sent.
+type BaseFeeDestination int32
+
+const (
+	// UNSPECIFIED defines an invalid destination.
+	DestinationUnspecified BaseFeeDestination = 0
+	// BURN defines that the base fees are burned.
+	DestinationBurn BaseFeeDestination = 1
+	// PROTOCOL_POOL defines that the base fees fund the community pool of the
+	// x/protocolpool module.
+	DestinationProtocolPool BaseFeeDestination = 2
+)
+
+var BaseFeeDestination_name = map[int32]string{
+	0: "BASE_FEE_DESTINATION_UNSPECIFIED",
+	1: "BASE_FEE_DESTINATION_BURN",
+	2: "BASE_FEE_DESTINATION_PROTOCOL_POOL",
+}
+
+var BaseFeeDestination_value = map[string]int32{
+	"BASE_FEE_DESTINATION_UNSPECIFIED":   0,
+	"BASE_FEE_DESTINATION_BURN":          1,
+	"BASE_FEE_DESTINATION_PROTOCOL_POOL": 2,
+}
+
+func (x BaseFeeDestination) String() string {
+	return proto.EnumName(BaseFeeDestination_name, int32(x))
+}
+
+func (BaseFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{0}
+}
+
+// Params defines the parameters for the x/feemarket module.
+type Params struct {
+	// fee_denom is the denom in which the base fee is paid.
+	FeeDenom string `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// min_base_fee is the minimum base fee per unit of gas. A zero minimum base
+	// fee lets the base fee decrease to zero, where it stays.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// target_block_gas is the gas of a block at which the base fee stays the
+	// same. The gas of a block is the gas consumed by its transactions.
+	TargetBlockGas uint64 `protobuf:"varint,3,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// max_change_rate is the maximum change rate of the base fee from a block to
+	// the next, reached when the gas of the block is zero or twice the target.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// base_fee_destination defines where the base fees are sent.
+	BaseFeeDestination BaseFeeDestination `protobuf:"varint,5,opt,name=base_fee_destination,json=baseFeeDestination,proto3,enum=cosmos.feemarket.v1.BaseFeeDestination" json:"base_fee_destination,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeDestination() BaseFeeDestination {
+	if m != nil {
+		return m.BaseFeeDestination
+	}
+	return DestinationUnspecified
+}
+
+// State represents the fee market state.
+type State struct {
+	// base_fee is the base fee per unit of gas of the current block.
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// block_base_fees are the base fees paid so far by the transactions of the
+	// current block.
+	BlockBaseFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=block_base_fees,json=blockBaseFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_base_fees"`
+}
+
+func (m *State) Reset()         { *m = State{} }
+func (m *State) String() string { return proto.CompactTextString(m) }
+func (*State) ProtoMessage()    {}
+func (*State) Descriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{1}
+}
+func (m *State) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_State.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *State) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_State.Merge(m, src)
+}
+func (m *State) XXX_Size() int {
+	return m.Size()
+}
+func (m *State) XXX_DiscardUnknown() {
+	xxx_messageInfo_State.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_State proto.InternalMessageInfo
+
+func (m *State) GetBlockBaseFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockBaseFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.feemarket.v1.BaseFeeDestination", BaseFeeDestination_name, BaseFeeDestination_value)
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1.Params")
+	proto.RegisterType((*State)(nil), "cosmos.feemarket.v1.State")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1/feemarket.proto", fileDescriptor_481036a621b23787)
+}
+
+var fileDescriptor_481036a621b23787 = []byte{
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xd0, 0xc2, 0x0f, 0xe6, 0x87, 0x50, 0x07, 0xa2, 0x65, 0x89, 0xcb, 0xa6, 0x1e, 0x6c,
+	0x9a, 0xb0, 0x9b, 0x62, 0x62, 0x8c, 0x27, 0xd9, 0xfe, 0x31, 0x35, 0xa4, 0xad, 0x5b, 0x48, 0xd4,
+	0x83, 0x93, 0xe9, 0xf6, 0xed, 0xb2, 0x69, 0x77, 0x87, 0x74, 0x06, 0x02, 0xdf, 0x00, 0x7b, 0xf2,
+	0x6c, 0xc2, 0xc9, 0x8b, 0xf1, 0xc4, 0xc1, 0x0f, 0xc1, 0x91, 0x98, 0x98, 0x18, 0x0f, 0x68, 0xe0,
+	0xc0, 0x47, 0xf0, 0x6a, 0xf6, 0x0f, 0xb0, 0x06, 0x6e, 0x5c, 0x36, 0x3b, 0xef, 0xf3, 0x3e, 0xef,
+	0x9f, 0x67, 0x9e, 0xc1, 0x0f, 0x6d, 0x2e, 0x3c, 0x2e, 0x8c, 0x1e, 0x80, 0xc7, 0x86, 0x7d, 0x90,
+	0xc6, 0x4e, 0xe9, 0xea, 0xa0, 0x6f, 0x0d, 0xb9, 0xe4, 0x64, 0x2e, 0x4a, 0xd2, 0xaf, 0xe2, 0x3b,
+	0x25, 0x65, 0xde, 0xe1, 0x0e, 0x0f, 0x71, 0x23, 0xf8, 0x8b, 0x52, 0x95, 0x85, 0x28, 0x95, 0x46,
+	0x40, 0xcc, 0x8b, 0x20, 0x35, 0x6e, 0xd5, 0x61, 0x02, 0x8c, 0x9d, 0x52, 0x07, 0x24, 0x2b, 0x19,
+	0x36, 0x77, 0xfd, 0x18, 0xbf, 0xcb, 0x3c, 0xd7, 0xe7, 0x46, 0xf8, 0x8d, 0x42, 0xf9, 0xfd, 0x34,
+	0x9e, 0x68, 0xb1, 0x21, 0xf3, 0x04, 0x59, 0xc4, 0x53, 0x3d, 0x00, 0xda, 0x05, 0x9f, 0x7b, 0x39,
+	0xa4, 0xa1, 0xc2, 0x94, 0x35, 0xd9, 0x03, 0xa8, 0x04, 0x67, 0xf2, 0x1a, 0x4f, 0x7b, 0xae, 0x4f,
+	0x83, 0xca, 0xb4, 0x07, 0x90, 0x1b, 0x0b, 0x70, 0xf3, 0xc9, 0xd1, 0xc9, 0x52, 0xea, 0xe7, 0xc9,
+	0xd2, 0x62, 0xd4, 0x58, 0x74, 0xfb, 0xba, 0xcb, 0x0d, 0x8f, 0xc9, 0x4d, 0x7d, 0x0d, 0x1c, 0x66,
+	0xef, 0x55, 0xc0, 0xfe, 0xf6, 0x75, 0x19, 0xc7, 0x53, 0x56, 0xc0, 0xfe, 0x7c, 0x7e, 0x58, 0x44,
+	0x16, 0xf6, 0x5c, 0xdf, 0x64, 0x02, 0x6a, 0x00, 0xa4, 0x80, 0xb3, 0x92, 0x0d, 0x1d, 0x90, 0xb4,
+	0x33, 0xe0, 0x76, 0x9f, 0x3a, 0x4c, 0xe4, 0xd2, 0x1a, 0x2a, 0x64, 0xac, 0x99, 0x28, 0x6e, 0x06,
+	0xe1, 0x17, 0x4c, 0x90, 0x77, 0x78, 0xd6, 0x63, 0xbb, 0xd4, 0xde, 0x64, 0xbe, 0x03, 0x74, 0xc8,
+	0x24, 0xe4, 0x32, 0xb7, 0x1a, 0xe3, 0x8e, 0xc7, 0x76, 0xcb, 0x61, 0x35, 0x8b, 0x49, 0x20, 0x6f,
+	0xf0, 0xfc, 0xc5, 0x7e, 0xb4, 0x0b, 0x42, 0xba, 0x3e, 0x93, 0x2e, 0xf7, 0x73, 0xe3, 0x1a, 0x2a,
+	0xcc, 0xac, 0x3c, 0xd2, 0x6f, 0xb8, 0x23, 0x3d, 0xde, 0xa2, 0x72, 0x95, 0x6e, 0x91, 0xce, 0xb5,
+	0xd8, 0xb3, 0xfc, 0xe8, 0xfc, 0xb0, 0xf8, 0x20, 0x2a, 0xb0, 0x2c, 0xba, 0x7d, 0x63, 0x37, 0xe1,
+	0x87, 0x48, 0xff, 0xfc, 0x1f, 0x84, 0xc7, 0xdb, 0x32, 0x18, 0xe4, 0x15, 0x9e, 0xbc, 0x14, 0x1a,
+	0xdd, 0x6a, 0xc3, 0xff, 0xe2, 0x59, 0xc8, 0x7b, 0x84, 0x67, 0x23, 0x7d, 0x2f, 0x2a, 0x07, 0x2a,
+	0xa7, 0x0b, 0xff, 0xaf, 0x2c, 0x5c, 0xec, 0x15, 0x00, 0x7a, 0xec, 0x1a, 0xbd, 0xcc, 0x5d, 0xdf,
+	0xac, 0x05, 0x5d, 0xbf, 0xfc, 0x5a, 0x2a, 0x38, 0xae, 0xdc, 0xdc, 0xee, 0xe8, 0x36, 0xf7, 0x62,
+	0xc3, 0x19, 0x89, 0x55, 0xe4, 0xde, 0x16, 0x88, 0x90, 0x20, 0x3e, 0x9e, 0x1f, 0x16, 0xa7, 0x07,
+	0xe1, 0x40, 0x34, 0xf0, 0x9d, 0x88, 0x75, 0x0e, 0x3b, 0xc7, 0x52, 0x89, 0x97, 0x99, 0xc9, 0xb1,
+	0x6c, 0xda, 0x9a, 0xba, 0xbc, 0xee, 0xe2, 0x77, 0x84, 0xc9, 0x75, 0x21, 0xc9, 0x73, 0xac, 0x99,
+	0xab, 0xed, 0x2a, 0xad, 0x55, 0xab, 0xb4, 0x52, 0x6d, 0xaf, 0xd7, 0x1b, 0xab, 0xeb, 0xf5, 0x66,
+	0x83, 0x6e, 0x34, 0xda, 0xad, 0x6a, 0xb9, 0x5e, 0xab, 0x57, 0x2b, 0xd9, 0x94, 0xa2, 0x8c, 0x0e,
+	0xb4, 0x7b, 0x09, 0xda, 0x86, 0x2f, 0xb6, 0xc0, 0x76, 0x7b, 0x2e, 0x74, 0xc9, 0x0a, 0x5e, 0xb8,
+	0xb1, 0x82, 0xb9, 0x61, 0x35, 0xb2, 0x48, 0x99, 0x1b, 0x1d, 0x68, 0xb3, 0x09, 0xaa, 0xb9, 0x3d,
+	0xf4, 0x49, 0x19, 0xe7, 0x6f, 0xe4, 0xb4, 0xac, 0xe6, 0x7a, 0xb3, 0xdc, 0x5c, 0xa3, 0xad, 0x66,
+	0x73, 0x2d, 0x3b, 0xa6, 0x2c, 0x8e, 0x0e, 0xb4, 0xfb, 0x09, 0x72, 0x2b, 0x78, 0x4f, 0x36, 0x1f,
+	0xb4, 0x38, 0x1f, 0x28, 0x99, 0xfd, 0x4f, 0x6a, 0xca, 0x7c, 0x7a, 0x74, 0xaa, 0xa2, 0xe3, 0x53,
+	0x15, 0xfd, 0x3e, 0x55, 0xd1, 0x87, 0x33, 0x35, 0x75, 0x7c, 0xa6, 0xa6, 0x7e, 0x9c, 0xa9, 0xa9,
+	0xb7, 0xea, 0x3f, 0xf7, 0x98, 0x34, 0x43, 0xa8, 0x66, 0x67, 0x22, 0x7c, 0x9d, 0x8f, 0xff, 0x0e,
+	0x00, 0x85, 0x1a, 0xd6, 0x39, 0x3d, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFeeDestination != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeDestination))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockBaseFees) > 0 {
+		for iNdEx := len(m.BlockBaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockBaseFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeDestination != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeDestination))
+	}
+	return n
+}
+
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.BlockBaseFees) > 0 {
+		for _, e := range m.BlockBaseFees {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDestination", wireType)
+			}
+			m.BaseFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeDestination |= BaseFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockBaseFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockBaseFees = append(m.BlockBaseFees, types.Coin{})
+			if err := m.BlockBaseFees[len(m.BlockBaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(feeDenom string, minBaseFee math.LegacyDec, targetBlockGas uint64, maxChangeRate math.LegacyDec, destination BaseFeeDestination) Params {
+	return Params{
+		FeeDenom:           feeDenom,
+		MinBaseFee:         minBaseFee,
+		TargetBlockGas:     targetBlockGas,
+		MaxChangeRate:      maxChangeRate,
+		BaseFeeDestination: destination,
+	}
+}
+
+// DefaultMinBaseFee is the default minimum base fee, and initial base fee, per
+// unit of gas. It must not be zero, as the base fee changes in proportion to
+// itself and would never leave zero.
+var DefaultMinBaseFee = math.LegacyNewDecWithPrec(1, 6)
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return Params{
+		FeeDenom:           sdk.DefaultBondDenom,
+		MinBaseFee:         DefaultMinBaseFee,
+		TargetBlockGas:     10_000_000,
+		MaxChangeRate:      math.LegacyNewDecWithPrec(125, 3), // as in EIP-1559
+		BaseFeeDestination: DestinationBurn,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateMaxChangeRate(p.MaxChangeRate); err != nil {
+		return err
+	}
+	if err := validateBaseFeeDestination(p.BaseFeeDestination); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the block following a block with the
+// given base fee and gas. As in EIP-1559, the base fee changes in proportion to
+// the deviation of the block gas from the target, by at most the max change
+// rate, and never goes below the minimum base fee.
+func (p Params) NextBaseFee(baseFee math.LegacyDec, blockGas uint64) math.LegacyDec {
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.TargetBlockGas))
+	deviation := math.LegacyNewDecFromInt(math.NewIntFromUint64(blockGas)).Sub(target).Quo(target)
+	deviation = math.LegacyMinDec(deviation, math.LegacyOneDec())
+
+	next := baseFee.Add(baseFee.Mul(p.MaxChangeRate).Mul(deviation))
+	return math.LegacyMaxDec(next, p.MinBaseFee)
+}
+
+// MaxBaseFee returns the maximum base fee that can be reached after the given
+// number of blocks, starting from the given base fee.
+func (p Params) MaxBaseFee(baseFee math.LegacyDec, blocks uint64) math.LegacyDec {
+	return baseFee.Mul(math.LegacyOneDec().Add(p.MaxChangeRate).Power(blocks))
+}
+
+func validateFeeDenom(v string) error {
+	if strings.TrimSpace(v) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+	if err := sdk.ValidateDenom(v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("min base fee cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxChangeRate(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("max change rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max change rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max change rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeDestination(v BaseFeeDestination) error {
+	switch v {
+	case DestinationBurn, DestinationProtocolPool:
+		return nil
+	default:
+		return fmt.Errorf("invalid base fee destination: %s", v)
+	}
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.FeeDenom = ""
+	require.ErrorContains(t, params.Validate(), "fee denom cannot be blank")
+
+	params = types.DefaultParams()
+	params.MinBaseFee = math.LegacyNewDec(-1)
+	require.ErrorContains(t, params.Validate(), "min base fee cannot be negative")
+
+	params = types.DefaultParams()
+	params.TargetBlockGas = 0
+	require.ErrorContains(t, params.Validate(), "target block gas must be positive")
+
+	params = types.DefaultParams()
+	params.MaxChangeRate = math.LegacyNewDecWithPrec(11, 1)
+	require.ErrorContains(t, params.Validate(), "max change rate too large")
+
+	params = types.DefaultParams()
+	params.BaseFeeDestination = types.DestinationUnspecified
+	require.ErrorContains(t, params.Validate(), "invalid base fee destination")
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams("stake", math.LegacyNewDecWithPrec(1, 1), 1_000, math.LegacyNewDecWithPrec(125, 3), types.DestinationBurn)
+	baseFee := math.LegacyNewDec(1)
+
+	testCases := []struct {
+		name     string
+		baseFee  math.LegacyDec
+		blockGas uint64
+		expected string
+	}{
+		{"at target", baseFee, 1_000, "1"},
+		{"empty block", baseFee, 0, "0.875"},
+		{"twice the target", baseFee, 2_000, "1.125"},
+		{"above twice the target", baseFee, 10_000, "1.125"},
+		{"half the target", baseFee, 500, "0.9375"},
+		{"min base fee", math.LegacyNewDecWithPrec(1, 1), 0, "0.1"},
+		{"below min base fee", math.LegacyZeroDec(), 2_000, "0.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, math.LegacyMustNewDecFromStr(tc.expected), params.NextBaseFee(tc.baseFee, tc.blockGas))
+		})
+	}
+}
+
+func TestDefaultBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	baseFee := types.DefaultInitialState().BaseFee
+	require.True(t, baseFee.IsPositive())
+
+	// the default base fee rises with congestion
+	next := params.NextBaseFee(baseFee, 2*params.TargetBlockGas)
+	require.True(t, next.GT(baseFee))
+	require.Equal(t, baseFee, params.NextBaseFee(baseFee, 0))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewState returns a State with the given base fee, at the start of a block.
+func NewState(baseFee math.LegacyDec) State {
+	return State{
+		BaseFee:       baseFee,
+		BlockBaseFees: sdk.NewCoins(),
+	}
+}
+
+// DefaultInitialState returns the default initial state of the fee market,
+// with the default minimum base fee as base fee.
+func DefaultInitialState() State {
+	return NewState(DefaultMinBaseFee)
+}
+
+// Validate does the sanity check on the state.
+func (s State) Validate() error {
+	if s.BaseFee.IsNil() {
+		return fmt.Errorf("base fee cannot be nil: %s", s.BaseFee)
+	}
+	if s.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", s.BaseFee)
+	}
+
+	return s.BlockBaseFees.Validate()
+}
@@ -29,6 +29,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "annual-provisions",
 					Short:     "Query the current minting annual provisions value",
 				},
+				{
+					RpcMethod:      "SupplyProjection",
+					Use:            "supply-projection [periods]",
+					Short:          "Query the projected supply for the next minting periods following the inflation schedule",
+					Example:        fmt.Sprintf(`%s query mint supply-projection 12 --bonded-ratio 0.5`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "periods"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
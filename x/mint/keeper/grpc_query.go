@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/x/mint/types"
 )
 
@@ -45,3 +48,42 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// SupplyProjection projects the supply of the mint denom for the next minting periods
+// following the inflation schedule in the params.
+func (q queryServer) SupplyProjection(ctx context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Periods == 0 || req.Periods > types.MaxSupplyProjectionPeriods {
+		return nil, status.Errorf(codes.InvalidArgument, "periods must be between 1 and %d", types.MaxSupplyProjectionPeriods)
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minter, err := q.k.Minter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bondedRatio := req.BondedRatio
+	if bondedRatio.IsNil() {
+		bondedRatio = params.GoalBonded
+	}
+
+	projections := types.ProjectSupply(
+		ctx,
+		minter,
+		params,
+		q.k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount,
+		bondedRatio,
+		q.k.HeaderService.HeaderInfo(ctx).Time,
+		req.Periods,
+	)
+
+	return &types.QuerySupplyProjectionResponse{Projections: projections}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/mint"
 	"cosmossdk.io/x/mint/keeper"
@@ -29,6 +30,7 @@ type MintTestSuite struct {
 	ctx         sdk.Context
 	queryClient types.QueryClient
 	mintKeeper  *keeper.Keeper
+	bankKeeper  *minttestutil.MockBankKeeper
 }
 
 func (suite *MintTestSuite) SetupTest() {
@@ -42,6 +44,7 @@ func (suite *MintTestSuite) SetupTest() {
 	ctrl := gomock.NewController(suite.T())
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
+	suite.bankKeeper = bankKeeper
 
 	accountKeeper.EXPECT().GetModuleAddress("mint").Return(sdk.AccAddress{})
 
@@ -81,6 +84,34 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, minter.AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCSupplyProjection() {
+	_, err := suite.queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Periods: types.MaxSupplyProjectionPeriods + 1})
+	suite.Require().Error(err)
+
+	params, err := suite.mintKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.InflationSchedule = types.InflationSchedule{
+		ScheduleType:    types.InflationScheduleType_INFLATION_SCHEDULE_TYPE_HALVING,
+		EpochIdentifier: "day",
+		EpochsPerYear:   100,
+		Halving:         types.HalvingSchedule{AnnualProvisions: math.NewInt(1000), HalvingInterval: 1},
+	}
+	suite.Require().NoError(suite.mintKeeper.Params.Set(suite.ctx, params))
+
+	suite.bankKeeper.EXPECT().GetSupply(gomock.Any(), params.MintDenom).Return(sdk.NewInt64Coin(params.MintDenom, 100000)).AnyTimes()
+
+	res, err := suite.queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Periods: 3})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Projections, 3)
+	suite.Require().Equal(math.NewInt(10), res.Projections[0].Provision)
+	suite.Require().Equal(math.NewInt(5), res.Projections[1].Provision)
+	suite.Require().Equal(math.NewInt(2), res.Projections[2].Provision)
+	suite.Require().Equal(math.NewInt(100017), res.Projections[2].TotalSupply)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -104,11 +104,20 @@ func (k *Keeper) MintFn(ctx context.Context, minter *types.Minter, epochId strin
 
 // DefaultMintFn returns a default mint function. It requires the Staking module and the mint keeper.
 // The default Mintfn has a requirement on staking as it uses bond to calculate inflation.
+// It mints following the inflation schedule set in the params, which allows governance to switch
+// between the bonded ratio model (using the given InflationCalculationFn), a halving schedule and a
+// piecewise-linear schedule, minting either every block or on a given x/epochs epoch.
 func DefaultMintFn(ic types.InflationCalculationFn, staking types.StakingKeeper, k *Keeper) types.MintFn {
 	return func(ctx context.Context, env appmodule.Environment, minter *types.Minter, epochId string, epochNumber int64) error {
-		// the default mint function is called every block, so we only check if epochId is "block" which is
-		// a special value to indicate that this is not an epoch minting, but a regular block minting.
-		if epochId != "block" {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		// the default mint function is called every block with the special epoch id "block", and
+		// on every epoch start, so we only mint if epochId matches the one of the schedule.
+		schedule := params.InflationSchedule
+		if epochId != schedule.MintEpochIdentifier() {
 			return nil
 		}
 
@@ -122,15 +131,15 @@ func DefaultMintFn(ic types.InflationCalculationFn, staking types.StakingKeeper,
 			return err
 		}
 
-		params, err := k.Params.Get(ctx)
-		if err != nil {
-			return err
+		headerInfo := env.HeaderService.HeaderInfo(ctx)
+		period := epochNumber
+		if epochId == types.BlockEpochIdentifier {
+			period = headerInfo.Height
 		}
 
-		minter.Inflation = ic(ctx, *minter, params, bondedRatio)
-		minter.AnnualProvisions = minter.NextAnnualProvisions(params, stakingTokenSupply)
+		var mintedCoin sdk.Coin
+		*minter, mintedCoin = schedule.NextMinter(ctx, ic, *minter, params, stakingTokenSupply, bondedRatio, period, headerInfo.Time)
 
-		mintedCoin := minter.BlockProvision(params)
 		mintedCoins := sdk.NewCoins(mintedCoin)
 		maxSupply := params.MaxSupply
 		totalSupply := stakingTokenSupply
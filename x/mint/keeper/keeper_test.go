@@ -116,6 +116,44 @@ func (s *KeeperTestSuite) TestDefaultMintFn() {
 	s.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestDefaultMintFnHalvingSchedule() {
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(15, 2), nil).AnyTimes()
+	err := s.mintKeeper.SetMintFn(keeper.DefaultMintFn(types.DefaultInflationCalculationFn, s.stakingKeeper, s.mintKeeper))
+	s.NoError(err)
+
+	params, err := s.mintKeeper.Params.Get(s.ctx)
+	s.NoError(err)
+	params.InflationSchedule = types.InflationSchedule{
+		ScheduleType:    types.InflationScheduleType_INFLATION_SCHEDULE_TYPE_HALVING,
+		EpochIdentifier: "day",
+		EpochsPerYear:   365,
+		Halving:         types.HalvingSchedule{AnnualProvisions: math.NewInt(365000), HalvingInterval: 10},
+	}
+	s.NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+
+	// no minting happens on blocks or on other epochs
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "block", -1))
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "week", 1))
+	s.Equal(int64(0), minter.Period)
+
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1000)))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "day", 10))
+	s.Equal(int64(10), minter.Period)
+	s.Equal(math.LegacyNewDec(365000), minter.AnnualProvisions)
+
+	// provisions are halved after the halving interval
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(500)))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+	s.NoError(s.mintKeeper.MintFn(s.ctx, &minter, "day", 11))
+	s.Equal(int64(11), minter.Period)
+	s.Equal(math.LegacyNewDec(182500), minter.AnnualProvisions)
+}
+
 func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
 	bondedRatio := math.LegacyNewDecWithPrec(15, 2)
@@ -10,16 +10,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +31,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationScheduleType enumerates the built-in inflation schedules.
+type InflationScheduleType int32
+
+const (
+	// INFLATION_SCHEDULE_TYPE_UNSPECIFIED defines no schedule type, which
+	// fallbacks to INFLATION_SCHEDULE_TYPE_BONDED_RATIO.
+	InflationScheduleType_INFLATION_SCHEDULE_TYPE_UNSPECIFIED InflationScheduleType = 0
+	// INFLATION_SCHEDULE_TYPE_BONDED_RATIO adjusts the inflation rate towards
+	// the goal bonded ratio, bounded by inflation_min and inflation_max.
+	InflationScheduleType_INFLATION_SCHEDULE_TYPE_BONDED_RATIO InflationScheduleType = 1
+	// INFLATION_SCHEDULE_TYPE_HALVING mints a fixed annual amount which is
+	// halved every halving interval.
+	InflationScheduleType_INFLATION_SCHEDULE_TYPE_HALVING InflationScheduleType = 2
+	// INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR follows a target inflation rate
+	// interpolated linearly between points in time.
+	InflationScheduleType_INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR InflationScheduleType = 3
+)
+
+var InflationScheduleType_name = map[int32]string{
+	0: "INFLATION_SCHEDULE_TYPE_UNSPECIFIED",
+	1: "INFLATION_SCHEDULE_TYPE_BONDED_RATIO",
+	2: "INFLATION_SCHEDULE_TYPE_HALVING",
+	3: "INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR",
+}
+
+var InflationScheduleType_value = map[string]int32{
+	"INFLATION_SCHEDULE_TYPE_UNSPECIFIED":      0,
+	"INFLATION_SCHEDULE_TYPE_BONDED_RATIO":     1,
+	"INFLATION_SCHEDULE_TYPE_HALVING":          2,
+	"INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR": 3,
+}
+
+func (x InflationScheduleType) String() string {
+	return proto.EnumName(InflationScheduleType_name, int32(x))
+}
+
+func (InflationScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -36,6 +80,9 @@ type Minter struct {
 	// data is any custom data that the user might want to put in the minter, to
 	// be used in the minting process.
 	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// period is the last minting period (epoch number, or block height when
+	// minting every block) processed by the inflation schedule.
+	Period int64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -78,6 +125,13 @@ func (m *Minter) GetData() []byte {
 	return nil
 }
 
+func (m *Minter) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
 // Params defines the parameters for the x/mint module.
 type Params struct {
 	// type of coin to mint
@@ -94,6 +148,8 @@ type Params struct {
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 	// maximum supply for the token
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// inflation schedule used by the default minting function
+	InflationSchedule InflationSchedule `protobuf:"bytes,8,opt,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -143,46 +199,318 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() InflationSchedule {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return InflationSchedule{}
+}
+
+// InflationSchedule defines a parameter-driven minting schedule.
+type InflationSchedule struct {
+	// schedule_type is the schedule used to compute the minted provisions.
+	ScheduleType InflationScheduleType `protobuf:"varint,1,opt,name=schedule_type,json=scheduleType,proto3,enum=cosmos.mint.v1beta1.InflationScheduleType" json:"schedule_type,omitempty"`
+	// epoch_identifier is the x/epochs identifier at which new tokens are minted.
+	// The special value "block" (or an empty value) mints every block.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epochs_per_year is the expected number of epochs per year. It is used to
+	// convert annual amounts into per epoch amounts, and is ignored when minting
+	// every block, where blocks_per_year is used instead.
+	EpochsPerYear uint64 `protobuf:"varint,3,opt,name=epochs_per_year,json=epochsPerYear,proto3" json:"epochs_per_year,omitempty"`
+	// halving defines the parameters of the halving schedule.
+	Halving HalvingSchedule `protobuf:"bytes,4,opt,name=halving,proto3" json:"halving"`
+	// piecewise_linear defines the parameters of the piecewise-linear schedule.
+	PiecewiseLinear PiecewiseLinearSchedule `protobuf:"bytes,5,opt,name=piecewise_linear,json=piecewiseLinear,proto3" json:"piecewise_linear"`
+}
+
+func (m *InflationSchedule) Reset()         { *m = InflationSchedule{} }
+func (m *InflationSchedule) String() string { return proto.CompactTextString(m) }
+func (*InflationSchedule) ProtoMessage()    {}
+func (*InflationSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *InflationSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSchedule.Merge(m, src)
+}
+func (m *InflationSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSchedule proto.InternalMessageInfo
+
+func (m *InflationSchedule) GetScheduleType() InflationScheduleType {
+	if m != nil {
+		return m.ScheduleType
+	}
+	return InflationScheduleType_INFLATION_SCHEDULE_TYPE_UNSPECIFIED
+}
+
+func (m *InflationSchedule) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *InflationSchedule) GetEpochsPerYear() uint64 {
+	if m != nil {
+		return m.EpochsPerYear
+	}
+	return 0
+}
+
+func (m *InflationSchedule) GetHalving() HalvingSchedule {
+	if m != nil {
+		return m.Halving
+	}
+	return HalvingSchedule{}
+}
+
+func (m *InflationSchedule) GetPiecewiseLinear() PiecewiseLinearSchedule {
+	if m != nil {
+		return m.PiecewiseLinear
+	}
+	return PiecewiseLinearSchedule{}
+}
+
+// HalvingSchedule mints a fixed amount of tokens per year, halved every
+// halving_interval minting periods.
+type HalvingSchedule struct {
+	// annual_provisions is the amount of tokens minted per year before the first
+	// halving.
+	AnnualProvisions cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.Int" json:"annual_provisions"`
+	// halving_interval is the number of minting periods (epochs, or blocks when
+	// minting every block) between two halvings.
+	HalvingInterval uint64 `protobuf:"varint,2,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+}
+
+func (m *HalvingSchedule) Reset()         { *m = HalvingSchedule{} }
+func (m *HalvingSchedule) String() string { return proto.CompactTextString(m) }
+func (*HalvingSchedule) ProtoMessage()    {}
+func (*HalvingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *HalvingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HalvingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HalvingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HalvingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HalvingSchedule.Merge(m, src)
+}
+func (m *HalvingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *HalvingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HalvingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HalvingSchedule proto.InternalMessageInfo
+
+func (m *HalvingSchedule) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+// PiecewiseLinearSchedule targets an annual inflation rate defined by a curve
+// of points in time. The rate is interpolated linearly between two points and
+// held constant before the first point and after the last one.
+type PiecewiseLinearSchedule struct {
+	// points are the points of the curve, ordered by time.
+	Points []InflationPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *PiecewiseLinearSchedule) Reset()         { *m = PiecewiseLinearSchedule{} }
+func (m *PiecewiseLinearSchedule) String() string { return proto.CompactTextString(m) }
+func (*PiecewiseLinearSchedule) ProtoMessage()    {}
+func (*PiecewiseLinearSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *PiecewiseLinearSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PiecewiseLinearSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PiecewiseLinearSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PiecewiseLinearSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecewiseLinearSchedule.Merge(m, src)
+}
+func (m *PiecewiseLinearSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PiecewiseLinearSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecewiseLinearSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PiecewiseLinearSchedule proto.InternalMessageInfo
+
+func (m *PiecewiseLinearSchedule) GetPoints() []InflationPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// InflationPoint defines the target annual inflation rate at a point in time.
+type InflationPoint struct {
+	// time at which the inflation rate is reached.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// inflation is the target annual inflation rate.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+}
+
+func (m *InflationPoint) Reset()         { *m = InflationPoint{} }
+func (m *InflationPoint) String() string { return proto.CompactTextString(m) }
+func (*InflationPoint) ProtoMessage()    {}
+func (*InflationPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{5}
+}
+func (m *InflationPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationPoint.Merge(m, src)
+}
+func (m *InflationPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationPoint proto.InternalMessageInfo
+
+func (m *InflationPoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationScheduleType", InflationScheduleType_name, InflationScheduleType_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*InflationSchedule)(nil), "cosmos.mint.v1beta1.InflationSchedule")
+	proto.RegisterType((*HalvingSchedule)(nil), "cosmos.mint.v1beta1.HalvingSchedule")
+	proto.RegisterType((*PiecewiseLinearSchedule)(nil), "cosmos.mint.v1beta1.PiecewiseLinearSchedule")
+	proto.RegisterType((*InflationPoint)(nil), "cosmos.mint.v1beta1.InflationPoint")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x10, 0x82, 0x72, 0xb4, 0x82, 0x5e, 0xa9, 0xe4, 0x16, 0xd5, 0x8d, 0x3a, 0xa0,
-	0xa8, 0xa8, 0xb1, 0xa2, 0x4a, 0x0c, 0x8c, 0x21, 0x4b, 0x11, 0x15, 0x91, 0x19, 0x10, 0x20, 0x61,
-	0xbd, 0xb6, 0xaf, 0xee, 0x11, 0xdf, 0x9d, 0xe5, 0xbb, 0x54, 0xf6, 0x57, 0x60, 0xe2, 0x63, 0x30,
-	0x76, 0x60, 0xe1, 0x1b, 0x74, 0x41, 0xaa, 0x98, 0x10, 0x43, 0x85, 0x92, 0xa1, 0x5f, 0x03, 0xf9,
-	0xce, 0xa4, 0xfc, 0x99, 0x08, 0x5d, 0xac, 0xbb, 0xf7, 0x79, 0xdf, 0xdf, 0xf3, 0xd8, 0xf2, 0x8b,
-	0xdd, 0x48, 0x2a, 0x2e, 0x95, 0xc7, 0x99, 0xd0, 0xde, 0x71, 0x3f, 0xa4, 0x1a, 0xfa, 0xe6, 0xd2,
-	0xcb, 0x72, 0xa9, 0x25, 0x59, 0xb5, 0x7a, 0xcf, 0x94, 0x6a, 0x7d, 0xe3, 0x6e, 0x22, 0x13, 0x69,
-	0x74, 0xaf, 0x3a, 0xd9, 0xd6, 0x8d, 0x75, 0xdb, 0x1a, 0x58, 0xa1, 0x9e, 0xb3, 0xd2, 0x0a, 0x70,
-	0x26, 0xa4, 0x67, 0x9e, 0x3f, 0xbb, 0x13, 0x29, 0x93, 0x94, 0x7a, 0xe6, 0x16, 0x4e, 0x0e, 0x3d,
-	0x10, 0xa5, 0x95, 0xb6, 0x3f, 0x23, 0xdc, 0x3a, 0x60, 0x42, 0xd3, 0x9c, 0x3c, 0xc3, 0x6d, 0x26,
-	0x0e, 0x53, 0xd0, 0x4c, 0x0a, 0x07, 0x75, 0x50, 0xb7, 0x3d, 0xe8, 0x9f, 0x9e, 0x6f, 0x35, 0xbe,
-	0x9d, 0x6f, 0xdd, 0xb3, 0x0e, 0x2a, 0x1e, 0xf7, 0x98, 0xf4, 0x38, 0xe8, 0xa3, 0xde, 0x53, 0x9a,
-	0x40, 0x54, 0x0e, 0x69, 0xf4, 0xe5, 0xe3, 0x2e, 0xae, 0x03, 0x0c, 0x69, 0xe4, 0x5f, 0x32, 0xc8,
-	0x1b, 0xbc, 0x02, 0x42, 0x4c, 0x20, 0xad, 0x62, 0x1e, 0x33, 0xc5, 0xa4, 0x50, 0xce, 0xb5, 0x45,
-	0xc1, 0x77, 0x2c, 0x6b, 0x34, 0x47, 0x11, 0x82, 0x9b, 0x31, 0x68, 0x70, 0xae, 0x77, 0x50, 0x77,
-	0xc9, 0x37, 0xe7, 0xed, 0x4f, 0x4d, 0xdc, 0x1a, 0x41, 0x0e, 0x5c, 0x91, 0x4d, 0x8c, 0xab, 0x2f,
-	0x19, 0xc4, 0x54, 0x48, 0x6e, 0x5f, 0xc8, 0x6f, 0x57, 0x95, 0x61, 0x55, 0x20, 0x6f, 0xf1, 0xda,
-	0x3c, 0x6a, 0x90, 0x83, 0xa6, 0x41, 0x74, 0x04, 0x22, 0xa1, 0x75, 0xc2, 0x87, 0xff, 0x9c, 0xf0,
-	0xc3, 0xc5, 0xc9, 0x0e, 0xf2, 0x57, 0xe7, 0x50, 0x1f, 0x34, 0x7d, 0x6c, 0x90, 0xe4, 0x35, 0x5e,
-	0xbe, 0xf4, 0xe2, 0x50, 0x98, 0xc8, 0x8b, 0x7b, 0x2c, 0xcd, 0x61, 0x07, 0x50, 0xfc, 0x01, 0x67,
-	0xc2, 0x69, 0x5e, 0x15, 0x9c, 0x09, 0xf2, 0x02, 0xdf, 0x4a, 0x24, 0xa4, 0x41, 0x28, 0x45, 0x4c,
-	0x63, 0xe7, 0xc6, 0x7f, 0xa1, 0x71, 0x85, 0x1a, 0x18, 0x12, 0xb9, 0x8f, 0x6f, 0x87, 0xa9, 0x8c,
-	0xc6, 0x2a, 0xc8, 0x68, 0x1e, 0x94, 0x14, 0x72, 0xa7, 0xd5, 0x41, 0xdd, 0xa6, 0xbf, 0x6c, 0xcb,
-	0x23, 0x9a, 0xbf, 0xa4, 0x90, 0x93, 0x27, 0x18, 0x73, 0x28, 0x02, 0x35, 0xc9, 0xb2, 0xb4, 0x74,
-	0x6e, 0x1a, 0xff, 0x07, 0xb5, 0xff, 0xda, 0xdf, 0xfe, 0xfb, 0x42, 0xff, 0xe2, 0xbc, 0x2f, 0xb4,
-	0xdf, 0xe6, 0x50, 0x3c, 0x37, 0xd3, 0x8f, 0x36, 0xdf, 0x5d, 0x9c, 0xec, 0x38, 0x56, 0xdb, 0x55,
-	0xf1, 0xd8, 0x2b, 0xec, 0x2e, 0xda, 0x1f, 0x66, 0xb0, 0x77, 0x3a, 0x75, 0xd1, 0xd9, 0xd4, 0x45,
-	0xdf, 0xa7, 0x2e, 0x7a, 0x3f, 0x73, 0x1b, 0x67, 0x33, 0xb7, 0xf1, 0x75, 0xe6, 0x36, 0x5e, 0xad,
-	0xff, 0x66, 0x54, 0x4f, 0xe9, 0x32, 0xa3, 0x2a, 0x6c, 0x99, 0x3d, 0xda, 0xfb, 0x11, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x44, 0x4d, 0x1a, 0xdd, 0x03, 0x00, 0x00,
+	// 934 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1a, 0x47,
+	0x14, 0x67, 0x0d, 0x26, 0x65, 0x30, 0x01, 0x26, 0xb5, 0xba, 0x71, 0x15, 0x40, 0x76, 0x94, 0x62,
+	0x37, 0x5e, 0x62, 0x2c, 0xf5, 0x10, 0xa9, 0x07, 0x63, 0xd6, 0xf5, 0x56, 0x04, 0xa3, 0x85, 0x34,
+	0x4d, 0x2b, 0x75, 0x35, 0xb0, 0x63, 0x98, 0x7a, 0x77, 0x66, 0xbb, 0xbb, 0xa6, 0xf0, 0x15, 0x7a,
+	0xca, 0xc7, 0x68, 0xa5, 0x1e, 0x72, 0xc8, 0xa1, 0x97, 0xde, 0x73, 0x8c, 0x72, 0xaa, 0x72, 0x48,
+	0x2b, 0xfb, 0x90, 0xaf, 0xd0, 0xde, 0xaa, 0x9d, 0x59, 0x30, 0xc6, 0xeb, 0xaa, 0xae, 0x7b, 0x41,
+	0xcc, 0x7b, 0xbf, 0xf7, 0xfb, 0xbd, 0x7f, 0x3b, 0x03, 0x0a, 0x3d, 0xe6, 0xd9, 0xcc, 0xab, 0xd8,
+	0x84, 0xfa, 0x95, 0xe1, 0x56, 0x17, 0xfb, 0x68, 0x8b, 0x1f, 0x14, 0xc7, 0x65, 0x3e, 0x83, 0xb7,
+	0x84, 0x5f, 0xe1, 0xa6, 0xd0, 0xbf, 0xf2, 0x7e, 0x9f, 0xf5, 0x19, 0xf7, 0x57, 0x82, 0x7f, 0x02,
+	0xba, 0x72, 0x5b, 0x40, 0x0d, 0xe1, 0x08, 0xe3, 0x84, 0x2b, 0x8f, 0x6c, 0x42, 0x59, 0x85, 0xff,
+	0x4e, 0xd0, 0x7d, 0xc6, 0xfa, 0x16, 0xae, 0xf0, 0x53, 0xf7, 0xf8, 0xb0, 0x82, 0xe8, 0x38, 0x74,
+	0x15, 0xe7, 0x5d, 0x3e, 0xb1, 0xb1, 0xe7, 0x23, 0xdb, 0x11, 0x80, 0xd5, 0x3f, 0x25, 0x90, 0x7c,
+	0x44, 0xa8, 0x8f, 0x5d, 0x78, 0x00, 0x52, 0x84, 0x1e, 0x5a, 0xc8, 0x27, 0x8c, 0xca, 0x52, 0x49,
+	0x2a, 0xa7, 0x6a, 0x5b, 0x2f, 0xdf, 0x16, 0x63, 0x6f, 0xde, 0x16, 0x3f, 0x14, 0x29, 0x78, 0xe6,
+	0x91, 0x42, 0x58, 0xc5, 0x46, 0xfe, 0x40, 0x69, 0xe0, 0x3e, 0xea, 0x8d, 0xeb, 0xb8, 0xf7, 0xfa,
+	0xc5, 0x26, 0x08, 0x33, 0xac, 0xe3, 0x9e, 0x7e, 0xc6, 0x01, 0xbf, 0x01, 0x79, 0x44, 0xe9, 0x31,
+	0xb2, 0x82, 0x3a, 0x86, 0xc4, 0x23, 0x8c, 0x7a, 0xf2, 0xc2, 0x7f, 0x25, 0xce, 0x09, 0xae, 0xd6,
+	0x94, 0x0a, 0x42, 0x90, 0x30, 0x91, 0x8f, 0xe4, 0x78, 0x49, 0x2a, 0x2f, 0xe9, 0xfc, 0x3f, 0x5c,
+	0x07, 0x49, 0x07, 0xbb, 0x84, 0x99, 0x72, 0xa2, 0x24, 0x95, 0xe3, 0xb5, 0xfc, 0x9b, 0x17, 0x9b,
+	0x99, 0x11, 0x1f, 0x43, 0x69, 0xf8, 0x40, 0xd9, 0x56, 0x1e, 0xe8, 0x21, 0x60, 0xf5, 0xe7, 0x45,
+	0x90, 0x6c, 0x21, 0x17, 0xd9, 0x1e, 0xbc, 0x03, 0x40, 0x80, 0x30, 0x4c, 0x4c, 0x99, 0x2d, 0x6a,
+	0xd7, 0x53, 0x81, 0xa5, 0x1e, 0x18, 0xe0, 0xb7, 0x60, 0x79, 0x5a, 0x95, 0xe1, 0x22, 0x1f, 0x1b,
+	0xbd, 0x01, 0xa2, 0x7d, 0x1c, 0x16, 0xf3, 0xc9, 0x95, 0x8b, 0xf9, 0xf1, 0xdd, 0xf3, 0x0d, 0x49,
+	0xbf, 0x35, 0x25, 0xd5, 0x91, 0x8f, 0x77, 0x39, 0x25, 0xfc, 0x1a, 0x64, 0xce, 0xb4, 0x6c, 0x34,
+	0x92, 0xe3, 0xd7, 0xd2, 0x58, 0x9a, 0x92, 0x3d, 0x42, 0xa3, 0x39, 0x72, 0x42, 0xe5, 0xc4, 0xff,
+	0x45, 0x4e, 0x28, 0x7c, 0x02, 0xd2, 0x7d, 0x86, 0x2c, 0xa3, 0xcb, 0xa8, 0x89, 0x4d, 0x79, 0xf1,
+	0x5a, 0xd4, 0x20, 0xa0, 0xaa, 0x71, 0x26, 0x78, 0x0f, 0x64, 0xbb, 0x16, 0xeb, 0x1d, 0x79, 0x86,
+	0x83, 0x5d, 0x63, 0x8c, 0x91, 0x2b, 0x27, 0x4b, 0x52, 0x39, 0xa1, 0x67, 0x84, 0xb9, 0x85, 0xdd,
+	0xa7, 0x18, 0xb9, 0xf0, 0x73, 0x00, 0x6c, 0x34, 0x32, 0xbc, 0x63, 0xc7, 0xb1, 0xc6, 0xf2, 0x0d,
+	0xae, 0xff, 0x71, 0xa8, 0xbf, 0x7c, 0x51, 0x5f, 0xa3, 0xfe, 0x8c, 0xb2, 0x46, 0x7d, 0x3d, 0x65,
+	0xa3, 0x51, 0x9b, 0x47, 0xc3, 0xef, 0x00, 0x3c, 0xeb, 0x94, 0xd7, 0x1b, 0x60, 0xf3, 0xd8, 0xc2,
+	0xf2, 0x7b, 0x25, 0xa9, 0x9c, 0xae, 0xde, 0x53, 0x22, 0xbe, 0x64, 0x45, 0x9b, 0xc0, 0xdb, 0x21,
+	0xba, 0xb6, 0xc2, 0xb5, 0xe7, 0xf7, 0x4f, 0xd4, 0x97, 0x27, 0xf3, 0xf0, 0x87, 0x77, 0x7e, 0x78,
+	0xf7, 0x7c, 0x43, 0x16, 0xd4, 0x9b, 0x9e, 0x79, 0x54, 0x11, 0x61, 0x15, 0xb1, 0xa3, 0xab, 0x7f,
+	0x2d, 0x80, 0xfc, 0x05, 0x0d, 0x78, 0x00, 0x32, 0x93, 0xec, 0x0c, 0x7f, 0xec, 0x60, 0xbe, 0xbc,
+	0x37, 0xab, 0x1b, 0xff, 0x2e, 0xc5, 0xce, 0xd8, 0xc1, 0xfa, 0x92, 0x37, 0x73, 0x82, 0xeb, 0x20,
+	0x87, 0x1d, 0xd6, 0x1b, 0x18, 0xc4, 0xc4, 0xd4, 0x27, 0x87, 0x04, 0xbb, 0x62, 0xcd, 0xf5, 0x2c,
+	0xb7, 0x6b, 0x53, 0x73, 0x30, 0x17, 0x6e, 0x9a, 0x99, 0x4b, 0x5c, 0xcc, 0x45, 0x98, 0x27, 0x73,
+	0xd1, 0xc0, 0x8d, 0x01, 0xb2, 0x86, 0x84, 0xf6, 0xf9, 0xbe, 0xa5, 0xab, 0x77, 0x23, 0xb3, 0xdb,
+	0x17, 0x98, 0x69, 0xfb, 0x52, 0x41, 0xfb, 0x44, 0xb7, 0x26, 0xf1, 0xb0, 0x0b, 0x72, 0x0e, 0xc1,
+	0x3d, 0xfc, 0x3d, 0xf1, 0xb0, 0x61, 0x11, 0x1a, 0x68, 0x2e, 0x72, 0xce, 0xfb, 0x91, 0x9c, 0xad,
+	0x09, 0xb8, 0xc1, 0xb1, 0x51, 0xdc, 0x59, 0xe7, 0x3c, 0xe6, 0x61, 0xfe, 0xf5, 0xfc, 0xc8, 0x56,
+	0x7f, 0x92, 0x40, 0x76, 0x2e, 0x3d, 0xf8, 0x65, 0xd4, 0xed, 0x26, 0x5d, 0x7d, 0xe9, 0x2e, 0xde,
+	0x6b, 0xeb, 0x20, 0x17, 0xd6, 0x6b, 0xf0, 0x9b, 0x79, 0x88, 0x2c, 0x3e, 0x82, 0x84, 0x9e, 0x0d,
+	0xed, 0x5a, 0x68, 0x8e, 0xca, 0xd5, 0x07, 0x1f, 0x5c, 0x52, 0x35, 0xdc, 0x03, 0x49, 0x87, 0x11,
+	0xea, 0x07, 0x79, 0xc6, 0xcb, 0xe9, 0xea, 0xda, 0x3f, 0x6f, 0x49, 0x2b, 0xc0, 0xce, 0xb6, 0x2a,
+	0x8c, 0x8e, 0x52, 0xfd, 0x45, 0x02, 0x37, 0xcf, 0x07, 0xc2, 0x4f, 0x41, 0x22, 0x78, 0x6d, 0x78,
+	0x4f, 0xd2, 0xd5, 0x15, 0x45, 0x3c, 0x45, 0xca, 0xe4, 0x29, 0x52, 0x3a, 0x93, 0xa7, 0xa8, 0x96,
+	0x09, 0x24, 0x9e, 0xfd, 0x5e, 0x94, 0x84, 0x0c, 0x0f, 0x83, 0x9d, 0xd9, 0xe7, 0xe8, 0x7a, 0x17,
+	0xed, 0x19, 0x51, 0x44, 0xea, 0x1b, 0xbf, 0x4a, 0x60, 0x39, 0xf2, 0xcb, 0x80, 0x1f, 0x81, 0x35,
+	0xad, 0xb9, 0xd7, 0xd8, 0xe9, 0x68, 0x07, 0x4d, 0xa3, 0xbd, 0xbb, 0xaf, 0xd6, 0x1f, 0x37, 0x54,
+	0xa3, 0xf3, 0xb4, 0xa5, 0x1a, 0x8f, 0x9b, 0xed, 0x96, 0xba, 0xab, 0xed, 0x69, 0x6a, 0x3d, 0x17,
+	0x83, 0x65, 0x70, 0xf7, 0x32, 0x60, 0xed, 0xa0, 0x59, 0x57, 0xeb, 0x86, 0x1e, 0xf8, 0x72, 0x12,
+	0x5c, 0x03, 0xc5, 0xcb, 0x90, 0xfb, 0x3b, 0x8d, 0x2f, 0xb4, 0xe6, 0x67, 0xb9, 0x05, 0x78, 0x1f,
+	0x94, 0x2f, 0x03, 0xb5, 0x34, 0x75, 0x57, 0x7d, 0xa2, 0xb5, 0x55, 0xa3, 0xa1, 0x35, 0xd5, 0x1d,
+	0x3d, 0x17, 0xaf, 0x6d, 0xbf, 0x3c, 0x29, 0x48, 0xaf, 0x4e, 0x0a, 0xd2, 0x1f, 0x27, 0x05, 0xe9,
+	0xd9, 0x69, 0x21, 0xf6, 0xea, 0xb4, 0x10, 0xfb, 0xed, 0xb4, 0x10, 0xfb, 0xea, 0xf6, 0xb9, 0x3e,
+	0x85, 0xd7, 0x49, 0x70, 0x4b, 0x78, 0xdd, 0x24, 0x1f, 0xc3, 0xf6, 0xdf, 0x03, 0x00, 0xe8, 0x48,
+	0x69, 0xb9, 0xb5, 0x08, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -205,6 +533,11 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Period != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -255,6 +588,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.InflationSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -320,67 +663,788 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InflationSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *InflationSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	{
+		size, err := m.PiecewiseLinear.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Halving.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EpochsPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochsPerYear))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduleType != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HalvingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HalvingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HalvingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.InflationRateChange.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMax.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMin.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.GoalBonded.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PiecewiseLinearSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PiecewiseLinearSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PiecewiseLinearSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMint(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovMint(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationSchedule.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleType != 0 {
+		n += 1 + sovMint(uint64(m.ScheduleType))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.EpochsPerYear != 0 {
+		n += 1 + sovMint(uint64(m.EpochsPerYear))
+	}
+	l = m.Halving.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.PiecewiseLinear.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *HalvingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	return n
+}
+
+func (m *PiecewiseLinearSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.MaxSupply.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *InflationSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -403,15 +1467,34 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= InflationScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -439,15 +1522,32 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerYear", wireType)
+			}
+			m.EpochsPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Halving", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -457,31 +1557,30 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Halving.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseLinear", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -491,24 +1590,23 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.PiecewiseLinear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -532,7 +1630,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *HalvingSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -555,15 +1653,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: HalvingSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HalvingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -591,13 +1689,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
 			}
-			var stringLen uint64
+			m.HalvingInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -607,31 +1707,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HalvingInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMint
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PiecewiseLinearSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PiecewiseLinearSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PiecewiseLinearSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -641,65 +1776,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Points = append(m.Points, InflationPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -709,48 +1860,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
-			}
-			m.BlocksPerYear = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -778,7 +1909,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40,6 +40,9 @@ func ValidateMinter(minter Minter) error {
 		return fmt.Errorf("mint parameter Inflation should be positive, is %s",
 			minter.Inflation.String())
 	}
+	if minter.Period < 0 {
+		return fmt.Errorf("mint parameter Period should be positive, is %d", minter.Period)
+	}
 	return nil
 }
 
@@ -96,5 +99,9 @@ func (m Minter) IsEqual(minter Minter) bool {
 		return false
 	}
 
+	if m.Period != minter.Period {
+		return false
+	}
+
 	return true
 }
@@ -10,7 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// NewParams returns Params instance with the given values.
+// NewParams returns Params instance with the given values, using the default
+// inflation schedule.
 func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded math.LegacyDec, blocksPerYear uint64, maxSupply math.Int) Params {
 	return Params{
 		MintDenom:           mintDenom,
@@ -20,6 +21,7 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
 		MaxSupply:           maxSupply,
+		InflationSchedule:   DefaultInflationSchedule(),
 	}
 }
 
@@ -33,6 +35,7 @@ func DefaultParams() Params {
 		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5-second block times
 		MaxSupply:           math.ZeroInt(),             // assuming zero is infinite
+		InflationSchedule:   DefaultInflationSchedule(),
 	}
 }
 
@@ -59,6 +62,9 @@ func (p Params) Validate() error {
 	if err := validateMaxSupply(p.MaxSupply); err != nil {
 		return err
 	}
+	if err := p.InflationSchedule.Validate(); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
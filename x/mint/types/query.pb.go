@@ -12,19 +12,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -270,6 +274,164 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QuerySupplyProjectionRequest is the request type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionRequest struct {
+	// periods is the number of future minting periods to project.
+	Periods uint64 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+	// bonded_ratio is the bonded ratio assumed by the bonded ratio schedule.
+	// It defaults to the goal bonded ratio when empty.
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+}
+
+func (m *QuerySupplyProjectionRequest) Reset()         { *m = QuerySupplyProjectionRequest{} }
+func (m *QuerySupplyProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionRequest) ProtoMessage()    {}
+func (*QuerySupplyProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionRequest.Merge(m, src)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionRequest) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+// QuerySupplyProjectionResponse is the response type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionResponse struct {
+	// projections holds the projected state after each minting period.
+	Projections []SupplyProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QuerySupplyProjectionResponse) Reset()         { *m = QuerySupplyProjectionResponse{} }
+func (m *QuerySupplyProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionResponse) ProtoMessage()    {}
+func (*QuerySupplyProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionResponse.Merge(m, src)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionResponse) GetProjections() []SupplyProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// SupplyProjection defines the projected supply after a minting period.
+type SupplyProjection struct {
+	// period is the minting period (epoch number or block height).
+	Period int64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// time is the estimated time of the minting period.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// inflation is the annual inflation rate during the period.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+	// provision is the amount minted during the period.
+	Provision cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=provision,proto3,customtype=cosmossdk.io/math.Int" json:"provision"`
+	// total_supply is the total supply after the period.
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+}
+
+func (m *SupplyProjection) Reset()         { *m = SupplyProjection{} }
+func (m *SupplyProjection) String() string { return proto.CompactTextString(m) }
+func (*SupplyProjection) ProtoMessage()    {}
+func (*SupplyProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *SupplyProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyProjection.Merge(m, src)
+}
+func (m *SupplyProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyProjection proto.InternalMessageInfo
+
+func (m *SupplyProjection) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *SupplyProjection) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,42 +439,62 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QuerySupplyProjectionRequest)(nil), "cosmos.mint.v1beta1.QuerySupplyProjectionRequest")
+	proto.RegisterType((*QuerySupplyProjectionResponse)(nil), "cosmos.mint.v1beta1.QuerySupplyProjectionResponse")
+	proto.RegisterType((*SupplyProjection)(nil), "cosmos.mint.v1beta1.SupplyProjection")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x8a, 0x85, 0x8e, 0x1e, 0x76, 0x67, 0xd7, 0x97, 0x4d, 0x77, 0xa7, 0x4b, 0x84,
-	0xb5, 0xac, 0x38, 0x43, 0xbb, 0xe0, 0x51, 0xb0, 0xec, 0x45, 0xf0, 0xb0, 0x2e, 0x7a, 0xf1, 0x22,
-	0xb3, 0x71, 0x8c, 0xc1, 0x66, 0x26, 0x9b, 0x99, 0x16, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b,
-	0xe8, 0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0x69, 0x05, 0xbf, 0x86, 0x64,
-	0x66, 0x5a, 0x69, 0x9a, 0xa0, 0xe2, 0x25, 0x24, 0xcf, 0xdb, 0xff, 0x37, 0xcf, 0x7f, 0x02, 0x9b,
-	0xa1, 0x54, 0x89, 0x54, 0x34, 0x89, 0x85, 0xa6, 0x83, 0xf6, 0x09, 0xd7, 0xac, 0x4d, 0x4f, 0xfb,
-	0x3c, 0x1b, 0x92, 0x34, 0x93, 0x5a, 0xa2, 0x0d, 0x5b, 0x40, 0xf2, 0x02, 0xe2, 0x0a, 0xfc, 0xcd,
-	0x48, 0x46, 0xd2, 0xe4, 0x69, 0xfe, 0x66, 0x4b, 0xfd, 0xed, 0x48, 0xca, 0xa8, 0xc7, 0x29, 0x4b,
-	0x63, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x58, 0x0a, 0xe5, 0xb2, 0xb8, 0x4c, 0xc9, 0x4c, 0xb5, 0xf9,
-	0x75, 0x96, 0xc4, 0x42, 0x52, 0xf3, 0x74, 0xa1, 0x2d, 0xdb, 0xf2, 0xc8, 0x2a, 0x39, 0x10, 0xf3,
-	0x11, 0x6c, 0x42, 0x74, 0x2f, 0xa7, 0x3c, 0x62, 0x19, 0x4b, 0xd4, 0x31, 0x3f, 0xed, 0x73, 0xa5,
-	0x83, 0x07, 0x70, 0x63, 0x29, 0xaa, 0x52, 0x29, 0x14, 0x47, 0xb7, 0x60, 0x2d, 0x35, 0x91, 0x2b,
-	0x60, 0x17, 0xb4, 0xce, 0x77, 0x1a, 0xa4, 0xe4, 0x50, 0xc4, 0x36, 0x75, 0xeb, 0xa3, 0x49, 0xd3,
-	0x7b, 0xf7, 0xf3, 0xc3, 0x3e, 0x38, 0x76, 0x5d, 0xc1, 0x65, 0x78, 0xd1, 0x8c, 0xbd, 0x23, 0x9e,
-	0xf4, 0xcc, 0x99, 0xe6, 0x7a, 0x02, 0x5e, 0x2a, 0x26, 0x9c, 0xe4, 0x7d, 0x58, 0x8f, 0xe7, 0x41,
-	0xa3, 0x7a, 0xa1, 0x7b, 0x33, 0x1f, 0xfc, 0x6d, 0xd2, 0x6c, 0x58, 0x71, 0xf5, 0xf8, 0x19, 0x89,
-	0x25, 0x4d, 0x98, 0x7e, 0x4a, 0xee, 0xf2, 0x88, 0x85, 0xc3, 0x43, 0x1e, 0x7e, 0xfe, 0x78, 0x03,
-	0x3a, 0xb6, 0x43, 0x1e, 0x5a, 0x8a, 0xdf, 0x83, 0x02, 0x0c, 0xb7, 0x8d, 0xde, 0x6d, 0x21, 0xfa,
-	0xac, 0x77, 0x94, 0xc9, 0x41, 0xac, 0xf2, 0x15, 0xcf, 0x79, 0x5e, 0x01, 0xb8, 0x53, 0x51, 0xe0,
-	0xb8, 0x42, 0xb8, 0xce, 0x4c, 0x2e, 0x5f, 0xaa, 0x4b, 0xfe, 0x27, 0xdf, 0x1a, 0x2b, 0x88, 0x75,
-	0x3e, 0x9d, 0x85, 0xe7, 0x0c, 0x06, 0x7a, 0x01, 0x60, 0xcd, 0xee, 0x15, 0x5d, 0x2b, 0x5d, 0xfa,
-	0xaa, 0x89, 0x7e, 0xeb, 0xcf, 0x85, 0xf6, 0x30, 0xc1, 0xd5, 0x97, 0x5f, 0x7e, 0xbc, 0x3d, 0xb3,
-	0x83, 0x1a, 0xb4, 0xec, 0x6e, 0x59, 0xf3, 0xd0, 0x6b, 0x00, 0xeb, 0x0b, 0x7f, 0xd0, 0x7e, 0xf5,
-	0xf0, 0xa2, 0xbb, 0xfe, 0xf5, 0xbf, 0xaa, 0x75, 0x2c, 0x7b, 0x86, 0x65, 0x17, 0xe1, 0x52, 0x96,
-	0x85, 0x85, 0xe8, 0x3d, 0x80, 0x6b, 0x45, 0x77, 0x50, 0xbb, 0x5a, 0xa9, 0xc2, 0x6a, 0xbf, 0xf3,
-	0x2f, 0x2d, 0x8e, 0x91, 0x18, 0xc6, 0x16, 0xda, 0x2b, 0x65, 0x5c, 0xb9, 0x17, 0xdd, 0x83, 0xd1,
-	0x14, 0x83, 0xf1, 0x14, 0x83, 0xef, 0x53, 0x0c, 0xde, 0xcc, 0xb0, 0x37, 0x9e, 0x61, 0xef, 0xeb,
-	0x0c, 0x7b, 0x0f, 0xb7, 0x96, 0xee, 0xc8, 0x73, 0x3b, 0x48, 0x0f, 0x53, 0xae, 0x4e, 0x6a, 0xe6,
-	0x07, 0x3d, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x7d, 0x50, 0x82, 0x5a, 0x04, 0x00, 0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6f, 0xd3, 0x4a,
+	0x10, 0xc7, 0xb3, 0xfd, 0x91, 0xa7, 0x6c, 0x5a, 0xa9, 0xdd, 0xfe, 0x78, 0xa9, 0xdb, 0x3a, 0x91,
+	0x9f, 0x5e, 0x5f, 0xd4, 0xaa, 0x76, 0x93, 0x4a, 0xef, 0x80, 0x04, 0x88, 0xa8, 0x97, 0x48, 0x08,
+	0x15, 0x53, 0x2e, 0x5c, 0xa2, 0x4d, 0xb2, 0x0d, 0x86, 0xd8, 0xeb, 0xda, 0x9b, 0x8a, 0xdc, 0x50,
+	0x85, 0x38, 0x71, 0xa8, 0xc4, 0x5f, 0xc0, 0x0d, 0x6e, 0x1c, 0x7a, 0xe2, 0x2f, 0xa8, 0x38, 0x55,
+	0xe5, 0x82, 0x38, 0x14, 0xd4, 0x22, 0x71, 0xe2, 0x7f, 0x40, 0xde, 0x5d, 0x27, 0xa9, 0xe3, 0x14,
+	0xa2, 0x5e, 0xa2, 0x78, 0x67, 0xe6, 0x3b, 0x9f, 0x9d, 0x9d, 0x19, 0x98, 0xad, 0x51, 0xdf, 0xa6,
+	0xbe, 0x61, 0x5b, 0x0e, 0x33, 0xf6, 0x0b, 0x55, 0xc2, 0x70, 0xc1, 0xd8, 0x6b, 0x11, 0xaf, 0xad,
+	0xbb, 0x1e, 0x65, 0x14, 0xcd, 0x08, 0x07, 0x3d, 0x70, 0xd0, 0xa5, 0x83, 0x32, 0xdb, 0xa0, 0x0d,
+	0xca, 0xed, 0x46, 0xf0, 0x4f, 0xb8, 0x2a, 0x4b, 0x0d, 0x4a, 0x1b, 0x4d, 0x62, 0x60, 0xd7, 0x32,
+	0xb0, 0xe3, 0x50, 0x86, 0x99, 0x45, 0x1d, 0x5f, 0x5a, 0xd5, 0xb8, 0x4c, 0x5c, 0x55, 0xd8, 0xa7,
+	0xb1, 0x6d, 0x39, 0xd4, 0xe0, 0xbf, 0xf2, 0x68, 0x41, 0x84, 0x54, 0x44, 0x26, 0x09, 0x22, 0x4c,
+	0x59, 0x99, 0x8b, 0x7f, 0x55, 0x5b, 0xbb, 0x06, 0xb3, 0x6c, 0xe2, 0x33, 0x6c, 0xbb, 0xc2, 0x41,
+	0x9b, 0x85, 0xe8, 0x7e, 0x70, 0x8d, 0x6d, 0xec, 0x61, 0xdb, 0x37, 0xc9, 0x5e, 0x8b, 0xf8, 0x4c,
+	0x7b, 0x08, 0x67, 0x2e, 0x9d, 0xfa, 0x2e, 0x75, 0x7c, 0x82, 0x6e, 0xc1, 0xa4, 0xcb, 0x4f, 0x32,
+	0x20, 0x07, 0xf2, 0xe9, 0xe2, 0xa2, 0x1e, 0x73, 0x6b, 0x5d, 0x04, 0x95, 0x52, 0xc7, 0x67, 0xd9,
+	0xc4, 0xdb, 0x1f, 0xef, 0x57, 0x81, 0x29, 0xa3, 0xb4, 0xbf, 0xe1, 0x1c, 0x97, 0x2d, 0x3b, 0xbb,
+	0x4d, 0x7e, 0xe9, 0x30, 0x9f, 0x03, 0xe7, 0xa3, 0x06, 0x99, 0x72, 0x07, 0xa6, 0xac, 0xf0, 0x90,
+	0x67, 0x9d, 0x28, 0xfd, 0x1f, 0x08, 0x7f, 0x39, 0xcb, 0x2e, 0x8a, 0xe4, 0x7e, 0xfd, 0xa9, 0x6e,
+	0x51, 0xc3, 0xc6, 0xec, 0xb1, 0x7e, 0x97, 0x34, 0x70, 0xad, 0xbd, 0x45, 0x6a, 0xa7, 0x47, 0xeb,
+	0x50, 0xb2, 0x6d, 0x91, 0x9a, 0xa0, 0xe8, 0x0a, 0x69, 0x2a, 0x5c, 0xe2, 0xf9, 0xee, 0x38, 0x4e,
+	0x0b, 0x37, 0xb7, 0x3d, 0xba, 0x6f, 0xf9, 0xc1, 0x1b, 0x84, 0x3c, 0x2f, 0x00, 0x5c, 0x1e, 0xe0,
+	0x20, 0xb9, 0x6a, 0x70, 0x1a, 0x73, 0x5b, 0xc5, 0xed, 0x18, 0xaf, 0xc9, 0x37, 0x85, 0x23, 0xc9,
+	0xb4, 0x37, 0x40, 0x72, 0x3e, 0x68, 0xb9, 0x6e, 0xb3, 0xbd, 0xed, 0xd1, 0x27, 0xa4, 0xd6, 0x53,
+	0x37, 0x94, 0x81, 0x7f, 0xb9, 0xc4, 0xb3, 0x68, 0x5d, 0xe4, 0x1e, 0x33, 0xc3, 0x4f, 0xb4, 0x03,
+	0x27, 0xaa, 0xd4, 0xa9, 0x93, 0x7a, 0xc5, 0x0b, 0xae, 0x9c, 0x19, 0xc9, 0x81, 0x7c, 0xaa, 0x54,
+	0x18, 0x1a, 0xcd, 0x4c, 0x0b, 0x19, 0x33, 0x50, 0xb9, 0x31, 0x7d, 0x7a, 0xb4, 0x3e, 0xf9, 0x8c,
+	0xb7, 0x63, 0x6e, 0x7f, 0x43, 0xdf, 0xd4, 0x37, 0xb4, 0x97, 0x61, 0xa9, 0xfa, 0x19, 0x65, 0xa9,
+	0x4c, 0x98, 0x76, 0x3b, 0xa7, 0x01, 0xe8, 0x68, 0x3e, 0x5d, 0xfc, 0x37, 0xb6, 0x75, 0xa2, 0x1a,
+	0xbd, 0x4d, 0xd4, 0x2b, 0x12, 0x07, 0xf2, 0x73, 0x04, 0x4e, 0x45, 0xe3, 0xd1, 0x3c, 0x4c, 0x8a,
+	0x8a, 0xf0, 0xfa, 0x8c, 0x9a, 0xf2, 0x0b, 0xdd, 0x84, 0x63, 0xc1, 0x24, 0xf0, 0xb2, 0xa4, 0x8b,
+	0x8a, 0x2e, 0xc6, 0x44, 0x0f, 0xc7, 0x44, 0xdf, 0x09, 0xc7, 0xa4, 0x34, 0x19, 0x10, 0x1c, 0x7e,
+	0xcd, 0x02, 0x41, 0xc1, 0xc3, 0x2e, 0x77, 0xe5, 0x28, 0x2f, 0xed, 0xf5, 0xbb, 0x12, 0x95, 0x61,
+	0xaa, 0xd3, 0x4c, 0x99, 0x31, 0xae, 0xba, 0x26, 0x55, 0xe7, 0xfa, 0x55, 0xcb, 0x0e, 0xeb, 0xd1,
+	0x2b, 0x3b, 0xcc, 0xec, 0x46, 0xa3, 0x7b, 0x70, 0x82, 0x51, 0x86, 0x9b, 0x15, 0x9f, 0x57, 0x24,
+	0x33, 0x3e, 0xbc, 0x5a, 0x9a, 0x0b, 0x88, 0x8a, 0xc6, 0xd4, 0xbb, 0x78, 0x30, 0x0e, 0xc7, 0xf9,
+	0xc3, 0xa3, 0xe7, 0x00, 0x26, 0xc5, 0xd0, 0xa3, 0xff, 0x62, 0x9f, 0xb5, 0x7f, 0xc3, 0x28, 0xf9,
+	0xdf, 0x3b, 0x8a, 0xf6, 0xd1, 0xfe, 0x39, 0xf8, 0xf4, 0xfd, 0xf5, 0xc8, 0x32, 0x5a, 0x34, 0xe2,
+	0x36, 0xa3, 0xd8, 0x2c, 0xe8, 0x15, 0x80, 0xa9, 0xce, 0xf2, 0x40, 0xab, 0x83, 0xc5, 0xa3, 0xab,
+	0x47, 0x59, 0xfb, 0x23, 0x5f, 0xc9, 0xb2, 0xc2, 0x59, 0x72, 0x48, 0x8d, 0x65, 0xe9, 0xbe, 0xe4,
+	0x3b, 0x00, 0xa7, 0xa2, 0xab, 0x03, 0x15, 0x06, 0x67, 0x1a, 0xb0, 0x87, 0x94, 0xe2, 0x30, 0x21,
+	0x92, 0x51, 0xe7, 0x8c, 0x79, 0xb4, 0x12, 0xcb, 0xd8, 0xb7, 0xb4, 0xd0, 0x07, 0x10, 0x33, 0x37,
+	0x57, 0xb0, 0x0e, 0xd8, 0x45, 0x4a, 0x71, 0x98, 0x10, 0xc9, 0x7a, 0xfb, 0x63, 0xb4, 0xad, 0xae,
+	0x84, 0x17, 0xcd, 0x5c, 0xe9, 0x2e, 0x82, 0xd2, 0xe6, 0xf1, 0xb9, 0x0a, 0x4e, 0xce, 0x55, 0xf0,
+	0xed, 0x5c, 0x05, 0x87, 0x17, 0x6a, 0xe2, 0xe4, 0x42, 0x4d, 0x7c, 0xbe, 0x50, 0x13, 0x8f, 0x16,
+	0x2e, 0xf5, 0xb8, 0xc8, 0x61, 0xb0, 0xb6, 0x4b, 0xfc, 0x6a, 0x92, 0x8f, 0xf9, 0xe6, 0xaf, 0x01,
+	0x00, 0x7c, 0x0d, 0xd3, 0xa1, 0xd5, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +515,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection projects the future supply of the mint denom following
+	// the configured inflation schedule.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -370,6 +555,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/SupplyProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -378,6 +572,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection projects the future supply of the mint denom following
+	// the configured inflation schedule.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -393,6 +590,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) SupplyProjection(ctx context.Context, req *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -452,6 +652,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/SupplyProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
@@ -469,6 +687,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -642,6 +864,147 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySupplyProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Provision.Size()
+		i -= size
+		if _, err := m.Provision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -713,6 +1076,55 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySupplyProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Provision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1118,6 +1530,397 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySupplyProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, SupplyProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
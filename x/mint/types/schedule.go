@@ -0,0 +1,254 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockEpochIdentifier is the special epoch identifier used to mint on every block
+// instead of on an x/epochs epoch.
+const BlockEpochIdentifier = "block"
+
+// Year is the duration of a year used to derive the duration of a minting period.
+const Year = 8766 * time.Hour
+
+// DefaultInflationSchedule returns the default inflation schedule, which mints
+// every block following the bonded ratio model.
+func DefaultInflationSchedule() InflationSchedule {
+	return InflationSchedule{
+		ScheduleType:    InflationScheduleType_INFLATION_SCHEDULE_TYPE_BONDED_RATIO,
+		EpochIdentifier: BlockEpochIdentifier,
+		Halving: HalvingSchedule{
+			AnnualProvisions: math.ZeroInt(),
+		},
+	}
+}
+
+// MintEpochIdentifier returns the epoch identifier at which the schedule mints.
+func (s InflationSchedule) MintEpochIdentifier() string {
+	if s.EpochIdentifier == "" {
+		return BlockEpochIdentifier
+	}
+
+	return s.EpochIdentifier
+}
+
+// PeriodsPerYear returns the expected number of minting periods per year.
+func (s InflationSchedule) PeriodsPerYear(params Params) uint64 {
+	if s.MintEpochIdentifier() == BlockEpochIdentifier {
+		return params.BlocksPerYear
+	}
+
+	return s.EpochsPerYear
+}
+
+// PeriodDuration returns the expected duration of a minting period.
+func (s InflationSchedule) PeriodDuration(params Params) time.Duration {
+	periods := s.PeriodsPerYear(params)
+	if periods == 0 {
+		return 0
+	}
+
+	return Year / time.Duration(periods)
+}
+
+// NextMinter returns the minter updated for the given minting period along
+// with the provision to mint during that period.
+// The bonded ratio model uses the given inflation calculation function, while
+// the other schedules only depend on their parameters.
+func (s InflationSchedule) NextMinter(
+	ctx context.Context,
+	ic InflationCalculationFn,
+	minter Minter,
+	params Params,
+	totalSupply math.Int,
+	bondedRatio math.LegacyDec,
+	period int64,
+	blockTime time.Time,
+) (Minter, sdk.Coin) {
+	// the bonded ratio model and the block provisions are expressed per block,
+	// so the params are adjusted to reflect the number of periods per year.
+	periodParams := params
+	periodParams.BlocksPerYear = s.PeriodsPerYear(params)
+
+	switch s.ScheduleType {
+	case InflationScheduleType_INFLATION_SCHEDULE_TYPE_HALVING:
+		minter.AnnualProvisions = math.LegacyNewDecFromInt(s.Halving.AnnualProvisionsAt(period))
+		if totalSupply.IsPositive() {
+			minter.Inflation = minter.AnnualProvisions.QuoInt(totalSupply)
+		}
+	case InflationScheduleType_INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR:
+		minter.Inflation = s.PiecewiseLinear.InflationAt(blockTime)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(periodParams, totalSupply)
+	default:
+		minter.Inflation = ic(ctx, minter, periodParams, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(periodParams, totalSupply)
+	}
+
+	minter.Period = period
+	return minter, minter.BlockProvision(periodParams)
+}
+
+// Validate performs a basic validation of the inflation schedule.
+func (s InflationSchedule) Validate() error {
+	if _, ok := InflationScheduleType_name[int32(s.ScheduleType)]; !ok {
+		return fmt.Errorf("unknown inflation schedule type: %d", s.ScheduleType)
+	}
+
+	if s.MintEpochIdentifier() != BlockEpochIdentifier {
+		if strings.TrimSpace(s.EpochIdentifier) == "" {
+			return errors.New("inflation schedule epoch identifier cannot be blank")
+		}
+		if s.EpochsPerYear == 0 {
+			return fmt.Errorf("inflation schedule epochs per year must be positive when minting on epoch %s", s.EpochIdentifier)
+		}
+	}
+
+	switch s.ScheduleType {
+	case InflationScheduleType_INFLATION_SCHEDULE_TYPE_HALVING:
+		return s.Halving.Validate()
+	case InflationScheduleType_INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR:
+		return s.PiecewiseLinear.Validate()
+	default:
+		return nil
+	}
+}
+
+// AnnualProvisionsAt returns the annual provisions during the given minting period.
+// Periods are counted from 1, the provisions being halved after every halving interval.
+func (h HalvingSchedule) AnnualProvisionsAt(period int64) math.Int {
+	if h.AnnualProvisions.IsNil() || h.HalvingInterval == 0 {
+		return math.ZeroInt()
+	}
+
+	halvings := uint64(0)
+	if period > 0 {
+		halvings = uint64(period-1) / h.HalvingInterval
+	}
+	// past 256 halvings any representable amount is zero
+	if halvings >= 256 {
+		return math.ZeroInt()
+	}
+
+	return math.NewIntFromBigInt(new(big.Int).Rsh(h.AnnualProvisions.BigInt(), uint(halvings)))
+}
+
+// Validate performs a basic validation of the halving schedule.
+func (h HalvingSchedule) Validate() error {
+	if h.AnnualProvisions.IsNil() {
+		return errors.New("halving annual provisions cannot be nil")
+	}
+	if h.AnnualProvisions.IsNegative() {
+		return fmt.Errorf("halving annual provisions cannot be negative: %s", h.AnnualProvisions)
+	}
+	if h.HalvingInterval == 0 {
+		return errors.New("halving interval must be positive")
+	}
+
+	return nil
+}
+
+// InflationAt returns the target inflation rate at the given time.
+func (p PiecewiseLinearSchedule) InflationAt(t time.Time) math.LegacyDec {
+	if len(p.Points) == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	first, last := p.Points[0], p.Points[len(p.Points)-1]
+	if !t.After(first.Time) {
+		return first.Inflation
+	}
+	if !t.Before(last.Time) {
+		return last.Inflation
+	}
+
+	for i := 1; i < len(p.Points); i++ {
+		start, end := p.Points[i-1], p.Points[i]
+		if t.After(end.Time) {
+			continue
+		}
+
+		// start + (end - start) * elapsed / span
+		elapsed := math.LegacyNewDec(t.Sub(start.Time).Nanoseconds())
+		span := math.LegacyNewDec(end.Time.Sub(start.Time).Nanoseconds())
+		return start.Inflation.Add(end.Inflation.Sub(start.Inflation).Mul(elapsed).Quo(span))
+	}
+
+	return last.Inflation
+}
+
+// Validate performs a basic validation of the piecewise-linear schedule.
+func (p PiecewiseLinearSchedule) Validate() error {
+	if len(p.Points) == 0 {
+		return errors.New("piecewise linear schedule must have at least one point")
+	}
+
+	for i, point := range p.Points {
+		if point.Inflation.IsNil() {
+			return fmt.Errorf("piecewise linear schedule point %d inflation cannot be nil", i)
+		}
+		if point.Inflation.IsNegative() || point.Inflation.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("piecewise linear schedule point %d inflation must be between 0 and 1: %s", i, point.Inflation)
+		}
+		if i > 0 && !point.Time.After(p.Points[i-1].Time) {
+			return fmt.Errorf("piecewise linear schedule points must be strictly ordered by time: point %d at %s", i, point.Time)
+		}
+	}
+
+	return nil
+}
+
+// MaxSupplyProjectionPeriods is the maximum number of minting periods that can be
+// projected by a single supply projection.
+const MaxSupplyProjectionPeriods = 10000
+
+// ProjectSupply projects the supply for the given number of minting periods following
+// the inflation schedule in the params. The bonded ratio model is projected using the
+// default inflation calculation and a constant bonded ratio, and the max supply is
+// enforced like the default mint function does.
+func ProjectSupply(
+	ctx context.Context,
+	minter Minter,
+	params Params,
+	totalSupply math.Int,
+	bondedRatio math.LegacyDec,
+	now time.Time,
+	periods uint64,
+) []SupplyProjection {
+	schedule := params.InflationSchedule
+	duration := schedule.PeriodDuration(params)
+
+	lastPeriod := minter.Period
+	projections := make([]SupplyProjection, 0, periods)
+	for i := uint64(1); i <= periods; i++ {
+		period := lastPeriod + int64(i)
+		periodTime := now.Add(duration * time.Duration(i))
+
+		var provision sdk.Coin
+		minter, provision = schedule.NextMinter(ctx, DefaultInflationCalculationFn, minter, params, totalSupply, bondedRatio, period, periodTime)
+
+		amount := provision.Amount
+		if !params.MaxSupply.IsZero() && totalSupply.Add(amount).GT(params.MaxSupply) {
+			amount = math.MaxInt(params.MaxSupply.Sub(totalSupply), math.ZeroInt())
+		}
+		totalSupply = totalSupply.Add(amount)
+
+		projections = append(projections, SupplyProjection{
+			Period:      period,
+			Time:        periodTime,
+			Inflation:   minter.Inflation,
+			Provision:   amount,
+			TotalSupply: totalSupply,
+		})
+	}
+
+	return projections
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestHalvingAnnualProvisionsAt(t *testing.T) {
+	halving := HalvingSchedule{AnnualProvisions: math.NewInt(1000), HalvingInterval: 10}
+
+	tests := []struct {
+		period int64
+		exp    math.Int
+	}{
+		{-1, math.NewInt(1000)},
+		{0, math.NewInt(1000)},
+		{1, math.NewInt(1000)},
+		{10, math.NewInt(1000)},
+		{11, math.NewInt(500)},
+		{20, math.NewInt(500)},
+		{21, math.NewInt(250)},
+		{101, math.NewInt(0)},
+		{1 << 62, math.NewInt(0)},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.exp, halving.AnnualProvisionsAt(tc.period), "period %d", tc.period)
+	}
+}
+
+func TestPiecewiseLinearInflationAt(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := PiecewiseLinearSchedule{Points: []InflationPoint{
+		{Time: start, Inflation: math.LegacyNewDecWithPrec(10, 2)},
+		{Time: start.Add(100 * time.Hour), Inflation: math.LegacyNewDecWithPrec(5, 2)},
+		{Time: start.Add(200 * time.Hour), Inflation: math.LegacyNewDecWithPrec(7, 2)},
+	}}
+
+	tests := []struct {
+		at  time.Time
+		exp math.LegacyDec
+	}{
+		{start.Add(-time.Hour), math.LegacyNewDecWithPrec(10, 2)},
+		{start, math.LegacyNewDecWithPrec(10, 2)},
+		{start.Add(50 * time.Hour), math.LegacyNewDecWithPrec(75, 3)},
+		{start.Add(100 * time.Hour), math.LegacyNewDecWithPrec(5, 2)},
+		{start.Add(150 * time.Hour), math.LegacyNewDecWithPrec(6, 2)},
+		{start.Add(300 * time.Hour), math.LegacyNewDecWithPrec(7, 2)},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.exp, schedule.InflationAt(tc.at), "at %s", tc.at)
+	}
+}
+
+func TestInflationScheduleValidate(t *testing.T) {
+	require.NoError(t, DefaultInflationSchedule().Validate())
+	require.NoError(t, InflationSchedule{}.Validate())
+
+	schedule := DefaultInflationSchedule()
+	schedule.ScheduleType = 42
+	require.Error(t, schedule.Validate())
+
+	schedule = DefaultInflationSchedule()
+	schedule.EpochIdentifier = "day"
+	require.Error(t, schedule.Validate())
+	schedule.EpochsPerYear = 365
+	require.NoError(t, schedule.Validate())
+
+	schedule.ScheduleType = InflationScheduleType_INFLATION_SCHEDULE_TYPE_HALVING
+	require.Error(t, schedule.Validate())
+	schedule.Halving.HalvingInterval = 1461
+	require.NoError(t, schedule.Validate())
+	schedule.Halving.AnnualProvisions = math.NewInt(-1)
+	require.Error(t, schedule.Validate())
+
+	schedule.ScheduleType = InflationScheduleType_INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR
+	require.Error(t, schedule.Validate())
+	now := time.Now()
+	schedule.PiecewiseLinear.Points = []InflationPoint{
+		{Time: now, Inflation: math.LegacyNewDecWithPrec(10, 2)},
+		{Time: now, Inflation: math.LegacyNewDecWithPrec(5, 2)},
+	}
+	require.Error(t, schedule.Validate())
+	schedule.PiecewiseLinear.Points[1].Time = now.Add(time.Hour)
+	require.NoError(t, schedule.Validate())
+	schedule.PiecewiseLinear.Points[1].Inflation = math.LegacyNewDec(2)
+	require.Error(t, schedule.Validate())
+}
+
+func TestProjectSupply(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = InflationSchedule{
+		ScheduleType:    InflationScheduleType_INFLATION_SCHEDULE_TYPE_HALVING,
+		EpochIdentifier: "day",
+		EpochsPerYear:   10,
+		Halving:         HalvingSchedule{AnnualProvisions: math.NewInt(1000), HalvingInterval: 2},
+	}
+	params.MaxSupply = math.NewInt(10260)
+
+	minter := DefaultInitialMinter()
+	minter.Period = 1
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	projections := ProjectSupply(context.Background(), minter, params, math.NewInt(10000), params.GoalBonded, now, 5)
+	require.Len(t, projections, 5)
+
+	expProvisions := []int64{100, 50, 50, 25, 25}
+	expSupply := []int64{10100, 10150, 10200, 10225, 10250}
+	for i, p := range projections {
+		require.Equal(t, int64(i+2), p.Period)
+		require.Equal(t, now.Add(time.Duration(i+1)*Year/10), p.Time)
+		require.Equal(t, math.NewInt(expProvisions[i]), p.Provision)
+		require.Equal(t, math.NewInt(expSupply[i]), p.TotalSupply)
+	}
+
+	// the max supply caps the projected supply
+	projections = ProjectSupply(context.Background(), minter, params, math.NewInt(10000), params.GoalBonded, now, 7)
+	require.Equal(t, math.NewInt(10), projections[5].Provision)
+	require.Equal(t, math.ZeroInt(), projections[6].Provision)
+	require.Equal(t, params.MaxSupply, projections[6].TotalSupply)
+}
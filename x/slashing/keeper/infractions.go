@@ -7,6 +7,7 @@ import (
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/event"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -145,14 +146,18 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		// This is acceptable since it's only used to filter unbonding delegations & redelegations.
 		distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-		slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
-		if err != nil {
-			return err
-		}
-
-		coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
-		if err != nil {
-			return err
+		// The punishment escalates with the number of recent downtime offenses,
+		// as configured by the downtime tiers.
+		blockTime := k.HeaderService.HeaderInfo(ctx).Time
+		offenses := signInfo.RecordDowntimeOffense(blockTime, params.DowntimeOffenseDecay)
+		slashFractionDowntime, downtimeJailDur := params.DowntimePunishment(offenses)
+
+		coinsBurned := sdkmath.ZeroInt()
+		if slashFractionDowntime.IsPositive() {
+			coinsBurned, err = k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
+			if err != nil {
+				return err
+			}
 		}
 
 		if err := k.EventService.EventManager(ctx).EmitKV(
@@ -162,6 +167,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			event.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 			event.NewAttribute(types.AttributeKeyJailed, consStr),
 			event.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+			event.NewAttribute(types.AttributeKeyDowntimeOffenses, fmt.Sprintf("%d", offenses)),
 		); err != nil {
 			return err
 		}
@@ -170,11 +176,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		if err != nil {
 			return err
 		}
-		downtimeJailDur, err := k.DowntimeJailDuration(ctx)
-		if err != nil {
-			return err
-		}
-		signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time.Add(downtimeJailDur)
+		signInfo.JailedUntil = blockTime.Add(downtimeJailDur)
 
 		// We need to reset the counter & bitmap so that the validator won't be
 		// immediately slashed for downtime upon re-bonding.
@@ -194,6 +196,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			"threshold", minSignedPerWindow,
 			"slashed", slashFractionDowntime.String(),
 			"jailed_until", signInfo.JailedUntil,
+			"downtime_offenses", offenses,
 		)
 	}
 
@@ -4,9 +4,11 @@ import (
 	"time"
 
 	gogoany "github.com/cosmos/gogoproto/types/any"
+	"go.uber.org/mock/gomock"
 
 	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
@@ -125,3 +127,72 @@ func (s *KeeperTestSuite) TestKeeper_HandleValidatorSignature() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestKeeper_HandleValidatorSignatureDowntimeTiers() {
+	_, edPubKey, valAddr := testdata.KeyTestPubAddrED25519()
+	valStrAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	s.Require().NoError(err)
+	consStrAddr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(valAddr)
+	s.Require().NoError(err)
+
+	vpk, err := gogoany.NewAnyWithCacheWithValue(edPubKey)
+	s.Require().NoError(err)
+	validator := stakingtypes.Validator{
+		OperatorAddress: valStrAddr,
+		ConsensusPubkey: vpk,
+		Status:          stakingtypes.Bonded,
+		Tokens:          math.NewInt(100),
+		DelegatorShares: math.LegacyNewDec(100),
+	}
+	consAddr := sdk.ConsAddress(edPubKey.Address())
+
+	params, err := s.slashingKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.DowntimeTiers = []types.DowntimeTier{
+		{MinOffenses: 1, SlashFraction: math.LegacyZeroDec(), JailDuration: 10 * time.Minute},
+		{MinOffenses: 2, SlashFraction: math.LegacyNewDecWithPrec(1, 2), JailDuration: time.Hour},
+		{MinOffenses: 3, SlashFraction: math.LegacyNewDecWithPrec(5, 2), JailDuration: 24 * time.Hour},
+	}
+	params.DowntimeOffenseDecay = 24 * time.Hour
+	s.Require().NoError(s.slashingKeeper.Params.Set(s.ctx, params))
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil).AnyTimes()
+
+	start := s.ctx.HeaderInfo().Time
+	missBlocks := func(blockTime time.Time) types.ValidatorSigningInfo {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: 2000, Time: blockTime})
+
+		signInfo, err := s.slashingKeeper.ValidatorSigningInfo.Get(ctx, consAddr)
+		if err != nil {
+			signInfo = types.NewValidatorSigningInfo(consStrAddr, 0, time.Time{}, false, 0)
+		}
+		signInfo.MissedBlocksCounter = 501
+		s.Require().NoError(s.slashingKeeper.ValidatorSigningInfo.Set(ctx, consAddr, signInfo))
+
+		s.Require().NoError(s.slashingKeeper.HandleValidatorSignature(ctx, edPubKey.Address(), 0, comet.BlockIDFlagAbsent))
+
+		signInfo, err = s.slashingKeeper.ValidatorSigningInfo.Get(ctx, consAddr)
+		s.Require().NoError(err)
+		return signInfo
+	}
+
+	// first offense only jails the validator
+	signInfo := missBlocks(start)
+	s.Require().Equal(int64(1), signInfo.DowntimeOffenses)
+	s.Require().Equal(start.Add(10*time.Minute), signInfo.JailedUntil)
+
+	// second offense slashes and jails for longer
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, int64(1998), int64(0), math.LegacyNewDecWithPrec(1, 2), stakingv1beta1.Infraction_INFRACTION_DOWNTIME).Return(math.NewInt(1), nil).Times(1)
+	signInfo = missBlocks(start.Add(time.Hour))
+	s.Require().Equal(int64(2), signInfo.DowntimeOffenses)
+	s.Require().Equal(start.Add(2*time.Hour), signInfo.JailedUntil)
+
+	// the offenses history decays over time
+	now := start.Add(time.Hour + 48*time.Hour)
+	signInfo = missBlocks(now)
+	s.Require().Equal(int64(1), signInfo.DowntimeOffenses)
+	s.Require().Equal(now, signInfo.LastDowntimeOffense)
+	s.Require().Equal(now.Add(10*time.Minute), signInfo.JailedUntil)
+}
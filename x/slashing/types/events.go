@@ -5,13 +5,14 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
-	AttributeKeyAddress      = "address"
-	AttributeKeyHeight       = "height"
-	AttributeKeyPower        = "power"
-	AttributeKeyReason       = "reason"
-	AttributeKeyJailed       = "jailed"
-	AttributeKeyMissedBlocks = "missed_blocks"
-	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyAddress          = "address"
+	AttributeKeyHeight           = "height"
+	AttributeKeyPower            = "power"
+	AttributeKeyReason           = "reason"
+	AttributeKeyJailed           = "jailed"
+	AttributeKeyMissedBlocks     = "missed_blocks"
+	AttributeKeyBurnedCoins      = "burned_coins"
+	AttributeKeyDowntimeOffenses = "downtime_offenses"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
@@ -61,6 +61,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeTiers(p.DowntimeTiers); err != nil {
+		return err
+	}
+	if err := validateDowntimeOffenseDecay(p.DowntimeOffenseDecay); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +153,65 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateDowntimeTiers(i interface{}) error {
+	v, ok := i.([]DowntimeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, tier := range v {
+		if tier.MinOffenses <= 0 {
+			return fmt.Errorf("downtime tier %d min offenses must be positive: %d", idx, tier.MinOffenses)
+		}
+		if idx > 0 && tier.MinOffenses <= v[idx-1].MinOffenses {
+			return fmt.Errorf("downtime tiers must be sorted by strictly increasing min offenses: tier %d has %d", idx, tier.MinOffenses)
+		}
+		if tier.SlashFraction.IsNil() {
+			return fmt.Errorf("downtime tier %d slash fraction cannot be nil: %s", idx, tier.SlashFraction)
+		}
+		if tier.SlashFraction.IsNegative() {
+			return fmt.Errorf("downtime tier %d slash fraction cannot be negative: %s", idx, tier.SlashFraction)
+		}
+		if tier.SlashFraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("downtime tier %d slash fraction too large: %s", idx, tier.SlashFraction)
+		}
+		if tier.JailDuration <= 0 {
+			return fmt.Errorf("downtime tier %d jail duration must be positive: %s", idx, tier.JailDuration)
+		}
+	}
+
+	return nil
+}
+
+func validateDowntimeOffenseDecay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offense decay cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// DowntimePunishment returns the slash fraction and jail duration of a downtime
+// offense for a validator with the given number of recent downtime offenses,
+// including the current one. The tier with the highest applicable min offenses is
+// used, falling back to SlashFractionDowntime and DowntimeJailDuration.
+func (p Params) DowntimePunishment(offenses int64) (math.LegacyDec, time.Duration) {
+	slashFraction, jailDuration := p.SlashFractionDowntime, p.DowntimeJailDuration
+	for _, tier := range p.DowntimeTiers {
+		if tier.MinOffenses > offenses {
+			break
+		}
+		slashFraction, jailDuration = tier.SlashFraction, tier.JailDuration
+	}
+
+	return slashFraction, jailDuration
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -17,3 +17,18 @@ func NewValidatorSigningInfo(
 		MissedBlocksCounter: missedBlocksCounter,
 	}
 }
+
+// RecordDowntimeOffense decays the recent downtime offenses of the validator by
+// one for every full decay period elapsed since the last offense, then records a
+// new offense at the given time. A zero decay disables the decay.
+// It returns the number of recent downtime offenses, including the new one.
+func (i *ValidatorSigningInfo) RecordDowntimeOffense(now time.Time, decay time.Duration) int64 {
+	if decay > 0 && i.DowntimeOffenses > 0 && now.After(i.LastDowntimeOffense) {
+		forgiven := int64(now.Sub(i.LastDowntimeOffense) / decay)
+		i.DowntimeOffenses = max(i.DowntimeOffenses-forgiven, 0)
+	}
+
+	i.DowntimeOffenses++
+	i.LastDowntimeOffense = now
+	return i.DowntimeOffenses
+}
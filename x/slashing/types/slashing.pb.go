@@ -50,6 +50,12 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Number of recent downtime offenses as of last_downtime_offense. It is
+	// decayed according to the downtime_offense_decay param when a new offense is
+	// recorded, and selects the applicable downtime tier.
+	DowntimeOffenses int64 `protobuf:"varint,7,opt,name=downtime_offenses,json=downtimeOffenses,proto3" json:"downtime_offenses,omitempty"`
+	// Timestamp of the last downtime offense.
+	LastDowntimeOffense time.Time `protobuf:"bytes,8,opt,name=last_downtime_offense,json=lastDowntimeOffense,proto3,stdtime" json:"last_downtime_offense"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -128,6 +134,20 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeOffenses() int64 {
+	if m != nil {
+		return m.DowntimeOffenses
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetLastDowntimeOffense() time.Time {
+	if m != nil {
+		return m.LastDowntimeOffense
+	}
+	return time.Time{}
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,6 +155,14 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// downtime_tiers escalates the downtime punishment with the number of recent
+	// downtime offenses of a validator. When empty, or when no tier applies,
+	// slash_fraction_downtime and downtime_jail_duration are used.
+	DowntimeTiers []DowntimeTier `protobuf:"bytes,6,rep,name=downtime_tiers,json=downtimeTiers,proto3" json:"downtime_tiers"`
+	// downtime_offense_decay is the duration after which a downtime offense is
+	// forgiven: every full period elapsed since the last offense decreases the
+	// number of recent offenses by one. A zero value disables the decay.
+	DowntimeOffenseDecay time.Duration `protobuf:"bytes,7,opt,name=downtime_offense_decay,json=downtimeOffenseDecay,proto3,stdduration" json:"downtime_offense_decay"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +212,84 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeTiers() []DowntimeTier {
+	if m != nil {
+		return m.DowntimeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetDowntimeOffenseDecay() time.Duration {
+	if m != nil {
+		return m.DowntimeOffenseDecay
+	}
+	return 0
+}
+
+// DowntimeTier defines the punishment of a downtime offense for validators
+// with a given number of recent downtime offenses.
+type DowntimeTier struct {
+	// min_offenses is the number of recent downtime offenses, including the
+	// current one, from which the tier applies.
+	MinOffenses int64 `protobuf:"varint,1,opt,name=min_offenses,json=minOffenses,proto3" json:"min_offenses,omitempty"`
+	// slash_fraction is the fraction of the validator stake slashed. A zero
+	// value only jails the validator.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// jail_duration is the duration for which the validator is jailed.
+	JailDuration time.Duration `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *DowntimeTier) Reset()         { *m = DowntimeTier{} }
+func (m *DowntimeTier) String() string { return proto.CompactTextString(m) }
+func (*DowntimeTier) ProtoMessage()    {}
+func (*DowntimeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *DowntimeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeTier.Merge(m, src)
+}
+func (m *DowntimeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeTier proto.InternalMessageInfo
+
+func (m *DowntimeTier) GetMinOffenses() int64 {
+	if m != nil {
+		return m.MinOffenses
+	}
+	return 0
+}
+
+func (m *DowntimeTier) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*DowntimeTier)(nil), "cosmos.slashing.v1beta1.DowntimeTier")
 }
 
 func init() {
@@ -194,47 +297,58 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x23, 0x5b, 0x4e, 0x4f, 0x52, 0x50, 0x5f, 0xa4, 0x98, 0x56, 0x6b, 0x4a, 0x16, 0x90,
+	0x42, 0x08, 0x20, 0xd2, 0x51, 0x80, 0x0e, 0xce, 0x54, 0x46, 0x28, 0xda, 0x22, 0x45, 0x02, 0x39,
+	0x6d, 0x81, 0x02, 0x2d, 0x71, 0x22, 0x4f, 0xd4, 0xd5, 0xe4, 0x9d, 0xc0, 0x3b, 0xc5, 0xf1, 0x5f,
+	0xe8, 0x94, 0xb1, 0x63, 0x47, 0x8f, 0x1e, 0xfc, 0x23, 0x3c, 0x1a, 0x9e, 0x0a, 0x0f, 0x6e, 0x21,
+	0x03, 0x75, 0x7f, 0x41, 0xb7, 0x02, 0x05, 0xef, 0x48, 0x5a, 0x92, 0x15, 0x78, 0xd0, 0x22, 0x88,
+	0xef, 0x7d, 0xef, 0xbd, 0xfb, 0xbe, 0xf7, 0xdd, 0x81, 0xcf, 0x5c, 0xc6, 0x43, 0xc6, 0x2d, 0x1e,
+	0x20, 0x3e, 0x24, 0xd4, 0xb7, 0xde, 0x3e, 0xed, 0x63, 0x81, 0x9e, 0x66, 0x01, 0x73, 0x14, 0x31,
+	0xc1, 0xe0, 0x86, 0xc2, 0x99, 0x59, 0x38, 0xc1, 0xd5, 0x2a, 0x3e, 0xf3, 0x99, 0xc4, 0x58, 0xf1,
+	0x3f, 0x05, 0xaf, 0x19, 0x3e, 0x63, 0x7e, 0x80, 0x2d, 0xf9, 0xd5, 0x1f, 0x0f, 0x2c, 0x6f, 0x1c,
+	0x21, 0x41, 0x18, 0x4d, 0xf2, 0xf5, 0xf9, 0xbc, 0x20, 0x21, 0xe6, 0x02, 0x85, 0xa3, 0x04, 0xb0,
+	0xa9, 0xe6, 0x39, 0xaa, 0x73, 0x32, 0x5c, 0xa5, 0xd6, 0x51, 0x48, 0x28, 0xb3, 0xe4, 0xaf, 0x0a,
+	0x35, 0xff, 0xcb, 0x83, 0xca, 0xf7, 0x28, 0x20, 0x1e, 0x12, 0x2c, 0xda, 0x23, 0x3e, 0x25, 0xd4,
+	0xff, 0x9a, 0x0e, 0x18, 0x7c, 0x0e, 0xd6, 0x90, 0xe7, 0x45, 0x98, 0x73, 0x5d, 0x6b, 0x68, 0xad,
+	0x8f, 0xec, 0xed, 0xf3, 0x93, 0xf6, 0x56, 0xd2, 0xee, 0x05, 0xa3, 0x1c, 0x53, 0x3e, 0xe6, 0x5f,
+	0x28, 0xc8, 0x9e, 0x88, 0x08, 0xf5, 0x7b, 0x69, 0x05, 0xdc, 0x06, 0x25, 0x2e, 0x50, 0x24, 0x9c,
+	0x21, 0x26, 0xfe, 0x50, 0xe8, 0xf7, 0x1a, 0x5a, 0x2b, 0xdf, 0x2b, 0xca, 0xd8, 0x57, 0x32, 0x04,
+	0x1f, 0x83, 0x12, 0xa1, 0x1e, 0x7e, 0xe7, 0xb0, 0xc1, 0x80, 0x63, 0xa1, 0xe7, 0x63, 0x88, 0x7d,
+	0x4f, 0xd7, 0x7a, 0x45, 0x19, 0x7f, 0x25, 0xc3, 0xf0, 0x25, 0x28, 0xfd, 0x82, 0x48, 0x80, 0x3d,
+	0x67, 0x4c, 0x05, 0x09, 0xf4, 0x95, 0x86, 0xd6, 0x2a, 0x76, 0x6a, 0xa6, 0x52, 0xc1, 0x4c, 0x55,
+	0x30, 0xdf, 0xa4, 0x2a, 0xd8, 0xe5, 0xd3, 0xcb, 0x7a, 0xee, 0xfd, 0x9f, 0x75, 0xed, 0xe8, 0xfa,
+	0xf8, 0x89, 0xd6, 0x2b, 0xaa, 0xf2, 0xef, 0xe2, 0x6a, 0x68, 0x00, 0x20, 0x58, 0xd8, 0xe7, 0x82,
+	0x51, 0xec, 0xe9, 0xab, 0x0d, 0xad, 0x75, 0xbf, 0x37, 0x15, 0x81, 0x1d, 0x50, 0x0d, 0x09, 0xe7,
+	0xd8, 0x73, 0xfa, 0x01, 0x73, 0xf7, 0xb9, 0xe3, 0xb2, 0x31, 0x15, 0x38, 0xd2, 0x0b, 0x92, 0xc0,
+	0x43, 0x95, 0xb4, 0x65, 0xee, 0x85, 0x4a, 0x41, 0x1b, 0xac, 0x7b, 0xec, 0x80, 0xc6, 0x6b, 0x88,
+	0xb9, 0x60, 0xca, 0x31, 0xd7, 0xd7, 0x24, 0x9b, 0xea, 0xc5, 0x49, 0x7b, 0xfd, 0x5d, 0x66, 0x88,
+	0xc6, 0xdb, 0x1d, 0xf3, 0x99, 0xb9, 0xd3, 0xfb, 0x38, 0xc5, 0xbf, 0x4a, 0xe0, 0x30, 0x02, 0xd5,
+	0x00, 0x71, 0xe1, 0xcc, 0x37, 0xd2, 0xef, 0xdf, 0x49, 0xb7, 0x99, 0xd2, 0x5d, 0x38, 0x4b, 0x69,
+	0xf0, 0x30, 0x6e, 0xde, 0x9d, 0x1d, 0xba, 0xbb, 0xf2, 0xcf, 0xef, 0x75, 0xad, 0xf9, 0xf7, 0x2a,
+	0x28, 0xbc, 0x46, 0x11, 0x0a, 0x39, 0xdc, 0x01, 0x15, 0x4e, 0x7c, 0x7a, 0x43, 0xfe, 0x80, 0x50,
+	0x8f, 0x1d, 0xc8, 0xf5, 0xe7, 0x7b, 0x50, 0xe5, 0x14, 0xf7, 0x1f, 0x64, 0x06, 0x92, 0x58, 0x2e,
+	0xea, 0x24, 0x55, 0x23, 0x1c, 0xa5, 0x25, 0xf1, 0xbe, 0x4b, 0xf6, 0xe7, 0xf1, 0xd1, 0x2e, 0x2e,
+	0xeb, 0x9f, 0x28, 0xd7, 0x70, 0x6f, 0xdf, 0x24, 0xcc, 0x0a, 0x91, 0x18, 0x9a, 0x2f, 0xb1, 0x8f,
+	0xdc, 0xc3, 0x2e, 0x76, 0xcf, 0x4f, 0xda, 0x40, 0xa5, 0xcd, 0x2e, 0x76, 0xd5, 0x71, 0x61, 0x48,
+	0xe8, 0x9e, 0xec, 0xf9, 0x1a, 0x47, 0xc9, 0xa8, 0x9f, 0xc1, 0xa3, 0x4c, 0x9c, 0x78, 0xa3, 0x4e,
+	0x7a, 0x2d, 0xa4, 0x71, 0x8a, 0x9d, 0xcd, 0x5b, 0x12, 0x75, 0x13, 0x80, 0x32, 0xc4, 0x6f, 0x99,
+	0x21, 0x2a, 0x69, 0x9f, 0x6f, 0x10, 0x09, 0x52, 0x10, 0xe4, 0xa0, 0x26, 0xa5, 0x73, 0x06, 0x11,
+	0x72, 0xe3, 0x88, 0xe3, 0xb1, 0x71, 0x3f, 0xc0, 0x92, 0x9c, 0xbe, 0xb2, 0x14, 0x9f, 0x0d, 0xd9,
+	0xf9, 0xcb, 0xa4, 0x71, 0x57, 0xf6, 0x8d, 0xf9, 0x41, 0x0a, 0x36, 0x6e, 0x0d, 0x55, 0x67, 0xd3,
+	0x57, 0x97, 0x9a, 0x58, 0x9d, 0x9b, 0xa8, 0x9a, 0x42, 0x1f, 0x3c, 0xc8, 0x44, 0x14, 0x04, 0x47,
+	0x5c, 0x2f, 0x34, 0xf2, 0xad, 0x62, 0xe7, 0xb1, 0xf9, 0x81, 0x37, 0xca, 0x4c, 0x4b, 0xdf, 0x10,
+	0x1c, 0xd9, 0x9b, 0xf2, 0x34, 0x0b, 0x2d, 0x5d, 0xf6, 0xa6, 0x80, 0x1c, 0x52, 0xf0, 0x68, 0xde,
+	0xca, 0x8e, 0x87, 0x5d, 0x74, 0xa8, 0xaf, 0xdd, 0xb5, 0xad, 0xad, 0x74, 0x5b, 0x8b, 0x07, 0x55,
+	0xe6, 0xee, 0x4e, 0x37, 0xee, 0xba, 0xbb, 0xfd, 0xeb, 0xf5, 0xf1, 0x93, 0x4f, 0x15, 0x89, 0x36,
+	0xf7, 0xf6, 0xad, 0x9b, 0x42, 0x4b, 0xb9, 0xbb, 0xf9, 0xaf, 0x06, 0x4a, 0xd3, 0x6c, 0xe2, 0x37,
+	0x2a, 0x36, 0x6f, 0x76, 0x65, 0x95, 0xcd, 0x8b, 0x21, 0xa1, 0xd9, 0xb5, 0xfc, 0x09, 0x3c, 0x98,
+	0xdd, 0xcf, 0x92, 0xc6, 0x2e, 0xcf, 0xac, 0x05, 0x7e, 0x0b, 0xca, 0xcb, 0x59, 0x59, 0x3e, 0x8d,
+	0x69, 0x72, 0xb7, 0x7a, 0xbe, 0x48, 0x31, 0xfb, 0xf9, 0xd1, 0xc4, 0xd0, 0x4e, 0x27, 0x86, 0x76,
+	0x36, 0x31, 0xb4, 0xbf, 0x26, 0x86, 0xf6, 0xfe, 0xca, 0xc8, 0x9d, 0x5d, 0x19, 0xb9, 0x3f, 0xae,
+	0x8c, 0xdc, 0x8f, 0x5b, 0x33, 0x14, 0xa6, 0x64, 0x13, 0x87, 0x23, 0xcc, 0xfb, 0x05, 0x79, 0x86,
+	0x67, 0xff, 0x0f, 0x00, 0x01, 0x91, 0x63, 0xb3, 0xed, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -274,6 +388,12 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeOffenses != that1.DowntimeOffenses {
+		return false
+	}
+	if !this.LastDowntimeOffense.Equal(that1.LastDowntimeOffense) {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -310,6 +430,47 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.DowntimeTiers) != len(that1.DowntimeTiers) {
+		return false
+	}
+	for i := range this.DowntimeTiers {
+		if !this.DowntimeTiers[i].Equal(&that1.DowntimeTiers[i]) {
+			return false
+		}
+	}
+	if this.DowntimeOffenseDecay != that1.DowntimeOffenseDecay {
+		return false
+	}
+	return true
+}
+func (this *DowntimeTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeTier)
+	if !ok {
+		that2, ok := that.(DowntimeTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinOffenses != that1.MinOffenses {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -332,6 +493,19 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastDowntimeOffense, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDowntimeOffense):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.DowntimeOffenses != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeOffenses))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -347,12 +521,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -395,6 +569,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeOffenseDecay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenseDecay):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DowntimeTiers) > 0 {
+		for iNdEx := len(m.DowntimeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +611,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -441,6 +637,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinOffenses != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MinOffenses))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -476,6 +718,11 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeOffenses != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeOffenses))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDowntimeOffense)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -496,6 +743,30 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.DowntimeTiers) > 0 {
+		for _, e := range m.DowntimeTiers {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenseDecay)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DowntimeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinOffenses != 0 {
+		n += 1 + sovSlashing(uint64(m.MinOffenses))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -676,6 +947,58 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenses", wireType)
+			}
+			m.DowntimeOffenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeOffenses |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeOffense", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastDowntimeOffense, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -877,6 +1200,208 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeTiers = append(m.DowntimeTiers, DowntimeTier{})
+			if err := m.DowntimeTiers[len(m.DowntimeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeOffenseDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOffenses", wireType)
+			}
+			m.MinOffenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOffenses |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
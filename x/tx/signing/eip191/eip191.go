@@ -0,0 +1,66 @@
+// Package eip191 implements the SIGN_MODE_EIP_191 sign mode, which allows to
+// sign transactions with Ethereum wallets.
+//
+// The sign bytes are the SIGN_MODE_LEGACY_AMINO_JSON sign doc prefixed as an
+// EIP-191 personal message (version 0x45):
+//
+//	"\x19Ethereum Signed Message:\n" + len(signDoc) + signDoc
+//
+// Ethereum wallets add this prefix themselves when signing with personal_sign,
+// hence the amino JSON sign doc must be given to the wallet, which signs the
+// Keccak256 hash of the sign bytes.
+package eip191
+
+import (
+	"context"
+	"strconv"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+)
+
+// PersonalMessagePrefix is the prefix of EIP-191 personal messages, followed
+// by the length of the message.
+const PersonalMessagePrefix = "\x19Ethereum Signed Message:\n"
+
+// SignModeHandler implements the SIGN_MODE_EIP_191 signing mode.
+type SignModeHandler struct {
+	aminoJSON *aminojson.SignModeHandler
+}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions struct {
+	// AminoJSON are the options of the amino JSON handler rendering the sign doc.
+	AminoJSON aminojson.SignModeHandlerOptions
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) *SignModeHandler {
+	return &SignModeHandler{aminoJSON: aminojson.NewSignModeHandler(options.AminoJSON)}
+}
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_EIP_191
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler interface.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	signDoc, err := h.aminoJSON.GetSignBytes(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return PersonalMessage(signDoc), nil
+}
+
+// PersonalMessage returns the message prefixed as an EIP-191 personal message.
+func PersonalMessage(msg []byte) []byte {
+	prefix := PersonalMessagePrefix + strconv.Itoa(len(msg))
+	bz := make([]byte, 0, len(prefix)+len(msg))
+	bz = append(bz, prefix...)
+	return append(bz, msg...)
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
 	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/eip191"
 	"cosmossdk.io/x/tx/signing/textual"
 )
 
@@ -16,6 +17,8 @@ type SignModeOptions struct {
 	DirectAux directaux.SignModeHandlerOptions
 	// AminoJSON are options for SIGN_MODE_LEGACY_AMINO_JSON
 	AminoJSON aminojson.SignModeHandlerOptions
+	// EIP191 are options for SIGN_MODE_EIP_191
+	EIP191 eip191.SignModeHandlerOptions
 }
 
 // HandlerMap returns a sign mode handler map that Cosmos SDK apps can use out
@@ -33,10 +36,13 @@ func (s SignModeOptions) HandlerMap() (*signing.HandlerMap, error) {
 
 	aminoJSON := aminojson.NewSignModeHandler(s.AminoJSON)
 
+	eip191Handler := eip191.NewSignModeHandler(s.EIP191)
+
 	return signing.NewHandlerMap(
 		direct.SignModeHandler{},
 		txt,
 		directAux,
 		aminoJSON,
+		eip191Handler,
 	), nil
 }
@@ -0,0 +1,186 @@
+package textual
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Field numbers of the textual annotations declared in
+// cosmos/msg/textual/v1/textual.proto. They are read from the raw descriptor
+// options, so that they are honored whether or not the annotations are
+// registered in the protobuf registry of the application.
+const (
+	summaryAnnotation protowire.Number = 11110010
+	labelAnnotation   protowire.Number = 11110011
+	hiddenAnnotation  protowire.Number = 11110012
+)
+
+// messageAnnotations are the textual annotations of a message and of its
+// fields.
+type messageAnnotations struct {
+	// summary is the parsed summary template, nil if the message has none.
+	summary []summaryPart
+	// titles are the titles of the fields, by field number.
+	titles map[protoreflect.FieldNumber]string
+	// hidden are the fields rendered on expert screens only.
+	hidden map[protoreflect.FieldNumber]bool
+}
+
+// summaryPart is either a literal text or a field of a summary template.
+type summaryPart struct {
+	text  string
+	field protoreflect.FieldDescriptor
+}
+
+// getMessageAnnotations returns the textual annotations of the given message
+// and its fields, or an error if they are invalid.
+func getMessageAnnotations(msgDesc protoreflect.MessageDescriptor) (messageAnnotations, error) {
+	annotations := messageAnnotations{
+		titles: make(map[protoreflect.FieldNumber]string),
+		hidden: make(map[protoreflect.FieldNumber]bool),
+	}
+
+	fields := msgDesc.Fields()
+	titles := make(map[string]protoreflect.Name, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+
+		title := toSentenceCase(string(fd.Name()))
+		if label, ok := stringOption(fd.Options(), labelAnnotation); ok {
+			if label == "" {
+				return messageAnnotations{}, fmt.Errorf("empty textual label for field %s", fd.FullName())
+			}
+			title = label
+		}
+		if other, ok := titles[title]; ok {
+			return messageAnnotations{}, fmt.Errorf("fields %s and %s of %s have the same textual title %q", other, fd.Name(), msgDesc.FullName(), title)
+		}
+		titles[title] = fd.Name()
+
+		annotations.titles[fd.Number()] = title
+		annotations.hidden[fd.Number()] = boolOption(fd.Options(), hiddenAnnotation)
+	}
+
+	if template, ok := stringOption(msgDesc.Options(), summaryAnnotation); ok {
+		summary, err := parseSummary(template, msgDesc, annotations.hidden)
+		if err != nil {
+			return messageAnnotations{}, fmt.Errorf("invalid textual summary of %s: %w", msgDesc.FullName(), err)
+		}
+		annotations.summary = summary
+	}
+
+	return annotations, nil
+}
+
+// parseSummary parses a summary template, where fields are referenced by their
+// name in braces and literal braces are escaped by doubling them.
+func parseSummary(template string, msgDesc protoreflect.MessageDescriptor, hidden map[protoreflect.FieldNumber]bool) ([]summaryPart, error) {
+	if template == "" {
+		return nil, fmt.Errorf("empty template")
+	}
+
+	var (
+		parts []summaryPart
+		text  strings.Builder
+	)
+	for i := 0; i < len(template); i++ {
+		switch c := template[i]; {
+		case c == '{' && strings.HasPrefix(template[i:], "{{"), c == '}' && strings.HasPrefix(template[i:], "}}"):
+			text.WriteByte(c)
+			i++
+		case c == '{':
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed brace at offset %d", i)
+			}
+
+			name := template[i+1 : i+end]
+			fd := msgDesc.Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				return nil, fmt.Errorf("unknown field %q", name)
+			}
+			if hidden[fd.Number()] {
+				return nil, fmt.Errorf("hidden field %q cannot be summarized", name)
+			}
+
+			if text.Len() > 0 {
+				parts = append(parts, summaryPart{text: text.String()})
+				text.Reset()
+			}
+			parts = append(parts, summaryPart{field: fd})
+			i += end
+		case c == '}':
+			return nil, fmt.Errorf("unopened brace at offset %d", i)
+		default:
+			text.WriteByte(c)
+		}
+	}
+	if text.Len() > 0 {
+		parts = append(parts, summaryPart{text: text.String()})
+	}
+
+	return parts, nil
+}
+
+// stringOption returns the string value of the option with the given field
+// number.
+func stringOption(opts proto.Message, num protowire.Number) (string, bool) {
+	typ, value, ok := rawOption(opts, num)
+	if !ok || typ != protowire.BytesType {
+		return "", false
+	}
+
+	bz, n := protowire.ConsumeBytes(value)
+	if n < 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// boolOption returns the bool value of the option with the given field number.
+func boolOption(opts proto.Message, num protowire.Number) bool {
+	typ, value, ok := rawOption(opts, num)
+	if !ok || typ != protowire.VarintType {
+		return false
+	}
+
+	v, n := protowire.ConsumeVarint(value)
+	return n >= 0 && v != 0
+}
+
+// rawOption returns the wire type and the encoded value of the option with the
+// given field number, the last one if it is repeated.
+func rawOption(opts proto.Message, num protowire.Number) (typ protowire.Type, value []byte, found bool) {
+	if opts == nil || !opts.ProtoReflect().IsValid() {
+		return 0, nil, false
+	}
+
+	bz, err := proto.Marshal(opts)
+	if err != nil {
+		return 0, nil, false
+	}
+
+	for len(bz) > 0 {
+		n, t, l := protowire.ConsumeTag(bz)
+		if l < 0 {
+			return 0, nil, false
+		}
+		bz = bz[l:]
+
+		l = protowire.ConsumeFieldValue(n, t, bz)
+		if l < 0 {
+			return 0, nil, false
+		}
+		if n == num {
+			typ, value, found = t, bz[:l], true
+		}
+		bz = bz[l:]
+	}
+
+	return typ, value, found
+}
@@ -0,0 +1,59 @@
+package textual_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/x/tx/internal/testpb"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+func TestAnnotations(t *testing.T) {
+	tr, err := textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: EmptyCoinMetadataQuerier})
+	require.NoError(t, err)
+
+	msg := &testpb.Transfer{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      []*basev1beta1.Coin{{Denom: "uatom", Amount: "10"}},
+		Memo:        "rent",
+		Ballot:      &testpb.Ballot{ProposalId: 1, Voter: "cosmos1from"},
+	}
+	screens, err := tr.CheckMessage(context.Background(), msg)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Content: "Transfer object"},
+		{Content: "Transfer 10 uatom to cosmos1to {rent}", Indent: 1},
+		{Title: "From address", Content: "cosmos1from", Indent: 1, Expert: true},
+		{Title: "Recipient", Content: "cosmos1to", Indent: 1},
+		{Title: "Amount", Content: "10 uatom", Indent: 1},
+		{Title: "Note", Content: "rent", Indent: 1},
+		{Title: "Ballot", Content: "Ballot object", Indent: 1, Expert: true},
+		{Title: "Proposal id", Content: "1", Indent: 2, Expert: true},
+		{Title: "Voter", Content: "cosmos1from", Indent: 2, Expert: true},
+	}, screens)
+
+	// unset fields are omitted from the summary
+	screens, err = tr.CheckMessage(context.Background(), &testpb.Transfer{ToAddress: "cosmos1to"})
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Content: "Transfer object"},
+		{Content: "Transfer  to cosmos1to {}", Indent: 1},
+		{Title: "Recipient", Content: "cosmos1to", Indent: 1},
+	}, screens)
+
+	// the summary must match the fields it summarizes
+	rend := textual.NewMessageValueRenderer(tr, msg.ProtoReflect().Descriptor())
+	screens[1].Content = "Transfer 1000000 uatom to cosmos1to {}"
+	_, err = rend.Parse(context.Background(), screens)
+	require.ErrorContains(t, err, "bad summary")
+
+	_, err = tr.CheckMessage(context.Background(), &testpb.TransferHiddenSummary{})
+	require.ErrorContains(t, err, `hidden field "from_address" cannot be summarized`)
+
+	_, err = tr.CheckMessage(context.Background(), &testpb.TransferDuplicateLabel{})
+	require.ErrorContains(t, err, `have the same textual title "To address"`)
+}
@@ -0,0 +1,69 @@
+package textual
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CheckMessage checks the textual rendering of the given message, e.g. in the
+// tests of the module declaring it: its textual annotations must be valid, and
+// its rendering must be parsed back to the same message, both on its own and
+// packed in an Any as in a transaction. It returns the rendering of the
+// message.
+func (r *SignModeHandler) CheckMessage(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	vr, err := r.GetMessageValueRenderer(msg.ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, err
+	}
+
+	screens, err := checkRoundTrip(ctx, vr, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	anyMsg, err := anyutil.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkRoundTrip(ctx, NewAnyValueRenderer(r), anyMsg); err != nil {
+		return nil, fmt.Errorf("packed in an Any: %w", err)
+	}
+
+	return screens, nil
+}
+
+// checkRoundTrip formats the given message with the value renderer and checks
+// that the screens are parsed back to the same message.
+func checkRoundTrip(ctx context.Context, vr ValueRenderer, msg proto.Message) ([]Screen, error) {
+	screens, err := vr.Format(ctx, protoreflect.ValueOfMessage(msg.ProtoReflect()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	parsed, err := vr.Parse(ctx, screens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the rendering of %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	// the messages are compared by their encoding, as the parsed message may be
+	// of another Go type than the given one, e.g. a dynamic message
+	marshal := proto.MarshalOptions{Deterministic: true}
+	want, err := marshal.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	got, err := marshal.Marshal(parsed.Message().Interface())
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(want, got) {
+		return nil, fmt.Errorf("the rendering of %s is not parsed back to the same message", msg.ProtoReflect().Descriptor().FullName())
+	}
+
+	return screens, nil
+}
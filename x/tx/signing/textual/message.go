@@ -19,9 +19,13 @@ var (
 )
 
 type messageValueRenderer struct {
-	tr      *SignModeHandler
-	msgDesc protoreflect.MessageDescriptor
-	fds     []protoreflect.FieldDescriptor
+	tr          *SignModeHandler
+	msgDesc     protoreflect.MessageDescriptor
+	fds         []protoreflect.FieldDescriptor
+	annotations messageAnnotations
+	// annotationsErr is the error of invalid textual annotations, returned
+	// when formatting or parsing the message.
+	annotationsErr error
 }
 
 func NewMessageValueRenderer(t *SignModeHandler, msgDesc protoreflect.MessageDescriptor) ValueRenderer {
@@ -32,14 +36,29 @@ func NewMessageValueRenderer(t *SignModeHandler, msgDesc protoreflect.MessageDes
 	}
 	sort.Slice(fds, func(i, j int) bool { return fds[i].Number() < fds[j].Number() })
 
-	return &messageValueRenderer{tr: t, msgDesc: msgDesc, fds: fds}
+	annotations, err := getMessageAnnotations(msgDesc)
+	return &messageValueRenderer{tr: t, msgDesc: msgDesc, fds: fds, annotations: annotations, annotationsErr: err}
 }
 
 func (mr *messageValueRenderer) header() string {
 	return fmt.Sprintf("%s object", mr.msgDesc.Name())
 }
 
+// title returns the title of the screens of a field: its textual label if
+// any, else its name in sentence case.
+func (mr *messageValueRenderer) title(fd protoreflect.FieldDescriptor) string {
+	if title, ok := mr.annotations.titles[fd.Number()]; ok {
+		return title
+	}
+
+	return toSentenceCase(string(fd.Name()))
+}
+
 func (mr *messageValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	if mr.annotationsErr != nil {
+		return nil, mr.annotationsErr
+	}
+
 	fullName := v.Message().Descriptor().FullName()
 	wantFullName := mr.msgDesc.FullName()
 	if fullName != wantFullName {
@@ -49,42 +68,33 @@ func (mr *messageValueRenderer) Format(ctx context.Context, v protoreflect.Value
 	screens := make([]Screen, 1)
 	screens[0].Content = mr.header()
 
-	for _, fd := range mr.fds {
-		if !v.Message().Has(fd) {
-			// Skip default values.
-			continue
-		}
-		vr, err := mr.tr.GetFieldValueRenderer(fd)
+	if mr.annotations.summary != nil {
+		summary, err := mr.formatSummary(ctx, v.Message())
 		if err != nil {
 			return nil, err
 		}
+		screens = append(screens, Screen{Content: summary, Indent: 1})
+	}
 
-		var subscreens []Screen
-		if fd.IsList() {
-			if r, ok := vr.(RepeatedValueRenderer); ok {
-				// If the field is a list, and handles its own repeated rendering
-				subscreens, err = r.FormatRepeated(ctx, v.Message().Get(fd))
-			} else {
-				// If the field is a list, we need to format each element of the list
-				subscreens, err = mr.formatRepeated(ctx, v.Message().Get(fd), fd)
-			}
-		} else {
-			// If the field is not list, we need to format the field
-			subscreens, err = vr.Format(ctx, v.Message().Get(fd))
+	for _, fd := range mr.fds {
+		if !v.Message().Has(fd) {
+			// Skip default values.
+			continue
 		}
 
+		subscreens, err := mr.formatField(ctx, v.Message(), fd)
 		if err != nil {
 			return nil, err
 		}
-		if len(subscreens) == 0 {
-			return nil, fmt.Errorf("empty rendering for field %s", fd.Name())
-		}
+
+		// Hidden fields are only rendered on expert screens.
+		hidden := mr.annotations.hidden[fd.Number()]
 
 		headerScreen := Screen{
-			Title:   toSentenceCase(string(fd.Name())),
+			Title:   mr.title(fd),
 			Content: subscreens[0].Content,
 			Indent:  subscreens[0].Indent + 1,
-			Expert:  subscreens[0].Expert,
+			Expert:  subscreens[0].Expert || hidden,
 		}
 		screens = append(screens, headerScreen)
 
@@ -93,7 +103,7 @@ func (mr *messageValueRenderer) Format(ctx context.Context, v protoreflect.Value
 				Title:   subscreens[i].Title,
 				Content: subscreens[i].Content,
 				Indent:  subscreens[i].Indent + 1,
-				Expert:  subscreens[i].Expert,
+				Expert:  subscreens[i].Expert || hidden,
 			}
 			screens = append(screens, extraScreen)
 		}
@@ -102,6 +112,62 @@ func (mr *messageValueRenderer) Format(ctx context.Context, v protoreflect.Value
 	return screens, nil
 }
 
+// formatField formats a field of the given message.
+func (mr *messageValueRenderer) formatField(ctx context.Context, msg protoreflect.Message, fd protoreflect.FieldDescriptor) ([]Screen, error) {
+	vr, err := mr.tr.GetFieldValueRenderer(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscreens []Screen
+	if fd.IsList() {
+		if r, ok := vr.(RepeatedValueRenderer); ok {
+			// If the field is a list, and handles its own repeated rendering
+			subscreens, err = r.FormatRepeated(ctx, msg.Get(fd))
+		} else {
+			// If the field is a list, we need to format each element of the list
+			subscreens, err = mr.formatRepeated(ctx, msg.Get(fd), fd)
+		}
+	} else {
+		// If the field is not list, we need to format the field
+		subscreens, err = vr.Format(ctx, msg.Get(fd))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if len(subscreens) == 0 {
+		return nil, fmt.Errorf("empty rendering for field %s", fd.Name())
+	}
+
+	return subscreens, nil
+}
+
+// formatSummary formats the summary of the given message from its template.
+// Fields are replaced by the first line of their rendering, unset fields by an
+// empty string.
+func (mr *messageValueRenderer) formatSummary(ctx context.Context, msg protoreflect.Message) (string, error) {
+	var summary strings.Builder
+	for _, part := range mr.annotations.summary {
+		if part.field == nil {
+			summary.WriteString(part.text)
+			continue
+		}
+
+		if !msg.Has(part.field) {
+			continue
+		}
+
+		subscreens, err := mr.formatField(ctx, msg, part.field)
+		if err != nil {
+			return "", err
+		}
+		summary.WriteString(subscreens[0].Content)
+	}
+
+	return summary.String(), nil
+}
+
 func (mr *messageValueRenderer) formatRepeated(ctx context.Context, v protoreflect.Value, fd protoreflect.FieldDescriptor) ([]Screen, error) {
 	vr, err := mr.tr.GetFieldValueRenderer(fd)
 	if err != nil {
@@ -113,6 +179,7 @@ func (mr *messageValueRenderer) formatRepeated(ctx context.Context, v protorefle
 		return nil, fmt.Errorf("got non-List value %T", l)
 	}
 
+	title := mr.title(fd)
 	screens := make([]Screen, 1)
 	// <field_name>: <int> <field_kind>
 	screens[0].Content = fmt.Sprintf("%d %s", l.Len(), toSentenceCase(getKind(fd)))
@@ -129,7 +196,7 @@ func (mr *messageValueRenderer) formatRepeated(ctx context.Context, v protorefle
 
 		headerScreen := Screen{
 			// <field_name> (<int>/<int>)
-			Title: fmt.Sprintf("%s (%d/%d)", toSentenceCase(string(fd.Name())), i+1, l.Len()),
+			Title: fmt.Sprintf("%s (%d/%d)", title, i+1, l.Len()),
 			// <value rendered 1st line>
 			Content: subscreens[0].Content,
 			Indent:  subscreens[0].Indent + 1,
@@ -151,7 +218,7 @@ func (mr *messageValueRenderer) formatRepeated(ctx context.Context, v protorefle
 
 	// End of <field_name>
 	terminalScreen := Screen{
-		Content: fmt.Sprintf("End of %s", toSentenceCase(string(fd.Name()))),
+		Content: fmt.Sprintf("End of %s", title),
 	}
 	screens = append(screens, terminalScreen)
 	return screens, nil
@@ -182,6 +249,10 @@ func toSentenceCase(name string) string {
 var nilValue = protoreflect.Value{}
 
 func (mr *messageValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	if mr.annotationsErr != nil {
+		return nilValue, mr.annotationsErr
+	}
+
 	if len(screens) == 0 {
 		return nilValue, errors.New("expect at least one screen")
 	}
@@ -200,6 +271,16 @@ func (mr *messageValueRenderer) Parse(ctx context.Context, screens []Screen) (pr
 	msg := msgType.New()
 	idx := 1
 
+	// The summary is checked once the fields are parsed.
+	var summaryScreen *Screen
+	if mr.annotations.summary != nil {
+		if idx >= len(screens) || screens[idx].Indent != 1 || screens[idx].Title != "" {
+			return nilValue, errors.New("expected a summary screen")
+		}
+		summaryScreen = &screens[idx]
+		idx++
+	}
+
 	for _, fd := range mr.fds {
 		if idx >= len(screens) {
 			// remaining fields are default
@@ -215,7 +296,7 @@ func (mr *messageValueRenderer) Parse(ctx context.Context, screens []Screen) (pr
 			return nilValue, fmt.Errorf("bad message indentation: want 1, got %d", screens[idx].Indent)
 		}
 
-		expectedTitle := toSentenceCase(string(fd.Name()))
+		expectedTitle := mr.title(fd)
 		if screens[idx].Title != expectedTitle {
 			// we must have skipped this fd because of a default value
 			continue
@@ -262,6 +343,16 @@ func (mr *messageValueRenderer) Parse(ctx context.Context, screens []Screen) (pr
 		}
 	}
 
+	if summaryScreen != nil {
+		summary, err := mr.formatSummary(ctx, msg)
+		if err != nil {
+			return nilValue, err
+		}
+		if summaryScreen.Content != summary {
+			return nilValue, fmt.Errorf(`bad summary: want "%s", got "%s"`, summary, summaryScreen.Content)
+		}
+	}
+
 	return protoreflect.ValueOfMessage(msg), nil
 }
 
@@ -23,6 +23,11 @@ import (
 // It is used in a v2 chain.
 const flagUnsafeSkipUpgradesV2 = "server.unsafe-skip-upgrades"
 
+// FlagPreflightWindow is the number of blocks, not a duration, before a scheduled
+// upgrade at which the upgrade pre-flight checks are run, e.g. 1200 to run them
+// about 2 hours before the upgrade with 6 seconds blocks. 0 disables them.
+const FlagPreflightWindow = "upgrade-preflight-window"
+
 var _ depinject.OnePerModuleType = AppModule{}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -41,6 +46,7 @@ func ProvideConfig(key depinject.OwnModuleKey) coreserver.ModuleConfigMap {
 		Config: coreserver.ConfigMap{
 			server.FlagUnsafeSkipUpgrades: []int{},
 			flagUnsafeSkipUpgradesV2:      []int{},
+			FlagPreflightWindow:           0,
 			flags.FlagHome:                "",
 		},
 	}
@@ -98,6 +104,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(in.Environment, skipUpgradeHeights, in.Cdc, homePath, in.AppVersionModifier, authorityStr, in.ConsensusKeeper)
+	k.SetPreflightWindow(cast.ToInt64(in.ConfigMap[FlagPreflightWindow]))
 	m := NewAppModule(k)
 
 	return ModuleOutputs{UpgradeKeeper: k, Module: m}
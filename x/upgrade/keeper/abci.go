@@ -13,7 +13,8 @@ import (
 // PreBlocker will check if there is a scheduled plan and if it is ready to be executed.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
-// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise),
+// and run the upgrade pre-flight checks once the plan is within the pre-flight window.
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
@@ -104,6 +105,12 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 		// Returning an error will end up in a panic
 		return errors.New(downgradeMsg)
 	}
+
+	// warn the node operator ahead of the upgrade if the node is not ready for it
+	if k.preflightWindow > 0 && plan.Height-blockHeight <= k.preflightWindow {
+		return k.runPreflight(ctx, plan)
+	}
+
 	return nil
 }
 
@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -183,6 +186,49 @@ func TestDoHeightUpgrade(t *testing.T) {
 	s.VerifyDoUpgrade(t)
 }
 
+func TestPreflightWindow(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	s.keeper.SetPreflightWindow(5)
+	binPath := filepath.Join(t.TempDir(), "simd")
+	s.keeper.SetUpgradeBinaryPathFn(func(types.Plan) string { return binPath })
+	binary := []byte("upgrade binary")
+	checksum := sha256.Sum256(binary)
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{
+		Name:   "test",
+		Info:   `{"binaries":{"any":"https://example.com/simd?checksum=sha256:` + hex.EncodeToString(checksum[:]) + `"}}`,
+		Height: 20,
+	})
+	require.NoError(t, err)
+
+	t.Log("Verify that the pre-flight checks are not run before the window")
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, s.preModule.PreBlock(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+
+	t.Log("Verify that the pre-flight checks report the missing upgrade binary until it is installed")
+	for _, height := range []int64{15, 16} {
+		ctx = s.ctx.WithHeaderInfo(header.Info{Height: height}).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, s.preModule.PreBlock(ctx))
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, types.EventTypeUpgradePreflight, events[0].Type)
+		check, ok := events[0].GetAttribute(types.AttributeKeyCheck)
+		require.True(t, ok)
+		require.Equal(t, types.PreflightCheckBinary, check.Value)
+	}
+
+	require.NoError(t, os.WriteFile(binPath, binary, 0o600))
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 17}).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, s.preModule.PreBlock(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+
+	t.Log("Verify that the pre-flight checks are not run again once passed")
+	require.NoError(t, os.Remove(binPath))
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 18}).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, s.preModule.PreBlock(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
 func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	s := setupTest(t, 10, map[int64]bool{})
 	t.Log("Can overwrite plan")
@@ -38,6 +38,9 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     appmodule.VersionMap            // the module version map at init genesis
+	preflightWindow    int64                           // number of blocks before an upgrade at which the pre-flight checks are run, 0 to disable them
+	upgradeBinaryPath  func(types.Plan) string         // returns the local path of the binary of an upgrade
+	preflightPassed    map[string]bool                 // set of upgrade plans whose pre-flight checks passed
 
 	consensusKeeper types.ConsensusKeeper
 }
@@ -65,8 +68,10 @@ func NewKeeper(
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionModifier:    vs,
 		authority:          authority,
+		preflightPassed:    map[string]bool{},
 		consensusKeeper:    ck,
 	}
+	k.upgradeBinaryPath = k.defaultUpgradeBinaryPath
 
 	if homePath == "" {
 		k.Logger.Warn("homePath is empty; upgrade info will be written to the current directory")
@@ -87,6 +92,20 @@ func (k *Keeper) GetInitVersionMap() appmodule.VersionMap {
 	return k.initVersionMap
 }
 
+// SetPreflightWindow enables the upgrade pre-flight checks in PreBlocker once a scheduled upgrade
+// is within the given number of blocks, e.g. 1200 blocks to run them about 2 hours before the
+// upgrade with 6 seconds blocks. A non-positive window disables them.
+func (k *Keeper) SetPreflightWindow(blocks int64) {
+	k.preflightWindow = blocks
+}
+
+// SetUpgradeBinaryPathFn sets the function returning the local path of the binary of an upgrade,
+// whose checksum is verified by the pre-flight checks.
+// It defaults to the Cosmovisor layout: {homePath}/cosmovisor/upgrades/{name}/bin/{daemonName}.
+func (k *Keeper) SetUpgradeBinaryPathFn(fn func(types.Plan) string) {
+	k.upgradeBinaryPath = fn
+}
+
 // SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
 // with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
 // must be set even if it is a no-op function.
@@ -191,6 +210,7 @@ func (k Keeper) getModuleVersion(ctx context.Context, name string) (uint64, erro
 }
 
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
+// If the plan info is structured (see plan.Info), all its binaries must have a sha256 checksum.
 // If there is another Plan already scheduled, it will cancel and overwrite it.
 // ScheduleUpgrade will also write the upgraded IBC ClientState to the upgraded client
 // path if it is specified in the plan.
@@ -199,6 +219,10 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
+	if err := validatePlanInfo(plan.Info); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan info: %s", err)
+	}
+
 	// NOTE: allow for the possibility of chains to schedule upgrades in begin block of the same block
 	// as a strategy for emergency hard fork recoveries
 	if plan.Height < k.HeaderService.HeaderInfo(ctx).Height {
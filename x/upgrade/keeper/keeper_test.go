@@ -2,7 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
@@ -17,6 +21,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/upgrade"
 	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/plan"
 	upgradetestutil "cosmossdk.io/x/upgrade/testutil"
 	"cosmossdk.io/x/upgrade/types"
 
@@ -148,6 +153,36 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 			},
 			expPass: true,
 		},
+		{
+			name: "successful schedule: structured info with checksums",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:` + strings.Repeat("ab", 32) + `"}}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: true,
+		},
+		{
+			name: "unsuccessful schedule: structured info without checksum",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"linux/amd64":"https://example.com/simd"}}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: false,
+		},
+		{
+			name: "unsuccessful schedule: structured info with non sha256 checksum",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=md5:` + strings.Repeat("ab", 16) + `"}}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: false,
+		},
 		{
 			name: "unsuccessful schedule: invalid plan",
 			plan: types.Plan{
@@ -417,6 +452,52 @@ func (s *KeeperTestSuite) TestLastCompletedUpgradeOrdering() {
 	require.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestPreflightUpgrade() {
+	require := s.Require()
+
+	binPath := filepath.Join(s.T().TempDir(), "simd")
+	require.NoError(os.WriteFile(binPath, []byte("upgrade binary"), 0o600))
+	checksum := sha256.Sum256([]byte("upgrade binary"))
+	s.upgradeKeeper.SetUpgradeBinaryPathFn(func(types.Plan) string { return binPath })
+
+	p := types.Plan{
+		Name:   "test-v1",
+		Info:   fmt.Sprintf(`{"binaries":{"%s":"https://example.com/simd?checksum=sha256:%x"}}`, plan.CurrentOSArch(), checksum),
+		Height: 20,
+	}
+
+	failures, err := s.upgradeKeeper.PreflightUpgrade(s.ctx, p)
+	require.NoError(err)
+	require.Len(failures, 1)
+	require.Equal(types.PreflightCheckHandler, failures[0].Check)
+	require.ErrorIs(failures[0].Err, types.ErrNoUpgradeHandlerFound)
+
+	// a module of this binary is older than the state
+	s.upgradeKeeper.SetInitVersionMap(appmodule.VersionMap{"bank": 1})
+	require.NoError(s.upgradeKeeper.SetModuleVersionMap(s.ctx, appmodule.VersionMap{"bank": 2}))
+	failures, err = s.upgradeKeeper.PreflightUpgrade(s.ctx, p, types.PreflightCheckVersionMap, types.PreflightCheckBinary)
+	require.NoError(err)
+	require.Len(failures, 1)
+	require.Equal(types.PreflightCheckVersionMap, failures[0].Check)
+	require.ErrorIs(failures[0].Err, types.ErrIncompatibleModuleVersion)
+
+	// the local binary does not match the plan
+	require.NoError(os.WriteFile(binPath, []byte("another binary"), 0o600))
+	failures, err = s.upgradeKeeper.PreflightUpgrade(s.ctx, p, types.PreflightCheckBinary)
+	require.NoError(err)
+	require.Len(failures, 1)
+	require.ErrorIs(failures[0].Err, types.ErrUpgradeBinaryMismatch)
+
+	// free-form plan infos are not checked
+	p.Info = "some text here"
+	failures, err = s.upgradeKeeper.PreflightUpgrade(s.ctx, p, types.PreflightCheckBinary)
+	require.NoError(err)
+	require.Empty(failures)
+
+	_, err = s.upgradeKeeper.PreflightUpgrade(s.ctx, p, "unknown")
+	require.Error(err)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/upgrade/plan"
+	"cosmossdk.io/x/upgrade/types"
+)
+
+// PreflightUpgrade runs the given pre-flight checks, or all of them if none is given, against
+// the upgrade plan and returns the failed ones. The checks are:
+//   - types.PreflightCheckHandler: an upgrade handler is registered for the plan.
+//   - types.PreflightCheckVersionMap: no module of this binary has a consensus version lower
+//     than the one stored in state.
+//   - types.PreflightCheckBinary: the local upgrade binary matches the sha256 checksum of the
+//     plan info for the running platform. Plans without structured info are not checked.
+//
+// The handler and version map checks are only meaningful when run by the upgrade binary, as the
+// running binary must not know about a pending upgrade and its modules are the ones in state.
+func (k Keeper) PreflightUpgrade(ctx context.Context, p types.Plan, checks ...string) ([]types.PreflightFailure, error) {
+	if len(checks) == 0 {
+		checks = []string{types.PreflightCheckHandler, types.PreflightCheckVersionMap, types.PreflightCheckBinary}
+	}
+
+	var failures []types.PreflightFailure
+	for _, check := range checks {
+		var err error
+		switch check {
+		case types.PreflightCheckHandler:
+			if !k.HasHandler(p.Name) {
+				err = types.ErrNoUpgradeHandlerFound.Wrapf("no upgrade handler registered for %s", p.Name)
+			}
+		case types.PreflightCheckVersionMap:
+			err = k.preflightVersionMap(ctx)
+		case types.PreflightCheckBinary:
+			err = k.preflightBinary(p)
+		default:
+			return nil, fmt.Errorf("unknown upgrade pre-flight check %q", check)
+		}
+
+		if err != nil {
+			failures = append(failures, types.PreflightFailure{Check: check, Err: err})
+		}
+	}
+
+	return failures, nil
+}
+
+// preflightVersionMap checks that the consensus versions of the modules of this binary are not
+// lower than the ones stored in state.
+func (k Keeper) preflightVersionMap(ctx context.Context) error {
+	if len(k.initVersionMap) == 0 {
+		return nil
+	}
+
+	vm, err := k.GetModuleVersionMap(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sortedModuleNames(vm) {
+		if version, ok := k.initVersionMap[name]; ok && version < vm[name] {
+			return types.ErrIncompatibleModuleVersion.Wrapf("module %s has consensus version %d, lower than version %d in state", name, version, vm[name])
+		}
+	}
+
+	return nil
+}
+
+// preflightBinary checks that the local binary of the upgrade matches the sha256 checksum of
+// the plan info for the running platform.
+func (k Keeper) preflightBinary(p types.Plan) error {
+	info, ok, err := parseStructuredPlanInfo(p.Info)
+	if err != nil || !ok {
+		return err
+	}
+
+	osArch := plan.CurrentOSArch()
+	url, ok := info.Binaries.URLFor(osArch)
+	if !ok {
+		return types.ErrUpgradeBinaryMismatch.Wrapf("plan info has no binary for %s", osArch)
+	}
+
+	expected, err := plan.SHA256Checksum(url)
+	if err != nil {
+		return types.ErrUpgradeBinaryMismatch.Wrapf("binaries[%s]: %s", osArch, err)
+	}
+
+	path := k.upgradeBinaryPath(p)
+	actual, err := plan.FileSHA256(path)
+	if err != nil {
+		return types.ErrUpgradeBinaryMismatch.Wrapf("could not read upgrade binary: %s", err)
+	}
+
+	if !bytes.Equal(expected, actual) {
+		return types.ErrUpgradeBinaryMismatch.Wrapf("%s has checksum %s, expected %s", path, hex.EncodeToString(actual), hex.EncodeToString(expected))
+	}
+
+	return nil
+}
+
+// runPreflight runs the pre-flight checks that are meaningful for the running binary until they
+// pass, and reports the failures as warning logs and events. The failed checks are run again on
+// the next blocks, as the operator may fix the node after being warned.
+// The results depend on the local node, so they must never affect the state.
+func (k Keeper) runPreflight(ctx context.Context, p types.Plan) error {
+	key := fmt.Sprintf("%s/%d", p.Name, p.Height)
+	if k.preflightPassed[key] {
+		return nil
+	}
+
+	failures, err := k.PreflightUpgrade(ctx, p, types.PreflightCheckBinary)
+	if err != nil {
+		return err
+	}
+
+	if len(failures) == 0 {
+		k.preflightPassed[key] = true
+		k.Logger.Info("upgrade pre-flight checks passed", "name", p.Name, "height", p.Height)
+		return nil
+	}
+
+	for _, failure := range failures {
+		k.Logger.Warn("upgrade pre-flight check failed", "name", p.Name, "height", p.Height, "check", failure.Check, "err", failure.Err)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeUpgradePreflight,
+			event.NewAttribute(types.AttributeKeyName, p.Name),
+			event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(p.Height, 10)),
+			event.NewAttribute(types.AttributeKeyCheck, failure.Check),
+			event.NewAttribute(types.AttributeKeyError, failure.Err.Error()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// defaultUpgradeBinaryPath returns the path of the upgrade binary in the Cosmovisor layout.
+func (k Keeper) defaultUpgradeBinaryPath(p types.Plan) string {
+	return filepath.Join(k.homePath, "cosmovisor", "upgrades", neturl.PathEscape(p.Name), "bin", filepath.Base(os.Args[0]))
+}
+
+// parseStructuredPlanInfo parses the plan info when it is a JSON object listing binaries.
+// It returns false when the plan info is free-form text or a url.
+func parseStructuredPlanInfo(infoStr string) (*plan.Info, bool, error) {
+	if !strings.HasPrefix(strings.TrimSpace(infoStr), "{") {
+		return nil, false, nil
+	}
+
+	info, err := plan.ParseInfo(infoStr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return info, len(info.Binaries) > 0, nil
+}
+
+// validatePlanInfo validates a structured plan info, whose binaries must all have a valid url
+// with a sha256 checksum. Other plan infos are not validated.
+func validatePlanInfo(infoStr string) error {
+	info, ok, err := parseStructuredPlanInfo(infoStr)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := info.Binaries.ValidateBasic(true); err != nil {
+		return err
+	}
+
+	return info.Binaries.ValidateSHA256Checksums()
+}
+
+func sortedModuleNames(vm map[string]uint64) []string {
+	names := make([]string, 0, len(vm))
+	for name := range vm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,13 +1,17 @@
 package plan
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	neturl "net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"runtime"
 	"strings"
 
 	"cosmossdk.io/x/upgrade/internal/conv"
@@ -141,3 +145,73 @@ func (m BinaryDownloadURLMap) CheckURLs(daemonName string, enforceChecksum bool)
 	}
 	return nil
 }
+
+// CurrentOSArch returns the os/arch key of the running platform in a BinaryDownloadURLMap.
+func CurrentOSArch() string {
+	return fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+// URLFor returns the url of the binary for the given os/arch, falling back to the "any" entry.
+func (m BinaryDownloadURLMap) URLFor(osArch string) (string, bool) {
+	if url, ok := m[osArch]; ok {
+		return url, true
+	}
+
+	url, ok := m["any"]
+	return url, ok
+}
+
+// ValidateSHA256Checksums checks that every url contains an inline sha256 checksum query parameter,
+// formatted either as "sha256:<hex>" or as a bare hex encoded sha256 digest.
+func (m BinaryDownloadURLMap) ValidateSHA256Checksums() error {
+	for key, val := range m {
+		if _, err := SHA256Checksum(val); err != nil {
+			return fmt.Errorf("invalid checksum in binaries[%s]: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// SHA256Checksum returns the sha256 digest contained in the checksum query parameter of the given url.
+func SHA256Checksum(urlStr string) ([]byte, error) {
+	url, err := neturl.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := url.Query().Get("checksum")
+	if len(checksum) == 0 {
+		return nil, errors.New("missing checksum query parameter")
+	}
+
+	if algo, digest, ok := strings.Cut(checksum, ":"); ok {
+		if algo != "sha256" {
+			return nil, fmt.Errorf("unsupported checksum type %q, expected sha256", algo)
+		}
+		checksum = digest
+	}
+
+	bz, err := hex.DecodeString(checksum)
+	if err != nil || len(bz) != sha256.Size {
+		return nil, fmt.Errorf("checksum %q is not a hex encoded sha256 digest", checksum)
+	}
+
+	return bz, nil
+}
+
+// FileSHA256 returns the sha256 digest of the file at the given path.
+func FileSHA256(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
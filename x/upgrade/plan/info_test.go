@@ -1,6 +1,8 @@
 package plan
 
 import (
+	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -344,3 +346,46 @@ func (s *InfoTestSuite) TestBinaryDownloadURLMapCheckURLs() {
 		})
 	}
 }
+
+func (s *InfoTestSuite) TestSHA256Checksum() {
+	digest := strings.Repeat("ab", 32)
+	tests := []struct {
+		name   string
+		url    string
+		errStr string
+	}{
+		{name: "typed checksum", url: "https://example.com/simd?checksum=sha256:" + digest},
+		{name: "bare checksum", url: "https://example.com/simd?checksum=" + digest},
+		{name: "missing checksum", url: "https://example.com/simd", errStr: "missing checksum query parameter"},
+		{name: "other checksum type", url: "https://example.com/simd?checksum=md5:" + digest[:32], errStr: "unsupported checksum type"},
+		{name: "short checksum", url: "https://example.com/simd?checksum=sha256:" + digest[:32], errStr: "not a hex encoded sha256 digest"},
+		{name: "not hex", url: "https://example.com/simd?checksum=sha256:" + strings.Repeat("zz", 32), errStr: "not a hex encoded sha256 digest"},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			bz, err := SHA256Checksum(tc.url)
+			if len(tc.errStr) > 0 {
+				require.ErrorContains(t, err, tc.errStr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, digest, hex.EncodeToString(bz))
+		})
+	}
+}
+
+func (s *InfoTestSuite) TestBinaryDownloadURLMapURLFor() {
+	m := BinaryDownloadURLMap{"linux/amd64": "url1", "any": "url2"}
+
+	url, ok := m.URLFor("linux/amd64")
+	s.Require().True(ok)
+	s.Require().Equal("url1", url)
+
+	url, ok = m.URLFor("darwin/arm64")
+	s.Require().True(ok)
+	s.Require().Equal("url2", url)
+
+	_, ok = BinaryDownloadURLMap{"linux/amd64": "url1"}.URLFor("darwin/arm64")
+	s.Require().False(ok)
+}
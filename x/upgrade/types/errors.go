@@ -16,4 +16,10 @@ var (
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
 	// ErrInvalidSigner error if the authority is not the signer for a proposal message
 	ErrInvalidSigner = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	// ErrNoUpgradeHandlerFound error if there is no upgrade handler registered for an upgrade plan
+	ErrNoUpgradeHandlerFound = errors.Register(ModuleName, 7, "upgrade handler not found")
+	// ErrIncompatibleModuleVersion error if a module consensus version is lower than the one stored in state
+	ErrIncompatibleModuleVersion = errors.Register(ModuleName, 8, "incompatible module version")
+	// ErrUpgradeBinaryMismatch error if the local upgrade binary does not match the plan info
+	ErrUpgradeBinaryMismatch = errors.Register(ModuleName, 9, "upgrade binary mismatch")
 )
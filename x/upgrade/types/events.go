@@ -0,0 +1,11 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeUpgradePreflight = "upgrade_preflight"
+
+	AttributeKeyName   = "name"
+	AttributeKeyHeight = "height"
+	AttributeKeyCheck  = "check"
+	AttributeKeyError  = "error"
+)
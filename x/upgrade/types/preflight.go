@@ -0,0 +1,17 @@
+package types
+
+// Upgrade pre-flight checks, see Keeper.PreflightUpgrade.
+const (
+	// PreflightCheckHandler checks that an upgrade handler is registered for the plan.
+	PreflightCheckHandler = "handler"
+	// PreflightCheckVersionMap checks that no module consensus version is lower than the one stored in state.
+	PreflightCheckVersionMap = "version_map"
+	// PreflightCheckBinary checks that the local upgrade binary matches the checksum of the plan info.
+	PreflightCheckBinary = "binary"
+)
+
+// PreflightFailure is a failed upgrade pre-flight check.
+type PreflightFailure struct {
+	Check string
+	Err   error
+}
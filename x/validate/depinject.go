@@ -57,6 +57,7 @@ type ModuleInputs struct {
 	AccountAbstractionKeeper ante.AccountAbstractionKeeper             `optional:"true"`
 	ExtraTxValidators        []appmodulev2.TxValidator[transaction.Tx] `optional:"true"`
 	UnorderedTxManager       *unorderedtx.Manager                      `optional:"true"`
+	UnorderedTxStore         ante.UnorderedTxStore                     `optional:"true"`
 	TxFeeChecker             ante.TxFeeChecker                         `optional:"true"`
 }
 
@@ -91,8 +92,12 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	feeTxValidator = ante.NewDeductFeeDecorator(in.AccountKeeper, in.BankKeeper, in.FeeGrantKeeper, in.TxFeeChecker)
 	feeTxValidator.SetMinGasPrices(minGasPrices) // set min gas price in deduct fee decorator
 
+	// server/v2 chains have no unordered tx manager and track unordered
+	// transactions in state instead.
 	if in.UnorderedTxManager != nil {
 		unorderedTxValidator = ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, in.UnorderedTxManager, in.Environment, ante.DefaultSha256Cost)
+	} else if in.UnorderedTxStore != nil {
+		unorderedTxValidator = ante.NewUnorderedTxDecoratorWithStore(unorderedtx.DefaultMaxTimeoutDuration, in.UnorderedTxStore, in.Environment, ante.DefaultSha256Cost)
 	}
 
 	return ModuleOutputs{
@@ -147,6 +152,7 @@ func newAnteHandler(in ModuleInputs) (sdk.AnteHandler, error) {
 			SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
 			UnorderedTxManager:       in.UnorderedTxManager,
 			AccountAbstractionKeeper: in.AccountAbstractionKeeper,
+			TxFeeChecker:             in.TxFeeChecker,
 		},
 	)
 	if err != nil {